@@ -0,0 +1,7 @@
+package fixture
+
+// Event is a hand-written struct type, used to test JSON Lines decoding.
+type Event struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
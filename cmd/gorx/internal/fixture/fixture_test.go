@@ -0,0 +1,45 @@
+package fixture
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainsUncomparable(t *testing.T) {
+	assert.Equal(t, []bool{true}, FromInterfaces([]int{1}, 2).Contains([]int{1}).ToArray())
+	assert.Equal(t, []bool{true}, FromInterfaces([]int{1}, 2).Contains(2).ToArray())
+	assert.Equal(t, []bool{false}, FromInterfaces([]int{1}, 2).Contains([]int{2}).ToArray())
+}
+
+func TestSequenceEqualUncomparable(t *testing.T) {
+	s := FromInterfaces([]int{1}, 2)
+	assert.Equal(t, []bool{true}, s.SequenceEqual(FromInterfaces([]int{1}, 2)).ToArray())
+	assert.Equal(t, []bool{false}, s.SequenceEqual(FromInterfaces([]int{2}, 2)).ToArray())
+}
+
+func TestDecodeJSONLines(t *testing.T) {
+	a := DecodeEventJSONLines(strings.NewReader("{\"name\":\"a\",\"count\":1}\n\n{\"name\":\"b\"}")).ToArray()
+	assert.Equal(t, []Event{{"a", 1}, {"b", 0}}, a)
+}
+
+func TestDecodeJSONLinesError(t *testing.T) {
+	a, err := DecodeEventJSONLines(strings.NewReader("{\"name\":\"a\"}\nx\n{\"name\":\"b\"}\n")).ToArrayWithError()
+	assert.Equal(t, []Event{{Name: "a"}}, a)
+	assert.Error(t, err)
+}
+
+func TestDecodeJSONLinesSkipErrors(t *testing.T) {
+	a := DecodeEventJSONLinesWithPolicy(strings.NewReader("{\"name\":\"a\"}\nx\n{\"name\":\"b\"}\n"), SkipDecodeErrors).ToArray()
+	assert.Equal(t, []Event{{Name: "a"}, {Name: "b"}}, a)
+}
+
+func TestEncodeJSONLines(t *testing.T) {
+	w := &bytes.Buffer{}
+	assert.NoError(t, FromEvents(Event{"a", 1}, Event{"b", 2}).EncodeJSONLines(w))
+	assert.Equal(t, "{\"name\":\"a\",\"count\":1}\n{\"name\":\"b\",\"count\":2}\n", w.String())
+	a := DecodeEventJSONLines(w).ToArray()
+	assert.Equal(t, []Event{{"a", 1}, {"b", 2}}, a)
+}
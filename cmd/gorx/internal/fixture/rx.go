@@ -0,0 +1,14664 @@
+// Package fixture implements ReactiveX extensions for Go.
+package fixture
+
+//go:generate gorx --json -o rx.go fixture interface{} Event
+
+// NOTE: This file was generated by github.com/alecthomas/gorx/cmd/gorx. Do not modify.
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"iter"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrTimeout is delivered to an observer if the stream times out.
+var ErrTimeout = errors.New("timeout")
+
+// MaxReplaySize is the maximum size of a replay buffer. Can be modified.
+var MaxReplaySize = 16384
+
+// Now is the clock used by Timestamp and TimeInterval. Can be modified.
+var Now = time.Now
+
+// A Subscription to an observable.
+type Subscription interface {
+	// Dispose unsubscribes from the subscription.
+	Dispose()
+	// Disposed returns true if this subscription has been unsubscribed.
+	Disposed() bool
+}
+
+// SubscriptionEvents provides lifecycle event callbacks for a Subscription.
+type SubscriptionEvents interface {
+	OnUnsubscribe(func())
+}
+
+// A Subscription that is already closed.
+type closedSubscription struct{}
+
+func (closedSubscription) Dispose()       {}
+func (closedSubscription) Disposed() bool { return true }
+
+// ClosedSubscription always returns true for Disposed()
+var ClosedSubscription Subscription = closedSubscription{}
+
+// A LinkedSubscription is a link to a (possible) future Subscription.
+type LinkedSubscription struct {
+	lock         sync.Mutex
+	unsubscribed bool
+	linked       Subscription
+}
+
+func NewLinkedSubscription() *LinkedSubscription {
+	return &LinkedSubscription{}
+}
+
+func (l *LinkedSubscription) Link(subscription Subscription) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.linked != nil {
+		panic("LinkedSubscription is already linked")
+	}
+	l.linked = subscription
+	if l.unsubscribed {
+		l.linked.Dispose()
+	}
+}
+
+func (l *LinkedSubscription) Dispose() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.unsubscribed = true
+	if l.linked != nil {
+		l.linked.Dispose()
+	}
+}
+
+func (l *LinkedSubscription) Disposed() bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.linked != nil {
+		return l.linked.Disposed()
+	}
+	return l.unsubscribed
+}
+
+// A SerialSubscription holds a replaceable Subscription. Disposing it disposes
+// the current Subscription and any set afterwards.
+type SerialSubscription struct {
+	lock     sync.Mutex
+	disposed bool
+	current  Subscription
+}
+
+func NewSerialSubscription() *SerialSubscription {
+	return &SerialSubscription{}
+}
+
+// Set replaces the current Subscription, disposing the previous one.
+func (s *SerialSubscription) Set(subscription Subscription) {
+	s.lock.Lock()
+	if s.disposed {
+		s.lock.Unlock()
+		subscription.Dispose()
+		return
+	}
+	previous := s.current
+	s.current = subscription
+	s.lock.Unlock()
+	if previous != nil {
+		previous.Dispose()
+	}
+}
+
+func (s *SerialSubscription) Dispose() {
+	s.lock.Lock()
+	s.disposed = true
+	current := s.current
+	s.current = nil
+	s.lock.Unlock()
+	if current != nil {
+		current.Dispose()
+	}
+}
+
+func (s *SerialSubscription) Disposed() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.disposed
+}
+
+// ChannelSubscription is implemented with a channel which is closed when
+// unsubscribed.
+type ChannelSubscription chan struct{}
+
+func NewChannelSubscription() ChannelSubscription {
+	return make(ChannelSubscription)
+}
+
+func (c ChannelSubscription) Dispose() {
+	// Disposing more than once is a no-op.
+	defer func() { recover() }()
+	close(c)
+}
+
+func (c ChannelSubscription) Disposed() bool {
+	select {
+	case _, ok := <-c:
+		return !ok
+	default:
+		return false
+	}
+}
+
+func (c ChannelSubscription) OnUnsubscribe(handler func()) {
+	go func() {
+		<-c
+		handler()
+	}()
+}
+
+// GenericSubscription is implemented with atomic operations.
+type GenericSubscription int32
+
+func NewGenericSubscription() Subscription {
+	return new(GenericSubscription)
+}
+
+func (t *GenericSubscription) Dispose() {
+	atomic.StoreInt32((*int32)(t), 1)
+}
+
+func (t *GenericSubscription) Disposed() bool {
+	return atomic.LoadInt32((*int32)(t)) == 1
+}
+
+type CallbackSubscription func()
+
+func (c *CallbackSubscription) Dispose() {
+	if *c != nil {
+		(*c)()
+		*c = nil
+	}
+}
+
+func (c *CallbackSubscription) Disposed() bool {
+	return *c == nil
+}
+
+// TerminationObserver contains functions for observing termination of a stream.
+type TerminationObserver interface {
+	Error(error)
+	Complete()
+}
+
+type GenericObserver interface {
+	TerminationObserver
+	Next(interface{})
+}
+
+type GenericObserverFunc struct {
+	f func(interface{}, error, bool)
+	Subscription
+}
+
+func NewGenericObserverFunc(f func(interface{}, error, bool)) *GenericObserverFunc {
+	return &GenericObserverFunc{f, NewGenericSubscription()}
+}
+
+func (f *GenericObserverFunc) Next(next interface{}) { f.f(next, nil, false) }
+func (f *GenericObserverFunc) Error(err error)       { f.f(nil, err, false) }
+func (f *GenericObserverFunc) Complete()             { f.f(nil, nil, true) }
+
+// GenericObservable is implemented by streams of every type, allowing a
+// stream of any type to be used as a signal, eg. for TakeUntil.
+type GenericObservable interface {
+	SubscribeGeneric(GenericObserver) Subscription
+}
+
+type GenericObservableFilter func(next interface{}, err error, complete bool, observer GenericObserver)
+
+// Turtles all the way down!
+type GenericObservableFilterFactory func(GenericObserver) GenericObservableFilter
+
+func ignoreCompletionFilter() GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				break
+			default:
+				observer.Next(next)
+			}
+		}
+	}
+}
+
+func distinctFilter() GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		seen := map[interface{}]struct{}{}
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if _, ok := seen[next]; ok {
+					return
+				}
+				seen[next] = struct{}{}
+				observer.Next(next)
+			}
+		}
+	}
+}
+
+// distinctDeepEqualFilter is distinctFilter for types whose values may not be
+// comparable, such as slices or interfaces. Those values are compared with
+// reflect.DeepEqual.
+func distinctDeepEqualFilter() GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		seen := map[interface{}]struct{}{}
+		// Values that are not comparable (slices, maps, funcs) can't be map keys.
+		var unhashable []interface{}
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if !reflect.ValueOf(next).Comparable() {
+					for _, v := range unhashable {
+						if reflect.DeepEqual(v, next) {
+							return
+						}
+					}
+					unhashable = append(unhashable, next)
+					observer.Next(next)
+					return
+				}
+				if _, ok := seen[next]; ok {
+					return
+				}
+				seen[next] = struct{}{}
+				observer.Next(next)
+			}
+		}
+	}
+}
+
+func elementAtFilter(n int) GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		i := 0
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if i == n {
+					observer.Next(next)
+				}
+				i++
+			}
+		}
+	}
+}
+
+func filterFilter(f func(next interface{}) bool) GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if f(next) {
+					observer.Next(next)
+				}
+			}
+		}
+	}
+}
+
+func firstFilter() GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		start := true
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if start {
+					observer.Next(next)
+				}
+				start = false
+			}
+		}
+	}
+}
+
+func lastFilter() GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		have := false
+		var last interface{}
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				if have {
+					observer.Next(last)
+				}
+				observer.Error(err)
+			case complete:
+				if have {
+					observer.Next(last)
+				}
+				observer.Complete()
+			default:
+				last = next
+				have = true
+			}
+		}
+	}
+}
+
+func takeLastFilter(n int) GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		read := 0
+		write := 0
+		n++
+		buffer := make([]interface{}, n)
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				for read != write {
+					observer.Next(buffer[read])
+					read = (read + 1) % n
+				}
+				observer.Error(err)
+			case complete:
+				for read != write {
+					observer.Next(buffer[read])
+					read = (read + 1) % n
+				}
+				observer.Complete()
+			default:
+				buffer[write] = next
+				write = (write + 1) % n
+				if write == read {
+					read = (read + 1) % n
+				}
+			}
+		}
+	}
+}
+
+func takeFilter(n int) GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		taken := 0
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			if taken >= n {
+				return
+			}
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				observer.Next(next)
+				taken++
+				if taken >= n {
+					observer.Complete()
+				}
+			}
+		}
+	}
+}
+
+func ignoreElementsFilter() GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			}
+		}
+	}
+}
+
+func skipFilter(n int) GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		i := 0
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if i >= n {
+					observer.Next(next)
+				}
+				i++
+			}
+		}
+	}
+}
+
+func skipLastFilter(n int) GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		read := 0
+		write := 0
+		n++
+		buffer := make([]interface{}, n)
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				buffer[write] = next
+				write = (write + 1) % n
+				if write == read {
+					observer.Next(buffer[read])
+					read = (read + 1) % n
+				}
+			}
+		}
+	}
+}
+
+func oneFilter() GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		count := 0
+		var value interface{}
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			if count > 1 {
+				return
+			}
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				if count != 1 {
+					observer.Error(errors.New("expected one value"))
+				} else {
+					observer.Next(value)
+					observer.Complete()
+				}
+			default:
+				count++
+				if count == 2 {
+					observer.Error(errors.New("expected one value"))
+					return
+				}
+				value = next
+			}
+		}
+	}
+}
+
+func sortedFilter(less func(a, b interface{}) bool) GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		values := []interface{}{}
+		flush := func(observer GenericObserver) {
+			sort.SliceStable(values, func(i, j int) bool { return less(values[i], values[j]) })
+			for _, v := range values {
+				observer.Next(v)
+			}
+		}
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				flush(observer)
+				observer.Error(err)
+			case complete:
+				flush(observer)
+				observer.Complete()
+			default:
+				values = append(values, next)
+			}
+		}
+	}
+}
+
+func sampleFilter(window time.Duration) GenericObservableFilterFactory {
+	return func(observer GenericObserver) GenericObservableFilter {
+		mutex := &sync.Mutex{}
+		cancel := make(chan bool, 1)
+		var last interface{}
+		haveNew := false
+		go func() {
+			for {
+				select {
+				case <-time.After(window):
+					mutex.Lock()
+					if haveNew {
+						observer.Next(last)
+						haveNew = false
+					}
+					mutex.Unlock()
+				case <-cancel:
+					return
+				}
+			}
+		}()
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				cancel <- true
+				observer.Error(err)
+			case complete:
+				cancel <- true
+				observer.Complete()
+			default:
+				mutex.Lock()
+				last = next
+				haveNew = true
+				mutex.Unlock()
+			}
+		}
+	}
+}
+
+func debounceFilter(duration time.Duration) GenericObservableFilterFactory {
+	return func(observer GenericObserver) GenericObservableFilter {
+		errch := make(chan error)
+		completech := make(chan bool)
+		valuech := make(chan interface{})
+		go func() {
+			var timeout <-chan time.Time
+			var nextValue interface{}
+			for {
+				select {
+				case <-timeout:
+					observer.Next(nextValue)
+					timeout = nil
+				case nextValue = <-valuech:
+					timeout = time.After(duration)
+				case err := <-errch:
+					if timeout != nil {
+						observer.Next(nextValue)
+					}
+					observer.Error(err)
+					return
+				case <-completech:
+					if timeout != nil {
+						observer.Next(nextValue)
+					}
+					observer.Complete()
+					return
+				}
+			}
+		}()
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				errch <- err
+			case complete:
+				completech <- true
+			default:
+				valuech <- next
+			}
+		}
+	}
+}
+
+// deepEqual is reflect.DeepEqual. Files generated for each type use it so
+// that only the core file needs to import reflect.
+func deepEqual(a, b interface{}) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+type delayedEntry struct {
+	next     interface{}
+	complete bool
+	due      time.Time
+}
+
+// delayQueue emits values, and completion, to an observer once they are due,
+// preserving order. Errors are delivered immediately.
+type delayQueue struct {
+	mutex    sync.Mutex
+	observer GenericObserver
+	wake     chan bool
+	stop     chan bool
+	queue    []delayedEntry
+	done     bool
+	last     time.Time
+}
+
+func newDelayQueue(observer GenericObserver) *delayQueue {
+	q := &delayQueue{
+		observer: observer,
+		wake:     make(chan bool, 1),
+		stop:     make(chan bool),
+	}
+	go q.run()
+	return q
+}
+
+func (q *delayQueue) run() {
+	for {
+		q.mutex.Lock()
+		for len(q.queue) == 0 && !q.done {
+			q.mutex.Unlock()
+			select {
+			case <-q.wake:
+			case <-q.stop:
+				return
+			}
+			q.mutex.Lock()
+		}
+		if q.done {
+			q.mutex.Unlock()
+			return
+		}
+		entry := q.queue[0]
+		q.mutex.Unlock()
+		timer := time.NewTimer(entry.due.Sub(time.Now()))
+		select {
+		case <-timer.C:
+		case <-q.stop:
+			timer.Stop()
+			return
+		}
+		q.mutex.Lock()
+		if q.done {
+			q.mutex.Unlock()
+			return
+		}
+		q.queue = q.queue[1:]
+		if entry.complete {
+			q.done = true
+			q.observer.Complete()
+		} else {
+			q.observer.Next(entry.next)
+		}
+		q.mutex.Unlock()
+	}
+}
+
+func (q *delayQueue) enqueue(entry delayedEntry, delay time.Duration) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if q.done {
+		return
+	}
+	// Never schedule before an earlier entry, so order is preserved.
+	entry.due = time.Now().Add(delay)
+	if entry.due.Before(q.last) {
+		entry.due = q.last
+	}
+	q.last = entry.due
+	q.queue = append(q.queue, entry)
+	select {
+	case q.wake <- true:
+	default:
+	}
+}
+
+func (q *delayQueue) error(err error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if !q.done {
+		q.observer.Error(err)
+	}
+	q.terminate()
+}
+
+// dispose discards any pending entries and stops the queue.
+func (q *delayQueue) dispose() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.terminate()
+}
+
+// terminate must be called with mutex held.
+func (q *delayQueue) terminate() {
+	q.done = true
+	q.queue = nil
+	select {
+	case <-q.stop:
+	default:
+		close(q.stop)
+	}
+}
+
+type timedEntry struct {
+	v interface{}
+	t time.Time
+}
+
+func replayFilter(size int, duration time.Duration) GenericObservableFilterFactory {
+	read := 0
+	write := 0
+	if size == 0 {
+		size = MaxReplaySize
+	}
+	if duration == 0 {
+		duration = time.Hour * 24 * 7 * 52
+	}
+	size++
+	buffer := make([]timedEntry, size)
+	return func(observer GenericObserver) GenericObservableFilter {
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			now := time.Now()
+			switch {
+			case err != nil:
+				cursor := read
+				for cursor != write {
+					if buffer[cursor].t.After(now) {
+						observer.Next(buffer[cursor].v)
+					}
+					cursor = (cursor + 1) % size
+				}
+				observer.Error(err)
+			case complete:
+				cursor := read
+				for cursor != write {
+					if buffer[cursor].t.After(now) {
+						observer.Next(buffer[cursor].v)
+					}
+					cursor = (cursor + 1) % size
+				}
+				observer.Complete()
+			default:
+				buffer[write] = timedEntry{next, time.Now().Add(duration)}
+				write = (write + 1) % size
+				if write == read {
+					if buffer[read].t.After(now) {
+						observer.Next(buffer[read].v)
+					}
+					read = (read + 1) % size
+				}
+			}
+		}
+	}
+}
+
+// A RetryPolicy decides whether, and after how long, to resubscribe to a
+// stream after attempt consecutive errors. attempt starts at 1, and is reset
+// whenever the stream emits a value.
+type RetryPolicy func(err error, attempt int) (time.Duration, bool)
+
+// ExponentialBackoff returns a RetryPolicy that always retries. The delay
+// starts at initial and is multiplied by multiplier after each attempt, up to
+// max. Each delay is then randomly adjusted by up to +/- jitter (0.0-1.0) of
+// itself.
+func ExponentialBackoff(initial, max time.Duration, multiplier, jitter float64) RetryPolicy {
+	return func(err error, attempt int) (time.Duration, bool) {
+		delay := float64(initial) * math.Pow(multiplier, float64(attempt-1))
+		if delay > float64(max) {
+			delay = float64(max)
+		}
+		delay += delay * jitter * (rand.Float64()*2 - 1)
+		return time.Duration(delay), true
+	}
+}
+
+// NotificationKind is the kind of event a materialized notification represents.
+type NotificationKind int
+
+const (
+	NotificationNext NotificationKind = iota
+	NotificationError
+	NotificationComplete
+)
+
+func (n NotificationKind) String() string {
+	switch n {
+	case NotificationNext:
+		return "Next"
+	case NotificationError:
+		return "Error"
+	case NotificationComplete:
+		return "Complete"
+	}
+	return "NotificationKind(" + strconv.Itoa(int(n)) + ")"
+}
+
+// A DecodeErrorPolicy is called by decoders with each line that could not be
+// decoded. If it returns nil the line is skipped, otherwise the stream
+// terminates with the returned error.
+type DecodeErrorPolicy func(line []byte, err error) error
+
+// FailOnDecodeError terminates the stream with the decode error.
+func FailOnDecodeError(line []byte, err error) error {
+	return err
+}
+
+// SkipDecodeErrors skips lines that could not be decoded.
+func SkipDecodeErrors(line []byte, err error) error {
+	return nil
+}
+
+func Range(start, count int) *IntStream {
+	end := start + count
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		for i := start; i < end; i++ {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(i)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func Interval(interval time.Duration) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		i := 0
+		for {
+			time.Sleep(interval)
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(i)
+			i++
+		}
+	})
+}
+
+// Timer emits 0 after delay, then completes.
+func Timer(delay time.Duration) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		time.Sleep(delay)
+		if subscription.Disposed() {
+			return
+		}
+		observer.Next(0)
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+// TimerPeriodic emits 0 after delay, then an incrementing count every period.
+func TimerPeriodic(delay, period time.Duration) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		time.Sleep(delay)
+		i := 0
+		for {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(i)
+			i++
+			time.Sleep(period)
+		}
+	})
+}
+
+type InterfaceObserver interface {
+	Next(interface{})
+	TerminationObserver
+}
+
+// A InterfaceSubscriber represents a subscribed InterfaceObserver.
+type InterfaceSubscriber interface {
+	Subscription
+	InterfaceObserver
+}
+
+type implInterfaceSubscriber struct {
+	Subscription
+	InterfaceObserver
+}
+
+func InterfaceObserverAsGenericObserver(observer InterfaceObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			// A nil interface value can't be type asserted.
+			value, _ := next.(interface{})
+			observer.Next(value)
+		}
+	})
+}
+
+func GenericObserverAsInterfaceObserver(observer GenericObserver) InterfaceObserver {
+	return InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type InterfaceObservableFactory func(observer InterfaceObserver, subscription Subscription)
+
+func (f InterfaceObservableFactory) Subscribe(observer InterfaceObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateInterface calls f(observer, subscription) to produce values for a stream.
+func CreateInterface(f func(observer InterfaceObserver, subscription Subscription)) *InterfaceStream {
+	return FromInterfaceObservable(InterfaceObservableFactory(f))
+}
+
+// Repeat value count times.
+func RepeatInterface(value interface{}, count int) *InterfaceStream {
+	return CreateInterface(func(observer InterfaceObserver, subscription Subscription) {
+		for i := 0; i < count; i++ {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(value)
+		}
+		observer.Complete()
+	})
+}
+
+// StartInterface is designed to be used with functions that return a
+// (interface{}, error) tuple.
+//
+// If the error is non-nil the returned InterfaceStream will be that error,
+// otherwise it will be a single-value stream of interface{}.
+func StartInterface(f func() (interface{}, error)) *InterfaceStream {
+	return CreateInterface(func(observer InterfaceObserver, subscription Subscription) {
+		if v, err := f(); err != nil {
+			observer.Error(err)
+		} else {
+			observer.Next(v)
+			observer.Complete()
+		}
+	})
+}
+
+type deferInterfaceObservable func() InterfaceObservable
+
+func (f deferInterfaceObservable) Subscribe(observer InterfaceObserver) Subscription {
+	return f().Subscribe(observer)
+}
+
+// DeferInterface calls f to create a fresh observable for each subscription.
+func DeferInterface(f func() InterfaceObservable) *InterfaceStream {
+	return FromInterfaceObservable(deferInterfaceObservable(f))
+}
+func PassthroughInterface(next interface{}, err error, complete bool, observer InterfaceObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroInterface = *new(interface{})
+
+type InterfaceObserverFunc func(interface{}, error, bool)
+
+func (f InterfaceObserverFunc) Next(next interface{}) { f(next, nil, false) }
+func (f InterfaceObserverFunc) Error(err error)       { f(zeroInterface, err, false) }
+func (f InterfaceObserverFunc) Complete()             { f(zeroInterface, nil, true) }
+
+type InterfaceObservable interface {
+	Subscribe(InterfaceObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a InterfaceObservable
+func (f GenericObservableFilterFactory) Interface(parent InterfaceObservable) InterfaceObservable {
+	return MapInterface2InterfaceObservable(parent, func(observer InterfaceObserver) MappingInterface2InterfaceFunc {
+		gobserver := InterfaceObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next interface{}, err error, complete bool, observer InterfaceObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func NeverInterface() *InterfaceStream {
+	return CreateInterface(func(observer InterfaceObserver, subscription Subscription) {})
+}
+
+func EmptyInterface() *InterfaceStream {
+	return CreateInterface(func(observer InterfaceObserver, subscription Subscription) {
+		observer.Complete()
+	})
+}
+
+func ThrowInterface(err error) *InterfaceStream {
+	return CreateInterface(func(observer InterfaceObserver, subscription Subscription) {
+		observer.Error(err)
+	})
+}
+func FromInterfaceArray(array []interface{}) *InterfaceStream {
+	return CreateInterface(func(observer InterfaceObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromInterfaces(array ...interface{}) *InterfaceStream {
+	return FromInterfaceArray(array)
+}
+
+// FromInterfaceSeq emits each value yielded by seq, iterating it once per
+// subscription.
+func FromInterfaceSeq(seq iter.Seq[interface{}]) *InterfaceStream {
+	return CreateInterface(func(observer InterfaceObserver, subscription Subscription) {
+		for v := range seq {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+func JustInterface(element interface{}) *InterfaceStream {
+	return FromInterfaceArray([]interface{}{element})
+}
+
+func MergeInterface(observables ...InterfaceObservable) *InterfaceStream {
+	if len(observables) == 0 {
+		return EmptyInterface()
+	}
+	return (&InterfaceStream{observables[0]}).Merge(observables[1:]...)
+}
+
+func MergeInterfaceDelayError(observables ...InterfaceObservable) *InterfaceStream {
+	if len(observables) == 0 {
+		return EmptyInterface()
+	}
+	return (&InterfaceStream{observables[0]}).MergeDelayError(observables[1:]...)
+}
+
+type ambInterfaceObservable []InterfaceObservable
+
+func (a ambInterfaceObservable) Subscribe(observer InterfaceObserver) Subscription {
+	lock := sync.Mutex{}
+	winner := -1
+	subscriptions := make([]*LinkedSubscription, len(a))
+	for i := range subscriptions {
+		subscriptions[i] = NewLinkedSubscription()
+	}
+	for i, observable := range a {
+		i := i
+		subscriptions[i].Link(observable.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+			lock.Lock()
+			if winner == -1 {
+				winner = i
+				for j, subscription := range subscriptions {
+					if j != i {
+						subscription.Dispose()
+					}
+				}
+			}
+			won := winner == i
+			lock.Unlock()
+			if won {
+				PassthroughInterface(next, err, complete, observer)
+			}
+		})))
+	}
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		for _, s := range subscriptions {
+			s.Dispose()
+		}
+	})
+	return subscription
+}
+
+// AmbInterface subscribes to all observables and mirrors whichever emits
+// first, disposing the others.
+func AmbInterface(observables ...InterfaceObservable) *InterfaceStream {
+	if len(observables) == 0 {
+		return EmptyInterface()
+	}
+	return FromInterfaceObservable(ambInterfaceObservable(observables))
+}
+func FromInterfaceChannel(ch <-chan interface{}) *InterfaceStream {
+	return CreateInterface(func(observer InterfaceObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type InterfaceStream struct {
+	InterfaceObservable
+}
+
+func FromInterfaceObservable(observable InterfaceObservable) *InterfaceStream {
+	return &InterfaceStream{observable}
+}
+
+func (s *InterfaceStream) SubscribeFunc(f func(interface{}, error, bool)) Subscription {
+	return s.Subscribe(InterfaceObserverFunc(f))
+}
+
+func (s *InterfaceStream) SubscribeNext(f func(v interface{})) Subscription {
+	return s.SubscribeFunc(func(next interface{}, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *InterfaceStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsInterfaceObserver(observer))
+}
+
+// Distinct removes duplicate elements in the stream. Values that can not be
+// map keys, such as slices, are compared with reflect.DeepEqual.
+func (s *InterfaceStream) Distinct() *InterfaceStream {
+	return FromInterfaceObservable(distinctDeepEqualFilter().Interface(s))
+}
+
+// ElementAt yields the Nth element of the stream.
+func (s *InterfaceStream) ElementAt(n int) *InterfaceStream {
+	return FromInterfaceObservable(elementAtFilter(n).Interface(s))
+}
+
+// Filter elements in the stream on a function.
+func (s *InterfaceStream) Filter(f func(interface{}) bool) *InterfaceStream {
+	return FromInterfaceObservable(filterFilter(func(v interface{}) bool {
+		value, _ := v.(interface{})
+		return f(value)
+	}).Interface(s))
+}
+
+// Last returns just the first element of the stream.
+func (s *InterfaceStream) First() *InterfaceStream {
+	return FromInterfaceObservable(firstFilter().Interface(s))
+}
+
+// Last returns just the last element of the stream.
+func (s *InterfaceStream) Last() *InterfaceStream {
+	return FromInterfaceObservable(lastFilter().Interface(s))
+}
+
+// SkipLast skips the first N elements of the stream.
+func (s *InterfaceStream) Skip(n int) *InterfaceStream {
+	return FromInterfaceObservable(skipFilter(n).Interface(s))
+}
+
+// SkipLast skips the last N elements of the stream.
+func (s *InterfaceStream) SkipLast(n int) *InterfaceStream {
+	return FromInterfaceObservable(skipLastFilter(n).Interface(s))
+}
+
+// Take returns just the first N elements of the stream.
+func (s *InterfaceStream) Take(n int) *InterfaceStream {
+	return FromInterfaceObservable(takeFilter(n).Interface(s))
+}
+
+// TakeLast returns just the last N elements of the stream.
+func (s *InterfaceStream) TakeLast(n int) *InterfaceStream {
+	return FromInterfaceObservable(takeLastFilter(n).Interface(s))
+}
+
+type takeWhileInterface struct {
+	parent InterfaceObservable
+	f      func(interface{}) bool
+}
+
+func (t *takeWhileInterface) Subscribe(observer InterfaceObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	parent.Link(t.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Complete()
+		case t.f(next):
+			observer.Next(next)
+		default:
+			done = true
+			observer.Complete()
+			parent.Dispose()
+		}
+	})))
+	return parent
+}
+
+// TakeWhile returns elements of the stream until f returns false, then
+// completes and disposes the stream.
+func (s *InterfaceStream) TakeWhile(f func(interface{}) bool) *InterfaceStream {
+	return &InterfaceStream{&takeWhileInterface{s, f}}
+}
+
+// SkipWhile skips elements of the stream until f returns false.
+func (s *InterfaceStream) SkipWhile(f func(interface{}) bool) *InterfaceStream {
+	return FromInterfaceObservable(MapInterface2InterfaceObservable(s, func(InterfaceObserver) MappingInterface2InterfaceFunc {
+		skipping := true
+		return func(next interface{}, err error, complete bool, observer InterfaceObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if skipping && !f(next) {
+					skipping = false
+				}
+				if !skipping {
+					observer.Next(next)
+				}
+			}
+		}
+	}))
+}
+
+// IgnoreElements ignores elements of the stream and emits only the completion events.
+func (s *InterfaceStream) IgnoreElements() *InterfaceStream {
+	return FromInterfaceObservable(ignoreElementsFilter().Interface(s))
+}
+
+func (s *InterfaceStream) Replay(size int, duration time.Duration) *InterfaceStream {
+	return FromInterfaceObservable(replayFilter(size, duration).Interface(s))
+}
+
+func (s *InterfaceStream) Sample(duration time.Duration) *InterfaceStream {
+	return FromInterfaceObservable(sampleFilter(duration).Interface(s))
+}
+
+func (s *InterfaceStream) Debounce(duration time.Duration) *InterfaceStream {
+	return FromInterfaceObservable(debounceFilter(duration).Interface(s))
+}
+
+type delayInterface struct {
+	parent     InterfaceObservable
+	delay      func(interface{}) time.Duration
+	completion time.Duration
+}
+
+func (d *delayInterface) Subscribe(observer InterfaceObserver) Subscription {
+	queue := newDelayQueue(InterfaceObserverAsGenericObserver(observer))
+	parent := d.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			queue.error(err)
+		case complete:
+			queue.enqueue(delayedEntry{complete: true}, d.completion)
+		default:
+			queue.enqueue(delayedEntry{next: next}, d.delay(next))
+		}
+	}))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		queue.dispose()
+	})
+	return subscription
+}
+
+// Delay shifts each value, and completion, forward in time by duration. Errors
+// are not delayed. Values that are still pending are discarded on disposal.
+func (s *InterfaceStream) Delay(duration time.Duration) *InterfaceStream {
+	return &InterfaceStream{&delayInterface{s, func(interface{}) time.Duration { return duration }, duration}}
+}
+
+// DelayWhen shifts each value forward in time by the duration returned by f.
+// Values are never reordered, so a value is emitted no earlier than the value
+// before it. Completion is emitted after the last value. Errors are not delayed.
+func (s *InterfaceStream) DelayWhen(f func(interface{}) time.Duration) *InterfaceStream {
+	return &InterfaceStream{&delayInterface{s, f, 0}}
+}
+
+// Wait for completion of the stream and return any error.
+func (s *InterfaceStream) Wait() error {
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			errch <- nil
+		default:
+		}
+	})
+	return <-errch
+}
+
+func MakeInterfaceSubscriber(observer InterfaceObserver) InterfaceSubscriber {
+	if subscriber, ok := observer.(InterfaceSubscriber); ok {
+		return subscriber
+	}
+	return &implInterfaceSubscriber{NewGenericSubscription(), observer}
+}
+
+type concatInterfaceSubscriber struct {
+	observable  int
+	observer    InterfaceObserver
+	observables []InterfaceObservable
+	Subscription
+}
+
+func (c *concatInterfaceSubscriber) Next(next interface{}) {
+	c.observer.Next(next)
+}
+
+func (c *concatInterfaceSubscriber) Error(err error) {
+	c.observer.Error(err)
+	c.observable = len(c.observables)
+	c.Dispose()
+}
+
+func (c *concatInterfaceSubscriber) Complete() {
+	c.observable++
+	if c.observable >= len(c.observables) {
+		c.observer.Complete()
+		c.Dispose()
+		return
+	}
+	c.observables[c.observable].Subscribe(c)
+}
+
+type concatInterfaceObservable struct {
+	observables []InterfaceObservable
+}
+
+func (m *concatInterfaceObservable) Subscribe(observer InterfaceObserver) Subscription {
+	if len(m.observables) == 0 {
+		observer.Complete()
+		return ClosedSubscription
+	}
+	subscriber := &concatInterfaceSubscriber{
+		observer:     observer,
+		Subscription: NewGenericSubscription(),
+		observables:  m.observables,
+	}
+	m.observables[0].Subscribe(subscriber)
+	return subscriber
+}
+
+func (s *InterfaceStream) Concat(observables ...InterfaceObservable) *InterfaceStream {
+	return &InterfaceStream{&concatInterfaceObservable{append([]InterfaceObservable{s}, observables...)}}
+}
+
+// StartWith emits values before the values of the stream.
+func (s *InterfaceStream) StartWith(values ...interface{}) *InterfaceStream {
+	return FromInterfaceArray(values).Concat(s)
+}
+
+// EndWith emits values after the stream completes.
+func (s *InterfaceStream) EndWith(values ...interface{}) *InterfaceStream {
+	return s.Concat(FromInterfaceArray(values))
+}
+
+// DefaultIfEmpty emits value if the stream completes without emitting any values.
+func (s *InterfaceStream) DefaultIfEmpty(value interface{}) *InterfaceStream {
+	return FromInterfaceObservable(MapInterface2InterfaceObservable(s, func(InterfaceObserver) MappingInterface2InterfaceFunc {
+		empty := true
+		return func(next interface{}, err error, complete bool, observer InterfaceObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				if empty {
+					observer.Next(value)
+				}
+				observer.Complete()
+			default:
+				empty = false
+				observer.Next(next)
+			}
+		}
+	}))
+}
+
+type switchIfEmptyInterfaceObservable struct {
+	parent InterfaceObservable
+	other  InterfaceObservable
+}
+
+func (e *switchIfEmptyInterfaceObservable) Subscribe(observer InterfaceObserver) Subscription {
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
+	empty := true
+	link.Link(e.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			if !empty {
+				observer.Complete()
+			} else if !subscription.Disposed() {
+				subscription.Set(e.other.Subscribe(observer))
+			}
+		default:
+			empty = false
+			observer.Next(next)
+		}
+	})))
+	return subscription
+}
+
+// SwitchIfEmpty switches to other if the stream completes without emitting any values.
+func (s *InterfaceStream) SwitchIfEmpty(other InterfaceObservable) *InterfaceStream {
+	return &InterfaceStream{&switchIfEmptyInterfaceObservable{s, other}}
+}
+
+type mergeInterfaceObservable struct {
+	delayError  bool
+	observables []InterfaceObservable
+}
+
+func (m *mergeInterfaceObservable) Subscribe(observer InterfaceObserver) Subscription {
+	subscription := NewGenericSubscription()
+	lock := sync.Mutex{}
+	completed := 0
+	var firstError error
+	relay := func(next interface{}, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if completed >= len(m.observables) {
+			return
+		}
+
+		switch {
+		case err != nil:
+			if m.delayError {
+				firstError = err
+				completed++
+			} else {
+				observer.Error(err)
+				completed = len(m.observables)
+			}
+
+		case complete:
+			completed++
+			if completed == len(m.observables) {
+				if firstError != nil {
+					observer.Error(firstError)
+				} else {
+					observer.Complete()
+				}
+			}
+		default:
+			observer.Next(next)
+		}
+	}
+	for _, observable := range m.observables {
+		observable.Subscribe(InterfaceObserverFunc(relay))
+	}
+	return subscription
+}
+
+// Merge an arbitrary number of observables with this one.
+// An error from any of the observables will terminate the merged stream.
+func (s *InterfaceStream) Merge(other ...InterfaceObservable) *InterfaceStream {
+	if len(other) == 0 {
+		return s
+	}
+	return &InterfaceStream{&mergeInterfaceObservable{false, append(other, s)}}
+}
+
+// Merge an arbitrary number of observables with this one.
+// Any error will be deferred until all observables terminate.
+func (s *InterfaceStream) MergeDelayError(other ...InterfaceObservable) *InterfaceStream {
+	if len(other) == 0 {
+		return s
+	}
+	return &InterfaceStream{&mergeInterfaceObservable{true, append(other, s)}}
+}
+
+// Amb mirrors whichever of this stream and others emits first, disposing the rest.
+func (s *InterfaceStream) Amb(others ...InterfaceObservable) *InterfaceStream {
+	return AmbInterface(append([]InterfaceObservable{s}, others...)...)
+}
+
+type catchInterfaceObservable struct {
+	parent InterfaceObservable
+	catch  func(err error) InterfaceObservable
+	// Also switch to the fallback when the parent completes. err will be nil.
+	resume bool
+}
+
+func (r *catchInterfaceObservable) Subscribe(observer InterfaceObserver) Subscription {
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
+	run := func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil || (complete && r.resume):
+			if !subscription.Disposed() {
+				subscription.Set(r.catch(err).Subscribe(observer))
+			}
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	}
+	link.Link(r.parent.Subscribe(InterfaceObserverFunc(run)))
+	return subscription
+}
+
+// Catch switches to the catch observable if the stream errors.
+func (s *InterfaceStream) Catch(catch InterfaceObservable) *InterfaceStream {
+	return s.CatchFunc(func(error) InterfaceObservable { return catch })
+}
+
+// CatchFunc switches to the observable returned by f(err) if the stream errors.
+func (s *InterfaceStream) CatchFunc(f func(err error) InterfaceObservable) *InterfaceStream {
+	return &InterfaceStream{&catchInterfaceObservable{parent: s, catch: f}}
+}
+
+// OnErrorReturn emits the single value returned by f(err) and completes if the stream errors.
+func (s *InterfaceStream) OnErrorReturn(f func(err error) interface{}) *InterfaceStream {
+	return s.CatchFunc(func(err error) InterfaceObservable { return JustInterface(f(err)) })
+}
+
+// OnErrorResumeNext switches to next when the stream terminates, whether it
+// completes or errors.
+func (s *InterfaceStream) OnErrorResumeNext(next InterfaceObservable) *InterfaceStream {
+	return &InterfaceStream{&catchInterfaceObservable{
+		parent: s,
+		catch:  func(error) InterfaceObservable { return next },
+		resume: true,
+	}}
+}
+
+type retryInterfaceObservable struct {
+	observable InterfaceObservable
+	policy     RetryPolicy
+}
+
+func (r *retryInterfaceObservable) Subscribe(observer InterfaceObserver) Subscription {
+	subscription := NewSerialSubscription()
+	attempt := 0
+	var subscribe func()
+	subscribe = func() {
+		// Set the link before subscribing, in case the observable fails
+		// and is resubscribed before Subscribe returns.
+		link := NewLinkedSubscription()
+		subscription.Set(link)
+		link.Link(r.observable.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+			switch {
+			case err != nil:
+				if subscription.Disposed() {
+					return
+				}
+				attempt++
+				delay, ok := r.policy(err, attempt)
+				switch {
+				case !ok:
+					observer.Error(err)
+				case delay > 0:
+					time.AfterFunc(delay, func() {
+						if !subscription.Disposed() {
+							subscribe()
+						}
+					})
+				default:
+					subscribe()
+				}
+			case complete:
+				observer.Complete()
+			default:
+				attempt = 0
+				observer.Next(next)
+			}
+		})))
+	}
+	subscribe()
+	return subscription
+}
+
+// Retry resubscribes to the stream immediately, and indefinitely, on error.
+func (s *InterfaceStream) Retry() *InterfaceStream {
+	return s.RetryWhen(func(error, int) (time.Duration, bool) { return 0, true })
+}
+
+// RetryN resubscribes to the stream on error, at most n times in a row without
+// the stream emitting a value in between.
+func (s *InterfaceStream) RetryN(n int) *InterfaceStream {
+	return s.RetryWhen(func(err error, attempt int) (time.Duration, bool) { return 0, attempt <= n })
+}
+
+// RetryWhen calls policy on each error to decide whether, and after how long,
+// to resubscribe to the stream. attempt counts consecutive errors, starting at
+// 1 and reset whenever the stream emits a value.
+func (s *InterfaceStream) RetryWhen(policy func(err error, attempt int) (time.Duration, bool)) *InterfaceStream {
+	return &InterfaceStream{&retryInterfaceObservable{s, policy}}
+}
+
+// RetryWithBackoff resubscribes to the stream on error, indefinitely, with
+// an ExponentialBackoff delay. The delay is reset to initial whenever the
+// stream emits a value.
+func (s *InterfaceStream) RetryWithBackoff(initial, max time.Duration, multiplier, jitter float64) *InterfaceStream {
+	return s.RetryWhen(ExponentialBackoff(initial, max, multiplier, jitter))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *InterfaceStream) Do(f func(next interface{})) *InterfaceStream {
+	return FromInterfaceObservable(MapInterface2InterfaceObserveNext(s, func(next interface{}) interface{} {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *InterfaceStream) DoOnError(f func(err error)) *InterfaceStream {
+	return FromInterfaceObservable(MapInterface2InterfaceObserveDirect(s, func(next interface{}, err error, complete bool, observer InterfaceObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughInterface(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *InterfaceStream) DoOnComplete(f func()) *InterfaceStream {
+	return FromInterfaceObservable(MapInterface2InterfaceObserveDirect(s, func(next interface{}, err error, complete bool, observer InterfaceObserver) {
+		if complete {
+			f()
+		}
+		PassthroughInterface(next, err, complete, observer)
+	}))
+}
+func (s *InterfaceStream) Reduce(initial interface{}, reducer func(interface{}, interface{}) interface{}) *InterfaceStream {
+	value := initial
+	return FromInterfaceObservable(MapInterface2InterfaceObserveDirect(s, func(next interface{}, err error, complete bool, observer InterfaceObserver) {
+		switch {
+		case err != nil:
+			observer.Next(value)
+			observer.Error(err)
+		case complete:
+			observer.Next(value)
+			observer.Complete()
+		default:
+			value = reducer(value, next)
+		}
+	}))
+}
+
+func (s *InterfaceStream) Scan(initial interface{}, f func(interface{}, interface{}) interface{}) *InterfaceStream {
+	value := initial
+	return FromInterfaceObservable(MapInterface2InterfaceObserveDirect(s, func(next interface{}, err error, complete bool, observer InterfaceObserver) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			value = f(value, next)
+			observer.Next(value)
+		}
+	}))
+}
+
+type timeoutInterface struct {
+	parent  InterfaceObservable
+	timeout time.Duration
+}
+
+func (t *timeoutInterface) Subscribe(observer InterfaceObserver) Subscription {
+	subscription := NewChannelSubscription()
+	cancel := t.parent.Subscribe(observer)
+	go func() {
+		select {
+		case <-time.After(t.timeout):
+			observer.Error(ErrTimeout)
+			cancel.Dispose()
+			subscription.Dispose()
+		case <-subscription:
+			cancel.Dispose()
+		}
+	}()
+	return subscription
+}
+
+func (s *InterfaceStream) Timeout(timeout time.Duration) *InterfaceStream {
+	return &InterfaceStream{&timeoutInterface{s, timeout}}
+}
+
+type delaySubscriptionInterface struct {
+	parent InterfaceObservable
+	delay  time.Duration
+}
+
+func (d *delaySubscriptionInterface) Subscribe(observer InterfaceObserver) Subscription {
+	subscription := NewLinkedSubscription()
+	time.AfterFunc(d.delay, func() {
+		if !subscription.Disposed() {
+			subscription.Link(d.parent.Subscribe(observer))
+		}
+	})
+	return subscription
+}
+
+// DelaySubscription waits for delay before subscribing to the stream.
+func (s *InterfaceStream) DelaySubscription(delay time.Duration) *InterfaceStream {
+	return &InterfaceStream{&delaySubscriptionInterface{s, delay}}
+}
+
+type takeUntilInterface struct {
+	parent InterfaceObservable
+	signal GenericObservable
+}
+
+func (t *takeUntilInterface) Subscribe(observer InterfaceObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			// A signal that completes without a value never ends the stream.
+			break
+		default:
+			terminate(observer.Complete)
+		}
+	})))
+	parent.Link(t.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// TakeUntil returns elements of the stream until signal, which may be a
+// stream of any type, emits a value. The stream then completes.
+func (s *InterfaceStream) TakeUntil(signal GenericObservable) *InterfaceStream {
+	return &InterfaceStream{&takeUntilInterface{s, signal}}
+}
+
+type skipUntilInterface struct {
+	parent InterfaceObservable
+	signal GenericObservable
+}
+
+func (t *skipUntilInterface) Subscribe(observer InterfaceObserver) Subscription {
+	lock := sync.Mutex{}
+	open := false
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			break
+		default:
+			lock.Lock()
+			open = true
+			lock.Unlock()
+			signal.Dispose()
+		}
+	})))
+	parent.Link(t.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if open && !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// SkipUntil skips elements of the stream until signal, which may be a stream
+// of any type, emits a value.
+func (s *InterfaceStream) SkipUntil(signal GenericObservable) *InterfaceStream {
+	return &InterfaceStream{&skipUntilInterface{s, signal}}
+}
+
+type forkedInterfaceStream struct {
+	lock      sync.Mutex
+	parent    InterfaceObservable
+	observers []InterfaceObserver
+}
+
+func (f *forkedInterfaceStream) Subscribe(observer InterfaceObserver) Subscription {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	i := len(f.observers)
+	f.observers = append(f.observers, observer)
+	sub := new(CallbackSubscription)
+	*sub = CallbackSubscription(func() {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		f.observers[i] = nil
+	})
+	return sub
+}
+
+// Fork replicates each event from the parent to every subscriber of the fork.
+func (s *InterfaceStream) Fork() *InterfaceStream {
+	f := &forkedInterfaceStream{parent: s}
+	go s.Subscribe(InterfaceObserverFunc(func(n interface{}, err error, complete bool) {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		for _, o := range f.observers {
+			if o == nil {
+				continue
+			}
+			switch {
+			case err != nil:
+				o.Error(err)
+			case complete:
+				o.Complete()
+			default:
+				o.Next(n)
+			}
+		}
+	}))
+	return &InterfaceStream{f}
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *InterfaceStream) ToOneWithError() (interface{}, error) {
+	valuech := make(chan interface{}, 1)
+	errch := make(chan error, 1)
+	FromInterfaceObservable(oneFilter().Interface(s)).SubscribeFunc(func(next interface{}, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroInterface, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *InterfaceStream) ToOne() interface{} {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *InterfaceStream) ToArrayWithError() ([]interface{}, error) {
+	array := []interface{}{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *InterfaceStream) ToArray() []interface{} {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *InterfaceStream) ToChannelWithError() (<-chan interface{}, <-chan error) {
+	ch := make(chan interface{}, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *InterfaceStream) ToChannel() <-chan interface{} {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *InterfaceStream) Seq() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *InterfaceStream) Seq2() iter.Seq2[interface{}, error] {
+	return func(yield func(interface{}, error) bool) {
+		type notification struct {
+			next     interface{}
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next interface{}, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroInterface, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+// Count returns an IntStream with the count of elements in this stream.
+func (s *InterfaceStream) Count() *IntStream {
+	count := 0
+	return FromIntObservable(MapInterface2IntObserveDirect(s, func(next interface{}, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			observer.Next(count)
+			observer.Error(err)
+		case complete:
+			observer.Next(count)
+			observer.Complete()
+		default:
+			count++
+		}
+	}))
+}
+
+func equalInterface(a, b interface{}) bool {
+	return deepEqual(a, b)
+}
+
+type decideInterface struct {
+	parent InterfaceObservable
+	// decide returns the result and true once the answer is known.
+	decide func(next interface{}) (bool, bool)
+	// otherwise is the result if the stream completes first.
+	otherwise bool
+}
+
+func (d *decideInterface) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	subscription := NewLinkedSubscription()
+	subscription.Link(d.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Next(d.otherwise)
+			observer.Complete()
+		default:
+			if result, ok := d.decide(next); ok {
+				done = true
+				observer.Next(result)
+				observer.Complete()
+				subscription.Dispose()
+			}
+		}
+	})))
+	return subscription
+}
+
+// All emits true if f returns true for every value in the stream, or false
+// as soon as it does not.
+func (s *InterfaceStream) All(f func(interface{}) bool) *BoolStream {
+	return FromBoolObservable(&decideInterface{s, func(next interface{}) (bool, bool) { return false, !f(next) }, true})
+}
+
+// Any emits true as soon as f returns true for a value in the stream, or
+// false if it never does.
+func (s *InterfaceStream) Any(f func(interface{}) bool) *BoolStream {
+	return FromBoolObservable(&decideInterface{s, func(next interface{}) (bool, bool) { return true, f(next) }, false})
+}
+
+// Contains emits true as soon as value is seen in the stream, or false if it
+// never is.
+func (s *InterfaceStream) Contains(value interface{}) *BoolStream {
+	return s.Any(func(next interface{}) bool { return equalInterface(next, value) })
+}
+
+// IsEmpty emits true if the stream completes without a value, or false as
+// soon as it emits one.
+func (s *InterfaceStream) IsEmpty() *BoolStream {
+	return FromBoolObservable(&decideInterface{s, func(interface{}) (bool, bool) { return false, true }, true})
+}
+
+type sequenceEqualInterface struct {
+	parent InterfaceObservable
+	other  InterfaceObservable
+}
+
+func (e *sequenceEqualInterface) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	// Values seen on one side that the other has not caught up with yet.
+	// Only one side can be ahead at a time.
+	pending := [2][]interface{}{}
+	completed := [2]bool{}
+	subscriptions := [2]*LinkedSubscription{NewLinkedSubscription(), NewLinkedSubscription()}
+	dispose := func() {
+		subscriptions[0].Dispose()
+		subscriptions[1].Dispose()
+	}
+	finish := func(result bool) {
+		done = true
+		observer.Next(result)
+		observer.Complete()
+		dispose()
+	}
+	observe := func(i int) InterfaceObserver {
+		j := 1 - i
+		return InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+			lock.Lock()
+			defer lock.Unlock()
+			if done {
+				return
+			}
+			switch {
+			case err != nil:
+				done = true
+				observer.Error(err)
+				dispose()
+			case complete:
+				completed[i] = true
+				if len(pending[j]) > 0 {
+					finish(false)
+				} else if completed[j] {
+					finish(true)
+				}
+			case len(pending[j]) > 0:
+				expected := pending[j][0]
+				pending[j] = pending[j][1:]
+				if !equalInterface(next, expected) {
+					finish(false)
+				}
+			case completed[j]:
+				finish(false)
+			default:
+				pending[i] = append(pending[i], next)
+			}
+		})
+	}
+	subscriptions[0].Link(e.parent.Subscribe(observe(0)))
+	subscriptions[1].Link(e.other.Subscribe(observe(1)))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(dispose)
+	return subscription
+}
+
+// SequenceEqual emits true if the stream and other emit equal values in the
+// same order and both complete, or false as soon as they differ.
+func (s *InterfaceStream) SequenceEqual(other InterfaceObservable) *BoolStream {
+	return FromBoolObservable(&sequenceEqualInterface{s, other})
+}
+
+// InterfaceNotification is a materialized event from a InterfaceStream.
+type InterfaceNotification struct {
+	Kind  NotificationKind
+	Value interface{} // Set if Kind is NotificationNext.
+	Err   error       // Set if Kind is NotificationError.
+}
+
+// Materialize emits every event of the stream, including termination, as a
+// InterfaceNotification. The materialized stream completes after the
+// notification for the termination of this stream.
+func (s *InterfaceStream) Materialize() *InterfaceNotificationStream {
+	return FromInterfaceNotificationObservable(MapInterface2InterfaceNotificationObserveDirect(s, func(next interface{}, err error, complete bool, observer InterfaceNotificationObserver) {
+		switch {
+		case err != nil:
+			observer.Next(InterfaceNotification{Kind: NotificationError, Err: err})
+			observer.Complete()
+		case complete:
+			observer.Next(InterfaceNotification{Kind: NotificationComplete})
+			observer.Complete()
+		default:
+			observer.Next(InterfaceNotification{Kind: NotificationNext, Value: next})
+		}
+	}))
+}
+
+// Dematerialize converts materialized notifications back into the events they
+// represent. Notifications after the first termination are ignored.
+func (s *InterfaceNotificationStream) Dematerialize() *InterfaceStream {
+	return FromInterfaceObservable(MapInterfaceNotification2InterfaceObservable(s, func(InterfaceObserver) MappingInterfaceNotification2InterfaceFunc {
+		terminated := false
+		return func(next InterfaceNotification, err error, complete bool, observer InterfaceObserver) {
+			if terminated {
+				return
+			}
+			switch {
+			case err != nil:
+				terminated = true
+				observer.Error(err)
+			case complete:
+				terminated = true
+				observer.Complete()
+			default:
+				switch next.Kind {
+				case NotificationNext:
+					observer.Next(next.Value)
+				case NotificationError:
+					terminated = true
+					observer.Error(next.Err)
+				case NotificationComplete:
+					terminated = true
+					observer.Complete()
+				}
+			}
+		}
+	}))
+}
+
+// TimestampedInterface is a value from a InterfaceStream and the time it was emitted.
+type TimestampedInterface struct {
+	Value interface{}
+	Time  time.Time
+}
+
+// Timestamp annotates each value in the stream with the time it was emitted.
+func (s *InterfaceStream) Timestamp() *TimestampedInterfaceStream {
+	return FromTimestampedInterfaceObservable(MapInterface2TimestampedInterfaceObserveNext(s, func(next interface{}) TimestampedInterface {
+		return TimestampedInterface{next, Now()}
+	}))
+}
+
+// IntervalInterface is a value from a InterfaceStream and the time elapsed
+// since the previous value was emitted.
+type IntervalInterface struct {
+	Value    interface{}
+	Interval time.Duration
+}
+
+// TimeInterval annotates each value in the stream with the time elapsed since
+// the previous value, or since subscription for the first value.
+func (s *InterfaceStream) TimeInterval() *IntervalInterfaceStream {
+	return FromIntervalInterfaceObservable(MapInterface2IntervalInterfaceObservable(s, func(IntervalInterfaceObserver) MappingInterface2IntervalInterfaceFunc {
+		last := Now()
+		return func(next interface{}, err error, complete bool, observer IntervalInterfaceObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				now := Now()
+				observer.Next(IntervalInterface{next, now.Sub(last)})
+				last = now
+			}
+		}
+	}))
+}
+
+type MappingInterface2InterfaceFunc func(next interface{}, err error, complete bool, observer InterfaceObserver)
+type MappingInterface2InterfaceFuncFactory func(observer InterfaceObserver) MappingInterface2InterfaceFunc
+
+type MappingInterface2InterfaceObservable struct {
+	parent InterfaceObservable
+	mapper MappingInterface2InterfaceFuncFactory
+}
+
+func (f *MappingInterface2InterfaceObservable) Subscribe(observer InterfaceObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInterface2InterfaceObservable(parent InterfaceObservable, mapper MappingInterface2InterfaceFuncFactory) InterfaceObservable {
+	return &MappingInterface2InterfaceObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInterface2InterfaceObserveDirect(parent InterfaceObservable, mapper MappingInterface2InterfaceFunc) InterfaceObservable {
+	return MapInterface2InterfaceObservable(parent, func(InterfaceObserver) MappingInterface2InterfaceFunc {
+		return mapper
+	})
+}
+
+func MapInterface2InterfaceObserveNext(parent InterfaceObservable, mapper func(interface{}) interface{}) InterfaceObservable {
+	return MapInterface2InterfaceObservable(parent, func(InterfaceObserver) MappingInterface2InterfaceFunc {
+		return func(next interface{}, err error, complete bool, observer InterfaceObserver) {
+			var mapped interface{}
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInterface(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapInterface2Interface struct {
+	parent InterfaceObservable
+	mapper func(interface{}) InterfaceObservable
+}
+
+func (f *flatMapInterface2Interface) Subscribe(observer InterfaceObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&InterfaceStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &InterfaceStream{ignoreCompletionFilter().Interface(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// Map maps values in this stream to another value.
+func (s *InterfaceStream) Map(f func(interface{}) interface{}) *InterfaceStream {
+	return FromInterfaceObservable(MapInterface2InterfaceObserveNext(s, f))
+}
+
+func (s *InterfaceStream) FlatMap(f func(interface{}) InterfaceObservable) *InterfaceStream {
+	return &InterfaceStream{&flatMapInterface2Interface{s, f}}
+}
+
+type MappingInterface2EventFunc func(next interface{}, err error, complete bool, observer EventObserver)
+type MappingInterface2EventFuncFactory func(observer EventObserver) MappingInterface2EventFunc
+
+type MappingInterface2EventObservable struct {
+	parent InterfaceObservable
+	mapper MappingInterface2EventFuncFactory
+}
+
+func (f *MappingInterface2EventObservable) Subscribe(observer EventObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInterface2EventObservable(parent InterfaceObservable, mapper MappingInterface2EventFuncFactory) EventObservable {
+	return &MappingInterface2EventObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInterface2EventObserveDirect(parent InterfaceObservable, mapper MappingInterface2EventFunc) EventObservable {
+	return MapInterface2EventObservable(parent, func(EventObserver) MappingInterface2EventFunc {
+		return mapper
+	})
+}
+
+func MapInterface2EventObserveNext(parent InterfaceObservable, mapper func(interface{}) Event) EventObservable {
+	return MapInterface2EventObservable(parent, func(EventObserver) MappingInterface2EventFunc {
+		return func(next interface{}, err error, complete bool, observer EventObserver) {
+			var mapped Event
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughEvent(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapInterface2Event struct {
+	parent InterfaceObservable
+	mapper func(interface{}) EventObservable
+}
+
+func (f *flatMapInterface2Event) Subscribe(observer EventObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&EventStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &EventStream{ignoreCompletionFilter().Event(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapEvent maps this stream to an EventStream via f.
+func (s *InterfaceStream) MapEvent(f func(interface{}) Event) *EventStream {
+	return FromEventObservable(MapInterface2EventObserveNext(s, f))
+}
+
+func (s *InterfaceStream) FlatMapEvent(f func(interface{}) EventObservable) *EventStream {
+	return &EventStream{&flatMapInterface2Event{s, f}}
+}
+
+type MappingInterface2IntFunc func(next interface{}, err error, complete bool, observer IntObserver)
+type MappingInterface2IntFuncFactory func(observer IntObserver) MappingInterface2IntFunc
+
+type MappingInterface2IntObservable struct {
+	parent InterfaceObservable
+	mapper MappingInterface2IntFuncFactory
+}
+
+func (f *MappingInterface2IntObservable) Subscribe(observer IntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInterface2IntObservable(parent InterfaceObservable, mapper MappingInterface2IntFuncFactory) IntObservable {
+	return &MappingInterface2IntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInterface2IntObserveDirect(parent InterfaceObservable, mapper MappingInterface2IntFunc) IntObservable {
+	return MapInterface2IntObservable(parent, func(IntObserver) MappingInterface2IntFunc {
+		return mapper
+	})
+}
+
+func MapInterface2IntObserveNext(parent InterfaceObservable, mapper func(interface{}) int) IntObservable {
+	return MapInterface2IntObservable(parent, func(IntObserver) MappingInterface2IntFunc {
+		return func(next interface{}, err error, complete bool, observer IntObserver) {
+			var mapped int
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapInterface2Int struct {
+	parent InterfaceObservable
+	mapper func(interface{}) IntObservable
+}
+
+func (f *flatMapInterface2Int) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&IntStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &IntStream{ignoreCompletionFilter().Int(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *InterfaceStream) MapInt(f func(interface{}) int) *IntStream {
+	return FromIntObservable(MapInterface2IntObserveNext(s, f))
+}
+
+func (s *InterfaceStream) FlatMapInt(f func(interface{}) IntObservable) *IntStream {
+	return &IntStream{&flatMapInterface2Int{s, f}}
+}
+
+type MappingInterface2BoolFunc func(next interface{}, err error, complete bool, observer BoolObserver)
+type MappingInterface2BoolFuncFactory func(observer BoolObserver) MappingInterface2BoolFunc
+
+type MappingInterface2BoolObservable struct {
+	parent InterfaceObservable
+	mapper MappingInterface2BoolFuncFactory
+}
+
+func (f *MappingInterface2BoolObservable) Subscribe(observer BoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInterface2BoolObservable(parent InterfaceObservable, mapper MappingInterface2BoolFuncFactory) BoolObservable {
+	return &MappingInterface2BoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInterface2BoolObserveDirect(parent InterfaceObservable, mapper MappingInterface2BoolFunc) BoolObservable {
+	return MapInterface2BoolObservable(parent, func(BoolObserver) MappingInterface2BoolFunc {
+		return mapper
+	})
+}
+
+func MapInterface2BoolObserveNext(parent InterfaceObservable, mapper func(interface{}) bool) BoolObservable {
+	return MapInterface2BoolObservable(parent, func(BoolObserver) MappingInterface2BoolFunc {
+		return func(next interface{}, err error, complete bool, observer BoolObserver) {
+			var mapped bool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapInterface2Bool struct {
+	parent InterfaceObservable
+	mapper func(interface{}) BoolObservable
+}
+
+func (f *flatMapInterface2Bool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&BoolStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &BoolStream{ignoreCompletionFilter().Bool(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *InterfaceStream) MapBool(f func(interface{}) bool) *BoolStream {
+	return FromBoolObservable(MapInterface2BoolObserveNext(s, f))
+}
+
+func (s *InterfaceStream) FlatMapBool(f func(interface{}) BoolObservable) *BoolStream {
+	return &BoolStream{&flatMapInterface2Bool{s, f}}
+}
+
+type MappingInterface2InterfaceNotificationFunc func(next interface{}, err error, complete bool, observer InterfaceNotificationObserver)
+type MappingInterface2InterfaceNotificationFuncFactory func(observer InterfaceNotificationObserver) MappingInterface2InterfaceNotificationFunc
+
+type MappingInterface2InterfaceNotificationObservable struct {
+	parent InterfaceObservable
+	mapper MappingInterface2InterfaceNotificationFuncFactory
+}
+
+func (f *MappingInterface2InterfaceNotificationObservable) Subscribe(observer InterfaceNotificationObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInterface2InterfaceNotificationObservable(parent InterfaceObservable, mapper MappingInterface2InterfaceNotificationFuncFactory) InterfaceNotificationObservable {
+	return &MappingInterface2InterfaceNotificationObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInterface2InterfaceNotificationObserveDirect(parent InterfaceObservable, mapper MappingInterface2InterfaceNotificationFunc) InterfaceNotificationObservable {
+	return MapInterface2InterfaceNotificationObservable(parent, func(InterfaceNotificationObserver) MappingInterface2InterfaceNotificationFunc {
+		return mapper
+	})
+}
+
+func MapInterface2InterfaceNotificationObserveNext(parent InterfaceObservable, mapper func(interface{}) InterfaceNotification) InterfaceNotificationObservable {
+	return MapInterface2InterfaceNotificationObservable(parent, func(InterfaceNotificationObserver) MappingInterface2InterfaceNotificationFunc {
+		return func(next interface{}, err error, complete bool, observer InterfaceNotificationObserver) {
+			var mapped InterfaceNotification
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInterfaceNotification(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapInterfaceNotification maps this stream to an InterfaceNotificationStream via f.
+func (s *InterfaceStream) MapInterfaceNotification(f func(interface{}) InterfaceNotification) *InterfaceNotificationStream {
+	return FromInterfaceNotificationObservable(MapInterface2InterfaceNotificationObserveNext(s, f))
+}
+
+type MappingInterface2TimestampedInterfaceFunc func(next interface{}, err error, complete bool, observer TimestampedInterfaceObserver)
+type MappingInterface2TimestampedInterfaceFuncFactory func(observer TimestampedInterfaceObserver) MappingInterface2TimestampedInterfaceFunc
+
+type MappingInterface2TimestampedInterfaceObservable struct {
+	parent InterfaceObservable
+	mapper MappingInterface2TimestampedInterfaceFuncFactory
+}
+
+func (f *MappingInterface2TimestampedInterfaceObservable) Subscribe(observer TimestampedInterfaceObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInterface2TimestampedInterfaceObservable(parent InterfaceObservable, mapper MappingInterface2TimestampedInterfaceFuncFactory) TimestampedInterfaceObservable {
+	return &MappingInterface2TimestampedInterfaceObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInterface2TimestampedInterfaceObserveDirect(parent InterfaceObservable, mapper MappingInterface2TimestampedInterfaceFunc) TimestampedInterfaceObservable {
+	return MapInterface2TimestampedInterfaceObservable(parent, func(TimestampedInterfaceObserver) MappingInterface2TimestampedInterfaceFunc {
+		return mapper
+	})
+}
+
+func MapInterface2TimestampedInterfaceObserveNext(parent InterfaceObservable, mapper func(interface{}) TimestampedInterface) TimestampedInterfaceObservable {
+	return MapInterface2TimestampedInterfaceObservable(parent, func(TimestampedInterfaceObserver) MappingInterface2TimestampedInterfaceFunc {
+		return func(next interface{}, err error, complete bool, observer TimestampedInterfaceObserver) {
+			var mapped TimestampedInterface
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughTimestampedInterface(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapTimestampedInterface maps this stream to an TimestampedInterfaceStream via f.
+func (s *InterfaceStream) MapTimestampedInterface(f func(interface{}) TimestampedInterface) *TimestampedInterfaceStream {
+	return FromTimestampedInterfaceObservable(MapInterface2TimestampedInterfaceObserveNext(s, f))
+}
+
+type MappingInterface2IntervalInterfaceFunc func(next interface{}, err error, complete bool, observer IntervalInterfaceObserver)
+type MappingInterface2IntervalInterfaceFuncFactory func(observer IntervalInterfaceObserver) MappingInterface2IntervalInterfaceFunc
+
+type MappingInterface2IntervalInterfaceObservable struct {
+	parent InterfaceObservable
+	mapper MappingInterface2IntervalInterfaceFuncFactory
+}
+
+func (f *MappingInterface2IntervalInterfaceObservable) Subscribe(observer IntervalInterfaceObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(InterfaceObserverFunc(func(next interface{}, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInterface2IntervalInterfaceObservable(parent InterfaceObservable, mapper MappingInterface2IntervalInterfaceFuncFactory) IntervalInterfaceObservable {
+	return &MappingInterface2IntervalInterfaceObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInterface2IntervalInterfaceObserveDirect(parent InterfaceObservable, mapper MappingInterface2IntervalInterfaceFunc) IntervalInterfaceObservable {
+	return MapInterface2IntervalInterfaceObservable(parent, func(IntervalInterfaceObserver) MappingInterface2IntervalInterfaceFunc {
+		return mapper
+	})
+}
+
+func MapInterface2IntervalInterfaceObserveNext(parent InterfaceObservable, mapper func(interface{}) IntervalInterface) IntervalInterfaceObservable {
+	return MapInterface2IntervalInterfaceObservable(parent, func(IntervalInterfaceObserver) MappingInterface2IntervalInterfaceFunc {
+		return func(next interface{}, err error, complete bool, observer IntervalInterfaceObserver) {
+			var mapped IntervalInterface
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntervalInterface(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapIntervalInterface maps this stream to an IntervalInterfaceStream via f.
+func (s *InterfaceStream) MapIntervalInterface(f func(interface{}) IntervalInterface) *IntervalInterfaceStream {
+	return FromIntervalInterfaceObservable(MapInterface2IntervalInterfaceObserveNext(s, f))
+}
+
+type InterfaceNotificationObserver interface {
+	Next(InterfaceNotification)
+	TerminationObserver
+}
+
+// A InterfaceNotificationSubscriber represents a subscribed InterfaceNotificationObserver.
+type InterfaceNotificationSubscriber interface {
+	Subscription
+	InterfaceNotificationObserver
+}
+
+type implInterfaceNotificationSubscriber struct {
+	Subscription
+	InterfaceNotificationObserver
+}
+
+func InterfaceNotificationObserverAsGenericObserver(observer InterfaceNotificationObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(InterfaceNotification))
+		}
+	})
+}
+
+func GenericObserverAsInterfaceNotificationObserver(observer GenericObserver) InterfaceNotificationObserver {
+	return InterfaceNotificationObserverFunc(func(next InterfaceNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type InterfaceNotificationObservableFactory func(observer InterfaceNotificationObserver, subscription Subscription)
+
+func (f InterfaceNotificationObservableFactory) Subscribe(observer InterfaceNotificationObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateInterfaceNotification calls f(observer, subscription) to produce values for a stream.
+func CreateInterfaceNotification(f func(observer InterfaceNotificationObserver, subscription Subscription)) *InterfaceNotificationStream {
+	return FromInterfaceNotificationObservable(InterfaceNotificationObservableFactory(f))
+}
+func PassthroughInterfaceNotification(next InterfaceNotification, err error, complete bool, observer InterfaceNotificationObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroInterfaceNotification = *new(InterfaceNotification)
+
+type InterfaceNotificationObserverFunc func(InterfaceNotification, error, bool)
+
+func (f InterfaceNotificationObserverFunc) Next(next InterfaceNotification) { f(next, nil, false) }
+func (f InterfaceNotificationObserverFunc) Error(err error)                 { f(zeroInterfaceNotification, err, false) }
+func (f InterfaceNotificationObserverFunc) Complete()                       { f(zeroInterfaceNotification, nil, true) }
+
+type InterfaceNotificationObservable interface {
+	Subscribe(InterfaceNotificationObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a InterfaceNotificationObservable
+func (f GenericObservableFilterFactory) InterfaceNotification(parent InterfaceNotificationObservable) InterfaceNotificationObservable {
+	return MapInterfaceNotification2InterfaceNotificationObservable(parent, func(observer InterfaceNotificationObserver) MappingInterfaceNotification2InterfaceNotificationFunc {
+		gobserver := InterfaceNotificationObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next InterfaceNotification, err error, complete bool, observer InterfaceNotificationObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromInterfaceNotificationArray(array []InterfaceNotification) *InterfaceNotificationStream {
+	return CreateInterfaceNotification(func(observer InterfaceNotificationObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromInterfaceNotifications(array ...InterfaceNotification) *InterfaceNotificationStream {
+	return FromInterfaceNotificationArray(array)
+}
+
+func FromInterfaceNotificationChannel(ch <-chan InterfaceNotification) *InterfaceNotificationStream {
+	return CreateInterfaceNotification(func(observer InterfaceNotificationObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type InterfaceNotificationStream struct {
+	InterfaceNotificationObservable
+}
+
+func FromInterfaceNotificationObservable(observable InterfaceNotificationObservable) *InterfaceNotificationStream {
+	return &InterfaceNotificationStream{observable}
+}
+
+func (s *InterfaceNotificationStream) SubscribeFunc(f func(InterfaceNotification, error, bool)) Subscription {
+	return s.Subscribe(InterfaceNotificationObserverFunc(f))
+}
+
+func (s *InterfaceNotificationStream) SubscribeNext(f func(v InterfaceNotification)) Subscription {
+	return s.SubscribeFunc(func(next InterfaceNotification, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *InterfaceNotificationStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsInterfaceNotificationObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *InterfaceNotificationStream) Filter(f func(InterfaceNotification) bool) *InterfaceNotificationStream {
+	return FromInterfaceNotificationObservable(filterFilter(func(v interface{}) bool { return f(v.(InterfaceNotification)) }).InterfaceNotification(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *InterfaceNotificationStream) Do(f func(next InterfaceNotification)) *InterfaceNotificationStream {
+	return FromInterfaceNotificationObservable(MapInterfaceNotification2InterfaceNotificationObserveNext(s, func(next InterfaceNotification) InterfaceNotification {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *InterfaceNotificationStream) DoOnError(f func(err error)) *InterfaceNotificationStream {
+	return FromInterfaceNotificationObservable(MapInterfaceNotification2InterfaceNotificationObserveDirect(s, func(next InterfaceNotification, err error, complete bool, observer InterfaceNotificationObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughInterfaceNotification(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *InterfaceNotificationStream) DoOnComplete(f func()) *InterfaceNotificationStream {
+	return FromInterfaceNotificationObservable(MapInterfaceNotification2InterfaceNotificationObserveDirect(s, func(next InterfaceNotification, err error, complete bool, observer InterfaceNotificationObserver) {
+		if complete {
+			f()
+		}
+		PassthroughInterfaceNotification(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *InterfaceNotificationStream) ToOneWithError() (InterfaceNotification, error) {
+	valuech := make(chan InterfaceNotification, 1)
+	errch := make(chan error, 1)
+	FromInterfaceNotificationObservable(oneFilter().InterfaceNotification(s)).SubscribeFunc(func(next InterfaceNotification, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroInterfaceNotification, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *InterfaceNotificationStream) ToOne() InterfaceNotification {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *InterfaceNotificationStream) ToArrayWithError() ([]InterfaceNotification, error) {
+	array := []InterfaceNotification{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next InterfaceNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *InterfaceNotificationStream) ToArray() []InterfaceNotification {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *InterfaceNotificationStream) ToChannelWithError() (<-chan InterfaceNotification, <-chan error) {
+	ch := make(chan InterfaceNotification, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next InterfaceNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *InterfaceNotificationStream) ToChannel() <-chan InterfaceNotification {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *InterfaceNotificationStream) Seq() iter.Seq[InterfaceNotification] {
+	return func(yield func(InterfaceNotification) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *InterfaceNotificationStream) Seq2() iter.Seq2[InterfaceNotification, error] {
+	return func(yield func(InterfaceNotification, error) bool) {
+		type notification struct {
+			next     InterfaceNotification
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next InterfaceNotification, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroInterfaceNotification, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapInterfaceNotification2Event struct {
+	parent InterfaceNotificationObservable
+	f      func(InterfaceNotification) Event
+}
+
+func (m *mapInterfaceNotification2Event) Subscribe(observer EventObserver) Subscription {
+	return m.parent.Subscribe(InterfaceNotificationObserverFunc(func(next InterfaceNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapEvent maps this stream to an EventStream via f.
+func (s *InterfaceNotificationStream) MapEvent(f func(InterfaceNotification) Event) *EventStream {
+	return &EventStream{&mapInterfaceNotification2Event{s, f}}
+}
+
+type mapInterfaceNotification2Int struct {
+	parent InterfaceNotificationObservable
+	f      func(InterfaceNotification) int
+}
+
+func (m *mapInterfaceNotification2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(InterfaceNotificationObserverFunc(func(next InterfaceNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *InterfaceNotificationStream) MapInt(f func(InterfaceNotification) int) *IntStream {
+	return &IntStream{&mapInterfaceNotification2Int{s, f}}
+}
+
+type mapInterfaceNotification2Bool struct {
+	parent InterfaceNotificationObservable
+	f      func(InterfaceNotification) bool
+}
+
+func (m *mapInterfaceNotification2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(InterfaceNotificationObserverFunc(func(next InterfaceNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *InterfaceNotificationStream) MapBool(f func(InterfaceNotification) bool) *BoolStream {
+	return &BoolStream{&mapInterfaceNotification2Bool{s, f}}
+}
+
+type MappingInterfaceNotification2InterfaceNotificationFunc func(next InterfaceNotification, err error, complete bool, observer InterfaceNotificationObserver)
+type MappingInterfaceNotification2InterfaceNotificationFuncFactory func(observer InterfaceNotificationObserver) MappingInterfaceNotification2InterfaceNotificationFunc
+
+type MappingInterfaceNotification2InterfaceNotificationObservable struct {
+	parent InterfaceNotificationObservable
+	mapper MappingInterfaceNotification2InterfaceNotificationFuncFactory
+}
+
+func (f *MappingInterfaceNotification2InterfaceNotificationObservable) Subscribe(observer InterfaceNotificationObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(InterfaceNotificationObserverFunc(func(next InterfaceNotification, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInterfaceNotification2InterfaceNotificationObservable(parent InterfaceNotificationObservable, mapper MappingInterfaceNotification2InterfaceNotificationFuncFactory) InterfaceNotificationObservable {
+	return &MappingInterfaceNotification2InterfaceNotificationObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInterfaceNotification2InterfaceNotificationObserveDirect(parent InterfaceNotificationObservable, mapper MappingInterfaceNotification2InterfaceNotificationFunc) InterfaceNotificationObservable {
+	return MapInterfaceNotification2InterfaceNotificationObservable(parent, func(InterfaceNotificationObserver) MappingInterfaceNotification2InterfaceNotificationFunc {
+		return mapper
+	})
+}
+
+func MapInterfaceNotification2InterfaceNotificationObserveNext(parent InterfaceNotificationObservable, mapper func(InterfaceNotification) InterfaceNotification) InterfaceNotificationObservable {
+	return MapInterfaceNotification2InterfaceNotificationObservable(parent, func(InterfaceNotificationObserver) MappingInterfaceNotification2InterfaceNotificationFunc {
+		return func(next InterfaceNotification, err error, complete bool, observer InterfaceNotificationObserver) {
+			var mapped InterfaceNotification
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInterfaceNotification(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *InterfaceNotificationStream) Map(f func(InterfaceNotification) InterfaceNotification) *InterfaceNotificationStream {
+	return FromInterfaceNotificationObservable(MapInterfaceNotification2InterfaceNotificationObserveNext(s, f))
+}
+
+type MappingInterfaceNotification2InterfaceFunc func(next InterfaceNotification, err error, complete bool, observer InterfaceObserver)
+type MappingInterfaceNotification2InterfaceFuncFactory func(observer InterfaceObserver) MappingInterfaceNotification2InterfaceFunc
+
+type MappingInterfaceNotification2InterfaceObservable struct {
+	parent InterfaceNotificationObservable
+	mapper MappingInterfaceNotification2InterfaceFuncFactory
+}
+
+func (f *MappingInterfaceNotification2InterfaceObservable) Subscribe(observer InterfaceObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(InterfaceNotificationObserverFunc(func(next InterfaceNotification, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInterfaceNotification2InterfaceObservable(parent InterfaceNotificationObservable, mapper MappingInterfaceNotification2InterfaceFuncFactory) InterfaceObservable {
+	return &MappingInterfaceNotification2InterfaceObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInterfaceNotification2InterfaceObserveDirect(parent InterfaceNotificationObservable, mapper MappingInterfaceNotification2InterfaceFunc) InterfaceObservable {
+	return MapInterfaceNotification2InterfaceObservable(parent, func(InterfaceObserver) MappingInterfaceNotification2InterfaceFunc {
+		return mapper
+	})
+}
+
+func MapInterfaceNotification2InterfaceObserveNext(parent InterfaceNotificationObservable, mapper func(InterfaceNotification) interface{}) InterfaceObservable {
+	return MapInterfaceNotification2InterfaceObservable(parent, func(InterfaceObserver) MappingInterfaceNotification2InterfaceFunc {
+		return func(next InterfaceNotification, err error, complete bool, observer InterfaceObserver) {
+			var mapped interface{}
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInterface(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapInterfaceNotification2Interface struct {
+	parent InterfaceNotificationObservable
+	mapper func(InterfaceNotification) InterfaceObservable
+}
+
+func (f *flatMapInterfaceNotification2Interface) Subscribe(observer InterfaceObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(InterfaceNotificationObserverFunc(func(next InterfaceNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&InterfaceStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &InterfaceStream{ignoreCompletionFilter().Interface(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInterface maps this stream to an InterfaceStream via f.
+func (s *InterfaceNotificationStream) MapInterface(f func(InterfaceNotification) interface{}) *InterfaceStream {
+	return FromInterfaceObservable(MapInterfaceNotification2InterfaceObserveNext(s, f))
+}
+
+func (s *InterfaceNotificationStream) FlatMapInterface(f func(InterfaceNotification) InterfaceObservable) *InterfaceStream {
+	return &InterfaceStream{&flatMapInterfaceNotification2Interface{s, f}}
+}
+
+type TimestampedInterfaceObserver interface {
+	Next(TimestampedInterface)
+	TerminationObserver
+}
+
+// A TimestampedInterfaceSubscriber represents a subscribed TimestampedInterfaceObserver.
+type TimestampedInterfaceSubscriber interface {
+	Subscription
+	TimestampedInterfaceObserver
+}
+
+type implTimestampedInterfaceSubscriber struct {
+	Subscription
+	TimestampedInterfaceObserver
+}
+
+func TimestampedInterfaceObserverAsGenericObserver(observer TimestampedInterfaceObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(TimestampedInterface))
+		}
+	})
+}
+
+func GenericObserverAsTimestampedInterfaceObserver(observer GenericObserver) TimestampedInterfaceObserver {
+	return TimestampedInterfaceObserverFunc(func(next TimestampedInterface, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type TimestampedInterfaceObservableFactory func(observer TimestampedInterfaceObserver, subscription Subscription)
+
+func (f TimestampedInterfaceObservableFactory) Subscribe(observer TimestampedInterfaceObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateTimestampedInterface calls f(observer, subscription) to produce values for a stream.
+func CreateTimestampedInterface(f func(observer TimestampedInterfaceObserver, subscription Subscription)) *TimestampedInterfaceStream {
+	return FromTimestampedInterfaceObservable(TimestampedInterfaceObservableFactory(f))
+}
+func PassthroughTimestampedInterface(next TimestampedInterface, err error, complete bool, observer TimestampedInterfaceObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroTimestampedInterface = *new(TimestampedInterface)
+
+type TimestampedInterfaceObserverFunc func(TimestampedInterface, error, bool)
+
+func (f TimestampedInterfaceObserverFunc) Next(next TimestampedInterface) { f(next, nil, false) }
+func (f TimestampedInterfaceObserverFunc) Error(err error)                { f(zeroTimestampedInterface, err, false) }
+func (f TimestampedInterfaceObserverFunc) Complete()                      { f(zeroTimestampedInterface, nil, true) }
+
+type TimestampedInterfaceObservable interface {
+	Subscribe(TimestampedInterfaceObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a TimestampedInterfaceObservable
+func (f GenericObservableFilterFactory) TimestampedInterface(parent TimestampedInterfaceObservable) TimestampedInterfaceObservable {
+	return MapTimestampedInterface2TimestampedInterfaceObservable(parent, func(observer TimestampedInterfaceObserver) MappingTimestampedInterface2TimestampedInterfaceFunc {
+		gobserver := TimestampedInterfaceObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next TimestampedInterface, err error, complete bool, observer TimestampedInterfaceObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromTimestampedInterfaceArray(array []TimestampedInterface) *TimestampedInterfaceStream {
+	return CreateTimestampedInterface(func(observer TimestampedInterfaceObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromTimestampedInterfaces(array ...TimestampedInterface) *TimestampedInterfaceStream {
+	return FromTimestampedInterfaceArray(array)
+}
+
+func FromTimestampedInterfaceChannel(ch <-chan TimestampedInterface) *TimestampedInterfaceStream {
+	return CreateTimestampedInterface(func(observer TimestampedInterfaceObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type TimestampedInterfaceStream struct {
+	TimestampedInterfaceObservable
+}
+
+func FromTimestampedInterfaceObservable(observable TimestampedInterfaceObservable) *TimestampedInterfaceStream {
+	return &TimestampedInterfaceStream{observable}
+}
+
+func (s *TimestampedInterfaceStream) SubscribeFunc(f func(TimestampedInterface, error, bool)) Subscription {
+	return s.Subscribe(TimestampedInterfaceObserverFunc(f))
+}
+
+func (s *TimestampedInterfaceStream) SubscribeNext(f func(v TimestampedInterface)) Subscription {
+	return s.SubscribeFunc(func(next TimestampedInterface, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *TimestampedInterfaceStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsTimestampedInterfaceObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *TimestampedInterfaceStream) Filter(f func(TimestampedInterface) bool) *TimestampedInterfaceStream {
+	return FromTimestampedInterfaceObservable(filterFilter(func(v interface{}) bool { return f(v.(TimestampedInterface)) }).TimestampedInterface(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *TimestampedInterfaceStream) Do(f func(next TimestampedInterface)) *TimestampedInterfaceStream {
+	return FromTimestampedInterfaceObservable(MapTimestampedInterface2TimestampedInterfaceObserveNext(s, func(next TimestampedInterface) TimestampedInterface {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *TimestampedInterfaceStream) DoOnError(f func(err error)) *TimestampedInterfaceStream {
+	return FromTimestampedInterfaceObservable(MapTimestampedInterface2TimestampedInterfaceObserveDirect(s, func(next TimestampedInterface, err error, complete bool, observer TimestampedInterfaceObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughTimestampedInterface(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *TimestampedInterfaceStream) DoOnComplete(f func()) *TimestampedInterfaceStream {
+	return FromTimestampedInterfaceObservable(MapTimestampedInterface2TimestampedInterfaceObserveDirect(s, func(next TimestampedInterface, err error, complete bool, observer TimestampedInterfaceObserver) {
+		if complete {
+			f()
+		}
+		PassthroughTimestampedInterface(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *TimestampedInterfaceStream) ToOneWithError() (TimestampedInterface, error) {
+	valuech := make(chan TimestampedInterface, 1)
+	errch := make(chan error, 1)
+	FromTimestampedInterfaceObservable(oneFilter().TimestampedInterface(s)).SubscribeFunc(func(next TimestampedInterface, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroTimestampedInterface, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *TimestampedInterfaceStream) ToOne() TimestampedInterface {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *TimestampedInterfaceStream) ToArrayWithError() ([]TimestampedInterface, error) {
+	array := []TimestampedInterface{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next TimestampedInterface, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *TimestampedInterfaceStream) ToArray() []TimestampedInterface {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *TimestampedInterfaceStream) ToChannelWithError() (<-chan TimestampedInterface, <-chan error) {
+	ch := make(chan TimestampedInterface, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next TimestampedInterface, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *TimestampedInterfaceStream) ToChannel() <-chan TimestampedInterface {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *TimestampedInterfaceStream) Seq() iter.Seq[TimestampedInterface] {
+	return func(yield func(TimestampedInterface) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *TimestampedInterfaceStream) Seq2() iter.Seq2[TimestampedInterface, error] {
+	return func(yield func(TimestampedInterface, error) bool) {
+		type notification struct {
+			next     TimestampedInterface
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next TimestampedInterface, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroTimestampedInterface, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapTimestampedInterface2Event struct {
+	parent TimestampedInterfaceObservable
+	f      func(TimestampedInterface) Event
+}
+
+func (m *mapTimestampedInterface2Event) Subscribe(observer EventObserver) Subscription {
+	return m.parent.Subscribe(TimestampedInterfaceObserverFunc(func(next TimestampedInterface, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapEvent maps this stream to an EventStream via f.
+func (s *TimestampedInterfaceStream) MapEvent(f func(TimestampedInterface) Event) *EventStream {
+	return &EventStream{&mapTimestampedInterface2Event{s, f}}
+}
+
+type mapTimestampedInterface2Int struct {
+	parent TimestampedInterfaceObservable
+	f      func(TimestampedInterface) int
+}
+
+func (m *mapTimestampedInterface2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(TimestampedInterfaceObserverFunc(func(next TimestampedInterface, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *TimestampedInterfaceStream) MapInt(f func(TimestampedInterface) int) *IntStream {
+	return &IntStream{&mapTimestampedInterface2Int{s, f}}
+}
+
+type mapTimestampedInterface2Bool struct {
+	parent TimestampedInterfaceObservable
+	f      func(TimestampedInterface) bool
+}
+
+func (m *mapTimestampedInterface2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(TimestampedInterfaceObserverFunc(func(next TimestampedInterface, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *TimestampedInterfaceStream) MapBool(f func(TimestampedInterface) bool) *BoolStream {
+	return &BoolStream{&mapTimestampedInterface2Bool{s, f}}
+}
+
+type MappingTimestampedInterface2TimestampedInterfaceFunc func(next TimestampedInterface, err error, complete bool, observer TimestampedInterfaceObserver)
+type MappingTimestampedInterface2TimestampedInterfaceFuncFactory func(observer TimestampedInterfaceObserver) MappingTimestampedInterface2TimestampedInterfaceFunc
+
+type MappingTimestampedInterface2TimestampedInterfaceObservable struct {
+	parent TimestampedInterfaceObservable
+	mapper MappingTimestampedInterface2TimestampedInterfaceFuncFactory
+}
+
+func (f *MappingTimestampedInterface2TimestampedInterfaceObservable) Subscribe(observer TimestampedInterfaceObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(TimestampedInterfaceObserverFunc(func(next TimestampedInterface, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapTimestampedInterface2TimestampedInterfaceObservable(parent TimestampedInterfaceObservable, mapper MappingTimestampedInterface2TimestampedInterfaceFuncFactory) TimestampedInterfaceObservable {
+	return &MappingTimestampedInterface2TimestampedInterfaceObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapTimestampedInterface2TimestampedInterfaceObserveDirect(parent TimestampedInterfaceObservable, mapper MappingTimestampedInterface2TimestampedInterfaceFunc) TimestampedInterfaceObservable {
+	return MapTimestampedInterface2TimestampedInterfaceObservable(parent, func(TimestampedInterfaceObserver) MappingTimestampedInterface2TimestampedInterfaceFunc {
+		return mapper
+	})
+}
+
+func MapTimestampedInterface2TimestampedInterfaceObserveNext(parent TimestampedInterfaceObservable, mapper func(TimestampedInterface) TimestampedInterface) TimestampedInterfaceObservable {
+	return MapTimestampedInterface2TimestampedInterfaceObservable(parent, func(TimestampedInterfaceObserver) MappingTimestampedInterface2TimestampedInterfaceFunc {
+		return func(next TimestampedInterface, err error, complete bool, observer TimestampedInterfaceObserver) {
+			var mapped TimestampedInterface
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughTimestampedInterface(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *TimestampedInterfaceStream) Map(f func(TimestampedInterface) TimestampedInterface) *TimestampedInterfaceStream {
+	return FromTimestampedInterfaceObservable(MapTimestampedInterface2TimestampedInterfaceObserveNext(s, f))
+}
+
+type MappingTimestampedInterface2InterfaceFunc func(next TimestampedInterface, err error, complete bool, observer InterfaceObserver)
+type MappingTimestampedInterface2InterfaceFuncFactory func(observer InterfaceObserver) MappingTimestampedInterface2InterfaceFunc
+
+type MappingTimestampedInterface2InterfaceObservable struct {
+	parent TimestampedInterfaceObservable
+	mapper MappingTimestampedInterface2InterfaceFuncFactory
+}
+
+func (f *MappingTimestampedInterface2InterfaceObservable) Subscribe(observer InterfaceObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(TimestampedInterfaceObserverFunc(func(next TimestampedInterface, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapTimestampedInterface2InterfaceObservable(parent TimestampedInterfaceObservable, mapper MappingTimestampedInterface2InterfaceFuncFactory) InterfaceObservable {
+	return &MappingTimestampedInterface2InterfaceObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapTimestampedInterface2InterfaceObserveDirect(parent TimestampedInterfaceObservable, mapper MappingTimestampedInterface2InterfaceFunc) InterfaceObservable {
+	return MapTimestampedInterface2InterfaceObservable(parent, func(InterfaceObserver) MappingTimestampedInterface2InterfaceFunc {
+		return mapper
+	})
+}
+
+func MapTimestampedInterface2InterfaceObserveNext(parent TimestampedInterfaceObservable, mapper func(TimestampedInterface) interface{}) InterfaceObservable {
+	return MapTimestampedInterface2InterfaceObservable(parent, func(InterfaceObserver) MappingTimestampedInterface2InterfaceFunc {
+		return func(next TimestampedInterface, err error, complete bool, observer InterfaceObserver) {
+			var mapped interface{}
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInterface(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapTimestampedInterface2Interface struct {
+	parent TimestampedInterfaceObservable
+	mapper func(TimestampedInterface) InterfaceObservable
+}
+
+func (f *flatMapTimestampedInterface2Interface) Subscribe(observer InterfaceObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(TimestampedInterfaceObserverFunc(func(next TimestampedInterface, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&InterfaceStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &InterfaceStream{ignoreCompletionFilter().Interface(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInterface maps this stream to an InterfaceStream via f.
+func (s *TimestampedInterfaceStream) MapInterface(f func(TimestampedInterface) interface{}) *InterfaceStream {
+	return FromInterfaceObservable(MapTimestampedInterface2InterfaceObserveNext(s, f))
+}
+
+func (s *TimestampedInterfaceStream) FlatMapInterface(f func(TimestampedInterface) InterfaceObservable) *InterfaceStream {
+	return &InterfaceStream{&flatMapTimestampedInterface2Interface{s, f}}
+}
+
+type IntervalInterfaceObserver interface {
+	Next(IntervalInterface)
+	TerminationObserver
+}
+
+// A IntervalInterfaceSubscriber represents a subscribed IntervalInterfaceObserver.
+type IntervalInterfaceSubscriber interface {
+	Subscription
+	IntervalInterfaceObserver
+}
+
+type implIntervalInterfaceSubscriber struct {
+	Subscription
+	IntervalInterfaceObserver
+}
+
+func IntervalInterfaceObserverAsGenericObserver(observer IntervalInterfaceObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(IntervalInterface))
+		}
+	})
+}
+
+func GenericObserverAsIntervalInterfaceObserver(observer GenericObserver) IntervalInterfaceObserver {
+	return IntervalInterfaceObserverFunc(func(next IntervalInterface, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type IntervalInterfaceObservableFactory func(observer IntervalInterfaceObserver, subscription Subscription)
+
+func (f IntervalInterfaceObservableFactory) Subscribe(observer IntervalInterfaceObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateIntervalInterface calls f(observer, subscription) to produce values for a stream.
+func CreateIntervalInterface(f func(observer IntervalInterfaceObserver, subscription Subscription)) *IntervalInterfaceStream {
+	return FromIntervalInterfaceObservable(IntervalInterfaceObservableFactory(f))
+}
+func PassthroughIntervalInterface(next IntervalInterface, err error, complete bool, observer IntervalInterfaceObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroIntervalInterface = *new(IntervalInterface)
+
+type IntervalInterfaceObserverFunc func(IntervalInterface, error, bool)
+
+func (f IntervalInterfaceObserverFunc) Next(next IntervalInterface) { f(next, nil, false) }
+func (f IntervalInterfaceObserverFunc) Error(err error)             { f(zeroIntervalInterface, err, false) }
+func (f IntervalInterfaceObserverFunc) Complete()                   { f(zeroIntervalInterface, nil, true) }
+
+type IntervalInterfaceObservable interface {
+	Subscribe(IntervalInterfaceObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a IntervalInterfaceObservable
+func (f GenericObservableFilterFactory) IntervalInterface(parent IntervalInterfaceObservable) IntervalInterfaceObservable {
+	return MapIntervalInterface2IntervalInterfaceObservable(parent, func(observer IntervalInterfaceObserver) MappingIntervalInterface2IntervalInterfaceFunc {
+		gobserver := IntervalInterfaceObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next IntervalInterface, err error, complete bool, observer IntervalInterfaceObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromIntervalInterfaceArray(array []IntervalInterface) *IntervalInterfaceStream {
+	return CreateIntervalInterface(func(observer IntervalInterfaceObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromIntervalInterfaces(array ...IntervalInterface) *IntervalInterfaceStream {
+	return FromIntervalInterfaceArray(array)
+}
+
+func FromIntervalInterfaceChannel(ch <-chan IntervalInterface) *IntervalInterfaceStream {
+	return CreateIntervalInterface(func(observer IntervalInterfaceObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type IntervalInterfaceStream struct {
+	IntervalInterfaceObservable
+}
+
+func FromIntervalInterfaceObservable(observable IntervalInterfaceObservable) *IntervalInterfaceStream {
+	return &IntervalInterfaceStream{observable}
+}
+
+func (s *IntervalInterfaceStream) SubscribeFunc(f func(IntervalInterface, error, bool)) Subscription {
+	return s.Subscribe(IntervalInterfaceObserverFunc(f))
+}
+
+func (s *IntervalInterfaceStream) SubscribeNext(f func(v IntervalInterface)) Subscription {
+	return s.SubscribeFunc(func(next IntervalInterface, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *IntervalInterfaceStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsIntervalInterfaceObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *IntervalInterfaceStream) Filter(f func(IntervalInterface) bool) *IntervalInterfaceStream {
+	return FromIntervalInterfaceObservable(filterFilter(func(v interface{}) bool { return f(v.(IntervalInterface)) }).IntervalInterface(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *IntervalInterfaceStream) Do(f func(next IntervalInterface)) *IntervalInterfaceStream {
+	return FromIntervalInterfaceObservable(MapIntervalInterface2IntervalInterfaceObserveNext(s, func(next IntervalInterface) IntervalInterface {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *IntervalInterfaceStream) DoOnError(f func(err error)) *IntervalInterfaceStream {
+	return FromIntervalInterfaceObservable(MapIntervalInterface2IntervalInterfaceObserveDirect(s, func(next IntervalInterface, err error, complete bool, observer IntervalInterfaceObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughIntervalInterface(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *IntervalInterfaceStream) DoOnComplete(f func()) *IntervalInterfaceStream {
+	return FromIntervalInterfaceObservable(MapIntervalInterface2IntervalInterfaceObserveDirect(s, func(next IntervalInterface, err error, complete bool, observer IntervalInterfaceObserver) {
+		if complete {
+			f()
+		}
+		PassthroughIntervalInterface(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *IntervalInterfaceStream) ToOneWithError() (IntervalInterface, error) {
+	valuech := make(chan IntervalInterface, 1)
+	errch := make(chan error, 1)
+	FromIntervalInterfaceObservable(oneFilter().IntervalInterface(s)).SubscribeFunc(func(next IntervalInterface, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroIntervalInterface, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *IntervalInterfaceStream) ToOne() IntervalInterface {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *IntervalInterfaceStream) ToArrayWithError() ([]IntervalInterface, error) {
+	array := []IntervalInterface{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntervalInterface, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *IntervalInterfaceStream) ToArray() []IntervalInterface {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *IntervalInterfaceStream) ToChannelWithError() (<-chan IntervalInterface, <-chan error) {
+	ch := make(chan IntervalInterface, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntervalInterface, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *IntervalInterfaceStream) ToChannel() <-chan IntervalInterface {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *IntervalInterfaceStream) Seq() iter.Seq[IntervalInterface] {
+	return func(yield func(IntervalInterface) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *IntervalInterfaceStream) Seq2() iter.Seq2[IntervalInterface, error] {
+	return func(yield func(IntervalInterface, error) bool) {
+		type notification struct {
+			next     IntervalInterface
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next IntervalInterface, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroIntervalInterface, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapIntervalInterface2Event struct {
+	parent IntervalInterfaceObservable
+	f      func(IntervalInterface) Event
+}
+
+func (m *mapIntervalInterface2Event) Subscribe(observer EventObserver) Subscription {
+	return m.parent.Subscribe(IntervalInterfaceObserverFunc(func(next IntervalInterface, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapEvent maps this stream to an EventStream via f.
+func (s *IntervalInterfaceStream) MapEvent(f func(IntervalInterface) Event) *EventStream {
+	return &EventStream{&mapIntervalInterface2Event{s, f}}
+}
+
+type mapIntervalInterface2Int struct {
+	parent IntervalInterfaceObservable
+	f      func(IntervalInterface) int
+}
+
+func (m *mapIntervalInterface2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(IntervalInterfaceObserverFunc(func(next IntervalInterface, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *IntervalInterfaceStream) MapInt(f func(IntervalInterface) int) *IntStream {
+	return &IntStream{&mapIntervalInterface2Int{s, f}}
+}
+
+type mapIntervalInterface2Bool struct {
+	parent IntervalInterfaceObservable
+	f      func(IntervalInterface) bool
+}
+
+func (m *mapIntervalInterface2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(IntervalInterfaceObserverFunc(func(next IntervalInterface, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *IntervalInterfaceStream) MapBool(f func(IntervalInterface) bool) *BoolStream {
+	return &BoolStream{&mapIntervalInterface2Bool{s, f}}
+}
+
+type MappingIntervalInterface2IntervalInterfaceFunc func(next IntervalInterface, err error, complete bool, observer IntervalInterfaceObserver)
+type MappingIntervalInterface2IntervalInterfaceFuncFactory func(observer IntervalInterfaceObserver) MappingIntervalInterface2IntervalInterfaceFunc
+
+type MappingIntervalInterface2IntervalInterfaceObservable struct {
+	parent IntervalInterfaceObservable
+	mapper MappingIntervalInterface2IntervalInterfaceFuncFactory
+}
+
+func (f *MappingIntervalInterface2IntervalInterfaceObservable) Subscribe(observer IntervalInterfaceObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntervalInterfaceObserverFunc(func(next IntervalInterface, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapIntervalInterface2IntervalInterfaceObservable(parent IntervalInterfaceObservable, mapper MappingIntervalInterface2IntervalInterfaceFuncFactory) IntervalInterfaceObservable {
+	return &MappingIntervalInterface2IntervalInterfaceObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntervalInterface2IntervalInterfaceObserveDirect(parent IntervalInterfaceObservable, mapper MappingIntervalInterface2IntervalInterfaceFunc) IntervalInterfaceObservable {
+	return MapIntervalInterface2IntervalInterfaceObservable(parent, func(IntervalInterfaceObserver) MappingIntervalInterface2IntervalInterfaceFunc {
+		return mapper
+	})
+}
+
+func MapIntervalInterface2IntervalInterfaceObserveNext(parent IntervalInterfaceObservable, mapper func(IntervalInterface) IntervalInterface) IntervalInterfaceObservable {
+	return MapIntervalInterface2IntervalInterfaceObservable(parent, func(IntervalInterfaceObserver) MappingIntervalInterface2IntervalInterfaceFunc {
+		return func(next IntervalInterface, err error, complete bool, observer IntervalInterfaceObserver) {
+			var mapped IntervalInterface
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntervalInterface(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *IntervalInterfaceStream) Map(f func(IntervalInterface) IntervalInterface) *IntervalInterfaceStream {
+	return FromIntervalInterfaceObservable(MapIntervalInterface2IntervalInterfaceObserveNext(s, f))
+}
+
+type MappingIntervalInterface2InterfaceFunc func(next IntervalInterface, err error, complete bool, observer InterfaceObserver)
+type MappingIntervalInterface2InterfaceFuncFactory func(observer InterfaceObserver) MappingIntervalInterface2InterfaceFunc
+
+type MappingIntervalInterface2InterfaceObservable struct {
+	parent IntervalInterfaceObservable
+	mapper MappingIntervalInterface2InterfaceFuncFactory
+}
+
+func (f *MappingIntervalInterface2InterfaceObservable) Subscribe(observer InterfaceObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntervalInterfaceObserverFunc(func(next IntervalInterface, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapIntervalInterface2InterfaceObservable(parent IntervalInterfaceObservable, mapper MappingIntervalInterface2InterfaceFuncFactory) InterfaceObservable {
+	return &MappingIntervalInterface2InterfaceObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntervalInterface2InterfaceObserveDirect(parent IntervalInterfaceObservable, mapper MappingIntervalInterface2InterfaceFunc) InterfaceObservable {
+	return MapIntervalInterface2InterfaceObservable(parent, func(InterfaceObserver) MappingIntervalInterface2InterfaceFunc {
+		return mapper
+	})
+}
+
+func MapIntervalInterface2InterfaceObserveNext(parent IntervalInterfaceObservable, mapper func(IntervalInterface) interface{}) InterfaceObservable {
+	return MapIntervalInterface2InterfaceObservable(parent, func(InterfaceObserver) MappingIntervalInterface2InterfaceFunc {
+		return func(next IntervalInterface, err error, complete bool, observer InterfaceObserver) {
+			var mapped interface{}
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInterface(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapIntervalInterface2Interface struct {
+	parent IntervalInterfaceObservable
+	mapper func(IntervalInterface) InterfaceObservable
+}
+
+func (f *flatMapIntervalInterface2Interface) Subscribe(observer InterfaceObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntervalInterfaceObserverFunc(func(next IntervalInterface, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&InterfaceStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &InterfaceStream{ignoreCompletionFilter().Interface(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInterface maps this stream to an InterfaceStream via f.
+func (s *IntervalInterfaceStream) MapInterface(f func(IntervalInterface) interface{}) *InterfaceStream {
+	return FromInterfaceObservable(MapIntervalInterface2InterfaceObserveNext(s, f))
+}
+
+func (s *IntervalInterfaceStream) FlatMapInterface(f func(IntervalInterface) InterfaceObservable) *InterfaceStream {
+	return &InterfaceStream{&flatMapIntervalInterface2Interface{s, f}}
+}
+
+type EventObserver interface {
+	Next(Event)
+	TerminationObserver
+}
+
+// A EventSubscriber represents a subscribed EventObserver.
+type EventSubscriber interface {
+	Subscription
+	EventObserver
+}
+
+type implEventSubscriber struct {
+	Subscription
+	EventObserver
+}
+
+func EventObserverAsGenericObserver(observer EventObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(Event))
+		}
+	})
+}
+
+func GenericObserverAsEventObserver(observer GenericObserver) EventObserver {
+	return EventObserverFunc(func(next Event, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type EventObservableFactory func(observer EventObserver, subscription Subscription)
+
+func (f EventObservableFactory) Subscribe(observer EventObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateEvent calls f(observer, subscription) to produce values for a stream.
+func CreateEvent(f func(observer EventObserver, subscription Subscription)) *EventStream {
+	return FromEventObservable(EventObservableFactory(f))
+}
+
+// Repeat value count times.
+func RepeatEvent(value Event, count int) *EventStream {
+	return CreateEvent(func(observer EventObserver, subscription Subscription) {
+		for i := 0; i < count; i++ {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(value)
+		}
+		observer.Complete()
+	})
+}
+
+// StartEvent is designed to be used with functions that return a
+// (Event, error) tuple.
+//
+// If the error is non-nil the returned EventStream will be that error,
+// otherwise it will be a single-value stream of Event.
+func StartEvent(f func() (Event, error)) *EventStream {
+	return CreateEvent(func(observer EventObserver, subscription Subscription) {
+		if v, err := f(); err != nil {
+			observer.Error(err)
+		} else {
+			observer.Next(v)
+			observer.Complete()
+		}
+	})
+}
+
+type deferEventObservable func() EventObservable
+
+func (f deferEventObservable) Subscribe(observer EventObserver) Subscription {
+	return f().Subscribe(observer)
+}
+
+// DeferEvent calls f to create a fresh observable for each subscription.
+func DeferEvent(f func() EventObservable) *EventStream {
+	return FromEventObservable(deferEventObservable(f))
+}
+func PassthroughEvent(next Event, err error, complete bool, observer EventObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroEvent = *new(Event)
+
+type EventObserverFunc func(Event, error, bool)
+
+func (f EventObserverFunc) Next(next Event) { f(next, nil, false) }
+func (f EventObserverFunc) Error(err error) { f(zeroEvent, err, false) }
+func (f EventObserverFunc) Complete()       { f(zeroEvent, nil, true) }
+
+type EventObservable interface {
+	Subscribe(EventObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a EventObservable
+func (f GenericObservableFilterFactory) Event(parent EventObservable) EventObservable {
+	return MapEvent2EventObservable(parent, func(observer EventObserver) MappingEvent2EventFunc {
+		gobserver := EventObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next Event, err error, complete bool, observer EventObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func NeverEvent() *EventStream {
+	return CreateEvent(func(observer EventObserver, subscription Subscription) {})
+}
+
+func EmptyEvent() *EventStream {
+	return CreateEvent(func(observer EventObserver, subscription Subscription) {
+		observer.Complete()
+	})
+}
+
+func ThrowEvent(err error) *EventStream {
+	return CreateEvent(func(observer EventObserver, subscription Subscription) {
+		observer.Error(err)
+	})
+}
+func FromEventArray(array []Event) *EventStream {
+	return CreateEvent(func(observer EventObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromEvents(array ...Event) *EventStream {
+	return FromEventArray(array)
+}
+
+// FromEventSeq emits each value yielded by seq, iterating it once per
+// subscription.
+func FromEventSeq(seq iter.Seq[Event]) *EventStream {
+	return CreateEvent(func(observer EventObserver, subscription Subscription) {
+		for v := range seq {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+func JustEvent(element Event) *EventStream {
+	return FromEventArray([]Event{element})
+}
+
+func MergeEvent(observables ...EventObservable) *EventStream {
+	if len(observables) == 0 {
+		return EmptyEvent()
+	}
+	return (&EventStream{observables[0]}).Merge(observables[1:]...)
+}
+
+func MergeEventDelayError(observables ...EventObservable) *EventStream {
+	if len(observables) == 0 {
+		return EmptyEvent()
+	}
+	return (&EventStream{observables[0]}).MergeDelayError(observables[1:]...)
+}
+
+type ambEventObservable []EventObservable
+
+func (a ambEventObservable) Subscribe(observer EventObserver) Subscription {
+	lock := sync.Mutex{}
+	winner := -1
+	subscriptions := make([]*LinkedSubscription, len(a))
+	for i := range subscriptions {
+		subscriptions[i] = NewLinkedSubscription()
+	}
+	for i, observable := range a {
+		i := i
+		subscriptions[i].Link(observable.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+			lock.Lock()
+			if winner == -1 {
+				winner = i
+				for j, subscription := range subscriptions {
+					if j != i {
+						subscription.Dispose()
+					}
+				}
+			}
+			won := winner == i
+			lock.Unlock()
+			if won {
+				PassthroughEvent(next, err, complete, observer)
+			}
+		})))
+	}
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		for _, s := range subscriptions {
+			s.Dispose()
+		}
+	})
+	return subscription
+}
+
+// AmbEvent subscribes to all observables and mirrors whichever emits
+// first, disposing the others.
+func AmbEvent(observables ...EventObservable) *EventStream {
+	if len(observables) == 0 {
+		return EmptyEvent()
+	}
+	return FromEventObservable(ambEventObservable(observables))
+}
+func FromEventChannel(ch <-chan Event) *EventStream {
+	return CreateEvent(func(observer EventObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type EventStream struct {
+	EventObservable
+}
+
+func FromEventObservable(observable EventObservable) *EventStream {
+	return &EventStream{observable}
+}
+
+func (s *EventStream) SubscribeFunc(f func(Event, error, bool)) Subscription {
+	return s.Subscribe(EventObserverFunc(f))
+}
+
+func (s *EventStream) SubscribeNext(f func(v Event)) Subscription {
+	return s.SubscribeFunc(func(next Event, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *EventStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsEventObserver(observer))
+}
+
+// Distinct removes duplicate elements in the stream. Values that can not be
+// map keys, such as slices, are compared with reflect.DeepEqual.
+func (s *EventStream) Distinct() *EventStream {
+	return FromEventObservable(distinctFilter().Event(s))
+}
+
+// ElementAt yields the Nth element of the stream.
+func (s *EventStream) ElementAt(n int) *EventStream {
+	return FromEventObservable(elementAtFilter(n).Event(s))
+}
+
+// Filter elements in the stream on a function.
+func (s *EventStream) Filter(f func(Event) bool) *EventStream {
+	return FromEventObservable(filterFilter(func(v interface{}) bool { return f(v.(Event)) }).Event(s))
+}
+
+// Last returns just the first element of the stream.
+func (s *EventStream) First() *EventStream {
+	return FromEventObservable(firstFilter().Event(s))
+}
+
+// Last returns just the last element of the stream.
+func (s *EventStream) Last() *EventStream {
+	return FromEventObservable(lastFilter().Event(s))
+}
+
+// SkipLast skips the first N elements of the stream.
+func (s *EventStream) Skip(n int) *EventStream {
+	return FromEventObservable(skipFilter(n).Event(s))
+}
+
+// SkipLast skips the last N elements of the stream.
+func (s *EventStream) SkipLast(n int) *EventStream {
+	return FromEventObservable(skipLastFilter(n).Event(s))
+}
+
+// Take returns just the first N elements of the stream.
+func (s *EventStream) Take(n int) *EventStream {
+	return FromEventObservable(takeFilter(n).Event(s))
+}
+
+// TakeLast returns just the last N elements of the stream.
+func (s *EventStream) TakeLast(n int) *EventStream {
+	return FromEventObservable(takeLastFilter(n).Event(s))
+}
+
+type takeWhileEvent struct {
+	parent EventObservable
+	f      func(Event) bool
+}
+
+func (t *takeWhileEvent) Subscribe(observer EventObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	parent.Link(t.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Complete()
+		case t.f(next):
+			observer.Next(next)
+		default:
+			done = true
+			observer.Complete()
+			parent.Dispose()
+		}
+	})))
+	return parent
+}
+
+// TakeWhile returns elements of the stream until f returns false, then
+// completes and disposes the stream.
+func (s *EventStream) TakeWhile(f func(Event) bool) *EventStream {
+	return &EventStream{&takeWhileEvent{s, f}}
+}
+
+// SkipWhile skips elements of the stream until f returns false.
+func (s *EventStream) SkipWhile(f func(Event) bool) *EventStream {
+	return FromEventObservable(MapEvent2EventObservable(s, func(EventObserver) MappingEvent2EventFunc {
+		skipping := true
+		return func(next Event, err error, complete bool, observer EventObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if skipping && !f(next) {
+					skipping = false
+				}
+				if !skipping {
+					observer.Next(next)
+				}
+			}
+		}
+	}))
+}
+
+// IgnoreElements ignores elements of the stream and emits only the completion events.
+func (s *EventStream) IgnoreElements() *EventStream {
+	return FromEventObservable(ignoreElementsFilter().Event(s))
+}
+
+func (s *EventStream) Replay(size int, duration time.Duration) *EventStream {
+	return FromEventObservable(replayFilter(size, duration).Event(s))
+}
+
+func (s *EventStream) Sample(duration time.Duration) *EventStream {
+	return FromEventObservable(sampleFilter(duration).Event(s))
+}
+
+func (s *EventStream) Debounce(duration time.Duration) *EventStream {
+	return FromEventObservable(debounceFilter(duration).Event(s))
+}
+
+type delayEvent struct {
+	parent     EventObservable
+	delay      func(Event) time.Duration
+	completion time.Duration
+}
+
+func (d *delayEvent) Subscribe(observer EventObserver) Subscription {
+	queue := newDelayQueue(EventObserverAsGenericObserver(observer))
+	parent := d.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		switch {
+		case err != nil:
+			queue.error(err)
+		case complete:
+			queue.enqueue(delayedEntry{complete: true}, d.completion)
+		default:
+			queue.enqueue(delayedEntry{next: next}, d.delay(next))
+		}
+	}))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		queue.dispose()
+	})
+	return subscription
+}
+
+// Delay shifts each value, and completion, forward in time by duration. Errors
+// are not delayed. Values that are still pending are discarded on disposal.
+func (s *EventStream) Delay(duration time.Duration) *EventStream {
+	return &EventStream{&delayEvent{s, func(Event) time.Duration { return duration }, duration}}
+}
+
+// DelayWhen shifts each value forward in time by the duration returned by f.
+// Values are never reordered, so a value is emitted no earlier than the value
+// before it. Completion is emitted after the last value. Errors are not delayed.
+func (s *EventStream) DelayWhen(f func(Event) time.Duration) *EventStream {
+	return &EventStream{&delayEvent{s, f, 0}}
+}
+
+// Wait for completion of the stream and return any error.
+func (s *EventStream) Wait() error {
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next Event, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			errch <- nil
+		default:
+		}
+	})
+	return <-errch
+}
+
+func MakeEventSubscriber(observer EventObserver) EventSubscriber {
+	if subscriber, ok := observer.(EventSubscriber); ok {
+		return subscriber
+	}
+	return &implEventSubscriber{NewGenericSubscription(), observer}
+}
+
+type concatEventSubscriber struct {
+	observable  int
+	observer    EventObserver
+	observables []EventObservable
+	Subscription
+}
+
+func (c *concatEventSubscriber) Next(next Event) {
+	c.observer.Next(next)
+}
+
+func (c *concatEventSubscriber) Error(err error) {
+	c.observer.Error(err)
+	c.observable = len(c.observables)
+	c.Dispose()
+}
+
+func (c *concatEventSubscriber) Complete() {
+	c.observable++
+	if c.observable >= len(c.observables) {
+		c.observer.Complete()
+		c.Dispose()
+		return
+	}
+	c.observables[c.observable].Subscribe(c)
+}
+
+type concatEventObservable struct {
+	observables []EventObservable
+}
+
+func (m *concatEventObservable) Subscribe(observer EventObserver) Subscription {
+	if len(m.observables) == 0 {
+		observer.Complete()
+		return ClosedSubscription
+	}
+	subscriber := &concatEventSubscriber{
+		observer:     observer,
+		Subscription: NewGenericSubscription(),
+		observables:  m.observables,
+	}
+	m.observables[0].Subscribe(subscriber)
+	return subscriber
+}
+
+func (s *EventStream) Concat(observables ...EventObservable) *EventStream {
+	return &EventStream{&concatEventObservable{append([]EventObservable{s}, observables...)}}
+}
+
+// StartWith emits values before the values of the stream.
+func (s *EventStream) StartWith(values ...Event) *EventStream {
+	return FromEventArray(values).Concat(s)
+}
+
+// EndWith emits values after the stream completes.
+func (s *EventStream) EndWith(values ...Event) *EventStream {
+	return s.Concat(FromEventArray(values))
+}
+
+// DefaultIfEmpty emits value if the stream completes without emitting any values.
+func (s *EventStream) DefaultIfEmpty(value Event) *EventStream {
+	return FromEventObservable(MapEvent2EventObservable(s, func(EventObserver) MappingEvent2EventFunc {
+		empty := true
+		return func(next Event, err error, complete bool, observer EventObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				if empty {
+					observer.Next(value)
+				}
+				observer.Complete()
+			default:
+				empty = false
+				observer.Next(next)
+			}
+		}
+	}))
+}
+
+type switchIfEmptyEventObservable struct {
+	parent EventObservable
+	other  EventObservable
+}
+
+func (e *switchIfEmptyEventObservable) Subscribe(observer EventObserver) Subscription {
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
+	empty := true
+	link.Link(e.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			if !empty {
+				observer.Complete()
+			} else if !subscription.Disposed() {
+				subscription.Set(e.other.Subscribe(observer))
+			}
+		default:
+			empty = false
+			observer.Next(next)
+		}
+	})))
+	return subscription
+}
+
+// SwitchIfEmpty switches to other if the stream completes without emitting any values.
+func (s *EventStream) SwitchIfEmpty(other EventObservable) *EventStream {
+	return &EventStream{&switchIfEmptyEventObservable{s, other}}
+}
+
+type mergeEventObservable struct {
+	delayError  bool
+	observables []EventObservable
+}
+
+func (m *mergeEventObservable) Subscribe(observer EventObserver) Subscription {
+	subscription := NewGenericSubscription()
+	lock := sync.Mutex{}
+	completed := 0
+	var firstError error
+	relay := func(next Event, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if completed >= len(m.observables) {
+			return
+		}
+
+		switch {
+		case err != nil:
+			if m.delayError {
+				firstError = err
+				completed++
+			} else {
+				observer.Error(err)
+				completed = len(m.observables)
+			}
+
+		case complete:
+			completed++
+			if completed == len(m.observables) {
+				if firstError != nil {
+					observer.Error(firstError)
+				} else {
+					observer.Complete()
+				}
+			}
+		default:
+			observer.Next(next)
+		}
+	}
+	for _, observable := range m.observables {
+		observable.Subscribe(EventObserverFunc(relay))
+	}
+	return subscription
+}
+
+// Merge an arbitrary number of observables with this one.
+// An error from any of the observables will terminate the merged stream.
+func (s *EventStream) Merge(other ...EventObservable) *EventStream {
+	if len(other) == 0 {
+		return s
+	}
+	return &EventStream{&mergeEventObservable{false, append(other, s)}}
+}
+
+// Merge an arbitrary number of observables with this one.
+// Any error will be deferred until all observables terminate.
+func (s *EventStream) MergeDelayError(other ...EventObservable) *EventStream {
+	if len(other) == 0 {
+		return s
+	}
+	return &EventStream{&mergeEventObservable{true, append(other, s)}}
+}
+
+// Amb mirrors whichever of this stream and others emits first, disposing the rest.
+func (s *EventStream) Amb(others ...EventObservable) *EventStream {
+	return AmbEvent(append([]EventObservable{s}, others...)...)
+}
+
+type catchEventObservable struct {
+	parent EventObservable
+	catch  func(err error) EventObservable
+	// Also switch to the fallback when the parent completes. err will be nil.
+	resume bool
+}
+
+func (r *catchEventObservable) Subscribe(observer EventObserver) Subscription {
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
+	run := func(next Event, err error, complete bool) {
+		switch {
+		case err != nil || (complete && r.resume):
+			if !subscription.Disposed() {
+				subscription.Set(r.catch(err).Subscribe(observer))
+			}
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	}
+	link.Link(r.parent.Subscribe(EventObserverFunc(run)))
+	return subscription
+}
+
+// Catch switches to the catch observable if the stream errors.
+func (s *EventStream) Catch(catch EventObservable) *EventStream {
+	return s.CatchFunc(func(error) EventObservable { return catch })
+}
+
+// CatchFunc switches to the observable returned by f(err) if the stream errors.
+func (s *EventStream) CatchFunc(f func(err error) EventObservable) *EventStream {
+	return &EventStream{&catchEventObservable{parent: s, catch: f}}
+}
+
+// OnErrorReturn emits the single value returned by f(err) and completes if the stream errors.
+func (s *EventStream) OnErrorReturn(f func(err error) Event) *EventStream {
+	return s.CatchFunc(func(err error) EventObservable { return JustEvent(f(err)) })
+}
+
+// OnErrorResumeNext switches to next when the stream terminates, whether it
+// completes or errors.
+func (s *EventStream) OnErrorResumeNext(next EventObservable) *EventStream {
+	return &EventStream{&catchEventObservable{
+		parent: s,
+		catch:  func(error) EventObservable { return next },
+		resume: true,
+	}}
+}
+
+type retryEventObservable struct {
+	observable EventObservable
+	policy     RetryPolicy
+}
+
+func (r *retryEventObservable) Subscribe(observer EventObserver) Subscription {
+	subscription := NewSerialSubscription()
+	attempt := 0
+	var subscribe func()
+	subscribe = func() {
+		// Set the link before subscribing, in case the observable fails
+		// and is resubscribed before Subscribe returns.
+		link := NewLinkedSubscription()
+		subscription.Set(link)
+		link.Link(r.observable.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+			switch {
+			case err != nil:
+				if subscription.Disposed() {
+					return
+				}
+				attempt++
+				delay, ok := r.policy(err, attempt)
+				switch {
+				case !ok:
+					observer.Error(err)
+				case delay > 0:
+					time.AfterFunc(delay, func() {
+						if !subscription.Disposed() {
+							subscribe()
+						}
+					})
+				default:
+					subscribe()
+				}
+			case complete:
+				observer.Complete()
+			default:
+				attempt = 0
+				observer.Next(next)
+			}
+		})))
+	}
+	subscribe()
+	return subscription
+}
+
+// Retry resubscribes to the stream immediately, and indefinitely, on error.
+func (s *EventStream) Retry() *EventStream {
+	return s.RetryWhen(func(error, int) (time.Duration, bool) { return 0, true })
+}
+
+// RetryN resubscribes to the stream on error, at most n times in a row without
+// the stream emitting a value in between.
+func (s *EventStream) RetryN(n int) *EventStream {
+	return s.RetryWhen(func(err error, attempt int) (time.Duration, bool) { return 0, attempt <= n })
+}
+
+// RetryWhen calls policy on each error to decide whether, and after how long,
+// to resubscribe to the stream. attempt counts consecutive errors, starting at
+// 1 and reset whenever the stream emits a value.
+func (s *EventStream) RetryWhen(policy func(err error, attempt int) (time.Duration, bool)) *EventStream {
+	return &EventStream{&retryEventObservable{s, policy}}
+}
+
+// RetryWithBackoff resubscribes to the stream on error, indefinitely, with
+// an ExponentialBackoff delay. The delay is reset to initial whenever the
+// stream emits a value.
+func (s *EventStream) RetryWithBackoff(initial, max time.Duration, multiplier, jitter float64) *EventStream {
+	return s.RetryWhen(ExponentialBackoff(initial, max, multiplier, jitter))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *EventStream) Do(f func(next Event)) *EventStream {
+	return FromEventObservable(MapEvent2EventObserveNext(s, func(next Event) Event {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *EventStream) DoOnError(f func(err error)) *EventStream {
+	return FromEventObservable(MapEvent2EventObserveDirect(s, func(next Event, err error, complete bool, observer EventObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughEvent(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *EventStream) DoOnComplete(f func()) *EventStream {
+	return FromEventObservable(MapEvent2EventObserveDirect(s, func(next Event, err error, complete bool, observer EventObserver) {
+		if complete {
+			f()
+		}
+		PassthroughEvent(next, err, complete, observer)
+	}))
+}
+func (s *EventStream) Reduce(initial Event, reducer func(Event, Event) Event) *EventStream {
+	value := initial
+	return FromEventObservable(MapEvent2EventObserveDirect(s, func(next Event, err error, complete bool, observer EventObserver) {
+		switch {
+		case err != nil:
+			observer.Next(value)
+			observer.Error(err)
+		case complete:
+			observer.Next(value)
+			observer.Complete()
+		default:
+			value = reducer(value, next)
+		}
+	}))
+}
+
+func (s *EventStream) Scan(initial Event, f func(Event, Event) Event) *EventStream {
+	value := initial
+	return FromEventObservable(MapEvent2EventObserveDirect(s, func(next Event, err error, complete bool, observer EventObserver) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			value = f(value, next)
+			observer.Next(value)
+		}
+	}))
+}
+
+type timeoutEvent struct {
+	parent  EventObservable
+	timeout time.Duration
+}
+
+func (t *timeoutEvent) Subscribe(observer EventObserver) Subscription {
+	subscription := NewChannelSubscription()
+	cancel := t.parent.Subscribe(observer)
+	go func() {
+		select {
+		case <-time.After(t.timeout):
+			observer.Error(ErrTimeout)
+			cancel.Dispose()
+			subscription.Dispose()
+		case <-subscription:
+			cancel.Dispose()
+		}
+	}()
+	return subscription
+}
+
+func (s *EventStream) Timeout(timeout time.Duration) *EventStream {
+	return &EventStream{&timeoutEvent{s, timeout}}
+}
+
+type delaySubscriptionEvent struct {
+	parent EventObservable
+	delay  time.Duration
+}
+
+func (d *delaySubscriptionEvent) Subscribe(observer EventObserver) Subscription {
+	subscription := NewLinkedSubscription()
+	time.AfterFunc(d.delay, func() {
+		if !subscription.Disposed() {
+			subscription.Link(d.parent.Subscribe(observer))
+		}
+	})
+	return subscription
+}
+
+// DelaySubscription waits for delay before subscribing to the stream.
+func (s *EventStream) DelaySubscription(delay time.Duration) *EventStream {
+	return &EventStream{&delaySubscriptionEvent{s, delay}}
+}
+
+type takeUntilEvent struct {
+	parent EventObservable
+	signal GenericObservable
+}
+
+func (t *takeUntilEvent) Subscribe(observer EventObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			// A signal that completes without a value never ends the stream.
+			break
+		default:
+			terminate(observer.Complete)
+		}
+	})))
+	parent.Link(t.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// TakeUntil returns elements of the stream until signal, which may be a
+// stream of any type, emits a value. The stream then completes.
+func (s *EventStream) TakeUntil(signal GenericObservable) *EventStream {
+	return &EventStream{&takeUntilEvent{s, signal}}
+}
+
+type skipUntilEvent struct {
+	parent EventObservable
+	signal GenericObservable
+}
+
+func (t *skipUntilEvent) Subscribe(observer EventObserver) Subscription {
+	lock := sync.Mutex{}
+	open := false
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			break
+		default:
+			lock.Lock()
+			open = true
+			lock.Unlock()
+			signal.Dispose()
+		}
+	})))
+	parent.Link(t.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if open && !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// SkipUntil skips elements of the stream until signal, which may be a stream
+// of any type, emits a value.
+func (s *EventStream) SkipUntil(signal GenericObservable) *EventStream {
+	return &EventStream{&skipUntilEvent{s, signal}}
+}
+
+type forkedEventStream struct {
+	lock      sync.Mutex
+	parent    EventObservable
+	observers []EventObserver
+}
+
+func (f *forkedEventStream) Subscribe(observer EventObserver) Subscription {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	i := len(f.observers)
+	f.observers = append(f.observers, observer)
+	sub := new(CallbackSubscription)
+	*sub = CallbackSubscription(func() {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		f.observers[i] = nil
+	})
+	return sub
+}
+
+// Fork replicates each event from the parent to every subscriber of the fork.
+func (s *EventStream) Fork() *EventStream {
+	f := &forkedEventStream{parent: s}
+	go s.Subscribe(EventObserverFunc(func(n Event, err error, complete bool) {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		for _, o := range f.observers {
+			if o == nil {
+				continue
+			}
+			switch {
+			case err != nil:
+				o.Error(err)
+			case complete:
+				o.Complete()
+			default:
+				o.Next(n)
+			}
+		}
+	}))
+	return &EventStream{f}
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *EventStream) ToOneWithError() (Event, error) {
+	valuech := make(chan Event, 1)
+	errch := make(chan error, 1)
+	FromEventObservable(oneFilter().Event(s)).SubscribeFunc(func(next Event, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroEvent, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *EventStream) ToOne() Event {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *EventStream) ToArrayWithError() ([]Event, error) {
+	array := []Event{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next Event, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *EventStream) ToArray() []Event {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *EventStream) ToChannelWithError() (<-chan Event, <-chan error) {
+	ch := make(chan Event, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next Event, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *EventStream) ToChannel() <-chan Event {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *EventStream) Seq() iter.Seq[Event] {
+	return func(yield func(Event) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *EventStream) Seq2() iter.Seq2[Event, error] {
+	return func(yield func(Event, error) bool) {
+		type notification struct {
+			next     Event
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next Event, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroEvent, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+// Count returns an IntStream with the count of elements in this stream.
+func (s *EventStream) Count() *IntStream {
+	count := 0
+	return FromIntObservable(MapEvent2IntObserveDirect(s, func(next Event, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			observer.Next(count)
+			observer.Error(err)
+		case complete:
+			observer.Next(count)
+			observer.Complete()
+		default:
+			count++
+		}
+	}))
+}
+
+func equalEvent(a, b Event) bool {
+	return a == b
+}
+
+type decideEvent struct {
+	parent EventObservable
+	// decide returns the result and true once the answer is known.
+	decide func(next Event) (bool, bool)
+	// otherwise is the result if the stream completes first.
+	otherwise bool
+}
+
+func (d *decideEvent) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	subscription := NewLinkedSubscription()
+	subscription.Link(d.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Next(d.otherwise)
+			observer.Complete()
+		default:
+			if result, ok := d.decide(next); ok {
+				done = true
+				observer.Next(result)
+				observer.Complete()
+				subscription.Dispose()
+			}
+		}
+	})))
+	return subscription
+}
+
+// All emits true if f returns true for every value in the stream, or false
+// as soon as it does not.
+func (s *EventStream) All(f func(Event) bool) *BoolStream {
+	return FromBoolObservable(&decideEvent{s, func(next Event) (bool, bool) { return false, !f(next) }, true})
+}
+
+// Any emits true as soon as f returns true for a value in the stream, or
+// false if it never does.
+func (s *EventStream) Any(f func(Event) bool) *BoolStream {
+	return FromBoolObservable(&decideEvent{s, func(next Event) (bool, bool) { return true, f(next) }, false})
+}
+
+// Contains emits true as soon as value is seen in the stream, or false if it
+// never is.
+func (s *EventStream) Contains(value Event) *BoolStream {
+	return s.Any(func(next Event) bool { return equalEvent(next, value) })
+}
+
+// IsEmpty emits true if the stream completes without a value, or false as
+// soon as it emits one.
+func (s *EventStream) IsEmpty() *BoolStream {
+	return FromBoolObservable(&decideEvent{s, func(Event) (bool, bool) { return false, true }, true})
+}
+
+type sequenceEqualEvent struct {
+	parent EventObservable
+	other  EventObservable
+}
+
+func (e *sequenceEqualEvent) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	// Values seen on one side that the other has not caught up with yet.
+	// Only one side can be ahead at a time.
+	pending := [2][]Event{}
+	completed := [2]bool{}
+	subscriptions := [2]*LinkedSubscription{NewLinkedSubscription(), NewLinkedSubscription()}
+	dispose := func() {
+		subscriptions[0].Dispose()
+		subscriptions[1].Dispose()
+	}
+	finish := func(result bool) {
+		done = true
+		observer.Next(result)
+		observer.Complete()
+		dispose()
+	}
+	observe := func(i int) EventObserver {
+		j := 1 - i
+		return EventObserverFunc(func(next Event, err error, complete bool) {
+			lock.Lock()
+			defer lock.Unlock()
+			if done {
+				return
+			}
+			switch {
+			case err != nil:
+				done = true
+				observer.Error(err)
+				dispose()
+			case complete:
+				completed[i] = true
+				if len(pending[j]) > 0 {
+					finish(false)
+				} else if completed[j] {
+					finish(true)
+				}
+			case len(pending[j]) > 0:
+				expected := pending[j][0]
+				pending[j] = pending[j][1:]
+				if !equalEvent(next, expected) {
+					finish(false)
+				}
+			case completed[j]:
+				finish(false)
+			default:
+				pending[i] = append(pending[i], next)
+			}
+		})
+	}
+	subscriptions[0].Link(e.parent.Subscribe(observe(0)))
+	subscriptions[1].Link(e.other.Subscribe(observe(1)))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(dispose)
+	return subscription
+}
+
+// SequenceEqual emits true if the stream and other emit equal values in the
+// same order and both complete, or false as soon as they differ.
+func (s *EventStream) SequenceEqual(other EventObservable) *BoolStream {
+	return FromBoolObservable(&sequenceEqualEvent{s, other})
+}
+
+// EventNotification is a materialized event from a EventStream.
+type EventNotification struct {
+	Kind  NotificationKind
+	Value Event // Set if Kind is NotificationNext.
+	Err   error // Set if Kind is NotificationError.
+}
+
+// Materialize emits every event of the stream, including termination, as a
+// EventNotification. The materialized stream completes after the
+// notification for the termination of this stream.
+func (s *EventStream) Materialize() *EventNotificationStream {
+	return FromEventNotificationObservable(MapEvent2EventNotificationObserveDirect(s, func(next Event, err error, complete bool, observer EventNotificationObserver) {
+		switch {
+		case err != nil:
+			observer.Next(EventNotification{Kind: NotificationError, Err: err})
+			observer.Complete()
+		case complete:
+			observer.Next(EventNotification{Kind: NotificationComplete})
+			observer.Complete()
+		default:
+			observer.Next(EventNotification{Kind: NotificationNext, Value: next})
+		}
+	}))
+}
+
+// Dematerialize converts materialized notifications back into the events they
+// represent. Notifications after the first termination are ignored.
+func (s *EventNotificationStream) Dematerialize() *EventStream {
+	return FromEventObservable(MapEventNotification2EventObservable(s, func(EventObserver) MappingEventNotification2EventFunc {
+		terminated := false
+		return func(next EventNotification, err error, complete bool, observer EventObserver) {
+			if terminated {
+				return
+			}
+			switch {
+			case err != nil:
+				terminated = true
+				observer.Error(err)
+			case complete:
+				terminated = true
+				observer.Complete()
+			default:
+				switch next.Kind {
+				case NotificationNext:
+					observer.Next(next.Value)
+				case NotificationError:
+					terminated = true
+					observer.Error(next.Err)
+				case NotificationComplete:
+					terminated = true
+					observer.Complete()
+				}
+			}
+		}
+	}))
+}
+
+// TimestampedEvent is a value from a EventStream and the time it was emitted.
+type TimestampedEvent struct {
+	Value Event
+	Time  time.Time
+}
+
+// Timestamp annotates each value in the stream with the time it was emitted.
+func (s *EventStream) Timestamp() *TimestampedEventStream {
+	return FromTimestampedEventObservable(MapEvent2TimestampedEventObserveNext(s, func(next Event) TimestampedEvent {
+		return TimestampedEvent{next, Now()}
+	}))
+}
+
+// IntervalEvent is a value from a EventStream and the time elapsed
+// since the previous value was emitted.
+type IntervalEvent struct {
+	Value    Event
+	Interval time.Duration
+}
+
+// TimeInterval annotates each value in the stream with the time elapsed since
+// the previous value, or since subscription for the first value.
+func (s *EventStream) TimeInterval() *IntervalEventStream {
+	return FromIntervalEventObservable(MapEvent2IntervalEventObservable(s, func(IntervalEventObserver) MappingEvent2IntervalEventFunc {
+		last := Now()
+		return func(next Event, err error, complete bool, observer IntervalEventObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				now := Now()
+				observer.Next(IntervalEvent{next, now.Sub(last)})
+				last = now
+			}
+		}
+	}))
+}
+
+// DecodeEventJSONLines decodes a Event from each line of r. A line
+// that can not be decoded terminates the stream with an error.
+func DecodeEventJSONLines(r io.Reader) *EventStream {
+	return DecodeEventJSONLinesWithPolicy(r, FailOnDecodeError)
+}
+
+// DecodeEventJSONLinesWithPolicy decodes a Event from each line of r,
+// passing lines that can not be decoded to policy. Blank lines are ignored.
+func DecodeEventJSONLinesWithPolicy(r io.Reader, policy DecodeErrorPolicy) *EventStream {
+	return CreateEvent(func(observer EventObserver, subscription Subscription) {
+		reader := bufio.NewReader(r)
+		for {
+			if subscription.Disposed() {
+				return
+			}
+			line, err := reader.ReadBytes('\n')
+			if err != nil && err != io.EOF {
+				observer.Error(err)
+				return
+			}
+			if len(bytes.TrimSpace(line)) > 0 {
+				var value Event
+				if derr := json.Unmarshal(line, &value); derr == nil {
+					observer.Next(value)
+				} else if derr = policy(line, derr); derr != nil {
+					observer.Error(derr)
+					return
+				}
+			}
+			if err == io.EOF {
+				observer.Complete()
+				return
+			}
+		}
+	})
+}
+
+// EncodeJSONLines writes each value in the stream to w as a line of JSON,
+// blocking until the stream completes. The error from the stream or from
+// encoding is returned. An encoding error disposes the stream.
+func (s *EventStream) EncodeJSONLines(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	errch := make(chan error, 1)
+	failed := false
+	subscription := s.SubscribeFunc(func(next Event, err error, complete bool) {
+		if failed {
+			return
+		}
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			errch <- nil
+		default:
+			if err := encoder.Encode(next); err != nil {
+				failed = true
+				errch <- err
+			}
+		}
+	})
+	err := <-errch
+	subscription.Dispose()
+	return err
+}
+
+type MappingEvent2InterfaceFunc func(next Event, err error, complete bool, observer InterfaceObserver)
+type MappingEvent2InterfaceFuncFactory func(observer InterfaceObserver) MappingEvent2InterfaceFunc
+
+type MappingEvent2InterfaceObservable struct {
+	parent EventObservable
+	mapper MappingEvent2InterfaceFuncFactory
+}
+
+func (f *MappingEvent2InterfaceObservable) Subscribe(observer InterfaceObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapEvent2InterfaceObservable(parent EventObservable, mapper MappingEvent2InterfaceFuncFactory) InterfaceObservable {
+	return &MappingEvent2InterfaceObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapEvent2InterfaceObserveDirect(parent EventObservable, mapper MappingEvent2InterfaceFunc) InterfaceObservable {
+	return MapEvent2InterfaceObservable(parent, func(InterfaceObserver) MappingEvent2InterfaceFunc {
+		return mapper
+	})
+}
+
+func MapEvent2InterfaceObserveNext(parent EventObservable, mapper func(Event) interface{}) InterfaceObservable {
+	return MapEvent2InterfaceObservable(parent, func(InterfaceObserver) MappingEvent2InterfaceFunc {
+		return func(next Event, err error, complete bool, observer InterfaceObserver) {
+			var mapped interface{}
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInterface(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapEvent2Interface struct {
+	parent EventObservable
+	mapper func(Event) InterfaceObservable
+}
+
+func (f *flatMapEvent2Interface) Subscribe(observer InterfaceObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&InterfaceStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &InterfaceStream{ignoreCompletionFilter().Interface(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInterface maps this stream to an InterfaceStream via f.
+func (s *EventStream) MapInterface(f func(Event) interface{}) *InterfaceStream {
+	return FromInterfaceObservable(MapEvent2InterfaceObserveNext(s, f))
+}
+
+func (s *EventStream) FlatMapInterface(f func(Event) InterfaceObservable) *InterfaceStream {
+	return &InterfaceStream{&flatMapEvent2Interface{s, f}}
+}
+
+type MappingEvent2EventFunc func(next Event, err error, complete bool, observer EventObserver)
+type MappingEvent2EventFuncFactory func(observer EventObserver) MappingEvent2EventFunc
+
+type MappingEvent2EventObservable struct {
+	parent EventObservable
+	mapper MappingEvent2EventFuncFactory
+}
+
+func (f *MappingEvent2EventObservable) Subscribe(observer EventObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapEvent2EventObservable(parent EventObservable, mapper MappingEvent2EventFuncFactory) EventObservable {
+	return &MappingEvent2EventObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapEvent2EventObserveDirect(parent EventObservable, mapper MappingEvent2EventFunc) EventObservable {
+	return MapEvent2EventObservable(parent, func(EventObserver) MappingEvent2EventFunc {
+		return mapper
+	})
+}
+
+func MapEvent2EventObserveNext(parent EventObservable, mapper func(Event) Event) EventObservable {
+	return MapEvent2EventObservable(parent, func(EventObserver) MappingEvent2EventFunc {
+		return func(next Event, err error, complete bool, observer EventObserver) {
+			var mapped Event
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughEvent(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapEvent2Event struct {
+	parent EventObservable
+	mapper func(Event) EventObservable
+}
+
+func (f *flatMapEvent2Event) Subscribe(observer EventObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&EventStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &EventStream{ignoreCompletionFilter().Event(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// Map maps values in this stream to another value.
+func (s *EventStream) Map(f func(Event) Event) *EventStream {
+	return FromEventObservable(MapEvent2EventObserveNext(s, f))
+}
+
+func (s *EventStream) FlatMap(f func(Event) EventObservable) *EventStream {
+	return &EventStream{&flatMapEvent2Event{s, f}}
+}
+
+type MappingEvent2IntFunc func(next Event, err error, complete bool, observer IntObserver)
+type MappingEvent2IntFuncFactory func(observer IntObserver) MappingEvent2IntFunc
+
+type MappingEvent2IntObservable struct {
+	parent EventObservable
+	mapper MappingEvent2IntFuncFactory
+}
+
+func (f *MappingEvent2IntObservable) Subscribe(observer IntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapEvent2IntObservable(parent EventObservable, mapper MappingEvent2IntFuncFactory) IntObservable {
+	return &MappingEvent2IntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapEvent2IntObserveDirect(parent EventObservable, mapper MappingEvent2IntFunc) IntObservable {
+	return MapEvent2IntObservable(parent, func(IntObserver) MappingEvent2IntFunc {
+		return mapper
+	})
+}
+
+func MapEvent2IntObserveNext(parent EventObservable, mapper func(Event) int) IntObservable {
+	return MapEvent2IntObservable(parent, func(IntObserver) MappingEvent2IntFunc {
+		return func(next Event, err error, complete bool, observer IntObserver) {
+			var mapped int
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapEvent2Int struct {
+	parent EventObservable
+	mapper func(Event) IntObservable
+}
+
+func (f *flatMapEvent2Int) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&IntStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &IntStream{ignoreCompletionFilter().Int(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *EventStream) MapInt(f func(Event) int) *IntStream {
+	return FromIntObservable(MapEvent2IntObserveNext(s, f))
+}
+
+func (s *EventStream) FlatMapInt(f func(Event) IntObservable) *IntStream {
+	return &IntStream{&flatMapEvent2Int{s, f}}
+}
+
+type MappingEvent2BoolFunc func(next Event, err error, complete bool, observer BoolObserver)
+type MappingEvent2BoolFuncFactory func(observer BoolObserver) MappingEvent2BoolFunc
+
+type MappingEvent2BoolObservable struct {
+	parent EventObservable
+	mapper MappingEvent2BoolFuncFactory
+}
+
+func (f *MappingEvent2BoolObservable) Subscribe(observer BoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapEvent2BoolObservable(parent EventObservable, mapper MappingEvent2BoolFuncFactory) BoolObservable {
+	return &MappingEvent2BoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapEvent2BoolObserveDirect(parent EventObservable, mapper MappingEvent2BoolFunc) BoolObservable {
+	return MapEvent2BoolObservable(parent, func(BoolObserver) MappingEvent2BoolFunc {
+		return mapper
+	})
+}
+
+func MapEvent2BoolObserveNext(parent EventObservable, mapper func(Event) bool) BoolObservable {
+	return MapEvent2BoolObservable(parent, func(BoolObserver) MappingEvent2BoolFunc {
+		return func(next Event, err error, complete bool, observer BoolObserver) {
+			var mapped bool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapEvent2Bool struct {
+	parent EventObservable
+	mapper func(Event) BoolObservable
+}
+
+func (f *flatMapEvent2Bool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&BoolStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &BoolStream{ignoreCompletionFilter().Bool(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *EventStream) MapBool(f func(Event) bool) *BoolStream {
+	return FromBoolObservable(MapEvent2BoolObserveNext(s, f))
+}
+
+func (s *EventStream) FlatMapBool(f func(Event) BoolObservable) *BoolStream {
+	return &BoolStream{&flatMapEvent2Bool{s, f}}
+}
+
+type MappingEvent2EventNotificationFunc func(next Event, err error, complete bool, observer EventNotificationObserver)
+type MappingEvent2EventNotificationFuncFactory func(observer EventNotificationObserver) MappingEvent2EventNotificationFunc
+
+type MappingEvent2EventNotificationObservable struct {
+	parent EventObservable
+	mapper MappingEvent2EventNotificationFuncFactory
+}
+
+func (f *MappingEvent2EventNotificationObservable) Subscribe(observer EventNotificationObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapEvent2EventNotificationObservable(parent EventObservable, mapper MappingEvent2EventNotificationFuncFactory) EventNotificationObservable {
+	return &MappingEvent2EventNotificationObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapEvent2EventNotificationObserveDirect(parent EventObservable, mapper MappingEvent2EventNotificationFunc) EventNotificationObservable {
+	return MapEvent2EventNotificationObservable(parent, func(EventNotificationObserver) MappingEvent2EventNotificationFunc {
+		return mapper
+	})
+}
+
+func MapEvent2EventNotificationObserveNext(parent EventObservable, mapper func(Event) EventNotification) EventNotificationObservable {
+	return MapEvent2EventNotificationObservable(parent, func(EventNotificationObserver) MappingEvent2EventNotificationFunc {
+		return func(next Event, err error, complete bool, observer EventNotificationObserver) {
+			var mapped EventNotification
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughEventNotification(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapEventNotification maps this stream to an EventNotificationStream via f.
+func (s *EventStream) MapEventNotification(f func(Event) EventNotification) *EventNotificationStream {
+	return FromEventNotificationObservable(MapEvent2EventNotificationObserveNext(s, f))
+}
+
+type MappingEvent2TimestampedEventFunc func(next Event, err error, complete bool, observer TimestampedEventObserver)
+type MappingEvent2TimestampedEventFuncFactory func(observer TimestampedEventObserver) MappingEvent2TimestampedEventFunc
+
+type MappingEvent2TimestampedEventObservable struct {
+	parent EventObservable
+	mapper MappingEvent2TimestampedEventFuncFactory
+}
+
+func (f *MappingEvent2TimestampedEventObservable) Subscribe(observer TimestampedEventObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapEvent2TimestampedEventObservable(parent EventObservable, mapper MappingEvent2TimestampedEventFuncFactory) TimestampedEventObservable {
+	return &MappingEvent2TimestampedEventObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapEvent2TimestampedEventObserveDirect(parent EventObservable, mapper MappingEvent2TimestampedEventFunc) TimestampedEventObservable {
+	return MapEvent2TimestampedEventObservable(parent, func(TimestampedEventObserver) MappingEvent2TimestampedEventFunc {
+		return mapper
+	})
+}
+
+func MapEvent2TimestampedEventObserveNext(parent EventObservable, mapper func(Event) TimestampedEvent) TimestampedEventObservable {
+	return MapEvent2TimestampedEventObservable(parent, func(TimestampedEventObserver) MappingEvent2TimestampedEventFunc {
+		return func(next Event, err error, complete bool, observer TimestampedEventObserver) {
+			var mapped TimestampedEvent
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughTimestampedEvent(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapTimestampedEvent maps this stream to an TimestampedEventStream via f.
+func (s *EventStream) MapTimestampedEvent(f func(Event) TimestampedEvent) *TimestampedEventStream {
+	return FromTimestampedEventObservable(MapEvent2TimestampedEventObserveNext(s, f))
+}
+
+type MappingEvent2IntervalEventFunc func(next Event, err error, complete bool, observer IntervalEventObserver)
+type MappingEvent2IntervalEventFuncFactory func(observer IntervalEventObserver) MappingEvent2IntervalEventFunc
+
+type MappingEvent2IntervalEventObservable struct {
+	parent EventObservable
+	mapper MappingEvent2IntervalEventFuncFactory
+}
+
+func (f *MappingEvent2IntervalEventObservable) Subscribe(observer IntervalEventObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(EventObserverFunc(func(next Event, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapEvent2IntervalEventObservable(parent EventObservable, mapper MappingEvent2IntervalEventFuncFactory) IntervalEventObservable {
+	return &MappingEvent2IntervalEventObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapEvent2IntervalEventObserveDirect(parent EventObservable, mapper MappingEvent2IntervalEventFunc) IntervalEventObservable {
+	return MapEvent2IntervalEventObservable(parent, func(IntervalEventObserver) MappingEvent2IntervalEventFunc {
+		return mapper
+	})
+}
+
+func MapEvent2IntervalEventObserveNext(parent EventObservable, mapper func(Event) IntervalEvent) IntervalEventObservable {
+	return MapEvent2IntervalEventObservable(parent, func(IntervalEventObserver) MappingEvent2IntervalEventFunc {
+		return func(next Event, err error, complete bool, observer IntervalEventObserver) {
+			var mapped IntervalEvent
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntervalEvent(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapIntervalEvent maps this stream to an IntervalEventStream via f.
+func (s *EventStream) MapIntervalEvent(f func(Event) IntervalEvent) *IntervalEventStream {
+	return FromIntervalEventObservable(MapEvent2IntervalEventObserveNext(s, f))
+}
+
+type EventNotificationObserver interface {
+	Next(EventNotification)
+	TerminationObserver
+}
+
+// A EventNotificationSubscriber represents a subscribed EventNotificationObserver.
+type EventNotificationSubscriber interface {
+	Subscription
+	EventNotificationObserver
+}
+
+type implEventNotificationSubscriber struct {
+	Subscription
+	EventNotificationObserver
+}
+
+func EventNotificationObserverAsGenericObserver(observer EventNotificationObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(EventNotification))
+		}
+	})
+}
+
+func GenericObserverAsEventNotificationObserver(observer GenericObserver) EventNotificationObserver {
+	return EventNotificationObserverFunc(func(next EventNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type EventNotificationObservableFactory func(observer EventNotificationObserver, subscription Subscription)
+
+func (f EventNotificationObservableFactory) Subscribe(observer EventNotificationObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateEventNotification calls f(observer, subscription) to produce values for a stream.
+func CreateEventNotification(f func(observer EventNotificationObserver, subscription Subscription)) *EventNotificationStream {
+	return FromEventNotificationObservable(EventNotificationObservableFactory(f))
+}
+func PassthroughEventNotification(next EventNotification, err error, complete bool, observer EventNotificationObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroEventNotification = *new(EventNotification)
+
+type EventNotificationObserverFunc func(EventNotification, error, bool)
+
+func (f EventNotificationObserverFunc) Next(next EventNotification) { f(next, nil, false) }
+func (f EventNotificationObserverFunc) Error(err error)             { f(zeroEventNotification, err, false) }
+func (f EventNotificationObserverFunc) Complete()                   { f(zeroEventNotification, nil, true) }
+
+type EventNotificationObservable interface {
+	Subscribe(EventNotificationObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a EventNotificationObservable
+func (f GenericObservableFilterFactory) EventNotification(parent EventNotificationObservable) EventNotificationObservable {
+	return MapEventNotification2EventNotificationObservable(parent, func(observer EventNotificationObserver) MappingEventNotification2EventNotificationFunc {
+		gobserver := EventNotificationObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next EventNotification, err error, complete bool, observer EventNotificationObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromEventNotificationArray(array []EventNotification) *EventNotificationStream {
+	return CreateEventNotification(func(observer EventNotificationObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromEventNotifications(array ...EventNotification) *EventNotificationStream {
+	return FromEventNotificationArray(array)
+}
+
+func FromEventNotificationChannel(ch <-chan EventNotification) *EventNotificationStream {
+	return CreateEventNotification(func(observer EventNotificationObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type EventNotificationStream struct {
+	EventNotificationObservable
+}
+
+func FromEventNotificationObservable(observable EventNotificationObservable) *EventNotificationStream {
+	return &EventNotificationStream{observable}
+}
+
+func (s *EventNotificationStream) SubscribeFunc(f func(EventNotification, error, bool)) Subscription {
+	return s.Subscribe(EventNotificationObserverFunc(f))
+}
+
+func (s *EventNotificationStream) SubscribeNext(f func(v EventNotification)) Subscription {
+	return s.SubscribeFunc(func(next EventNotification, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *EventNotificationStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsEventNotificationObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *EventNotificationStream) Filter(f func(EventNotification) bool) *EventNotificationStream {
+	return FromEventNotificationObservable(filterFilter(func(v interface{}) bool { return f(v.(EventNotification)) }).EventNotification(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *EventNotificationStream) Do(f func(next EventNotification)) *EventNotificationStream {
+	return FromEventNotificationObservable(MapEventNotification2EventNotificationObserveNext(s, func(next EventNotification) EventNotification {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *EventNotificationStream) DoOnError(f func(err error)) *EventNotificationStream {
+	return FromEventNotificationObservable(MapEventNotification2EventNotificationObserveDirect(s, func(next EventNotification, err error, complete bool, observer EventNotificationObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughEventNotification(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *EventNotificationStream) DoOnComplete(f func()) *EventNotificationStream {
+	return FromEventNotificationObservable(MapEventNotification2EventNotificationObserveDirect(s, func(next EventNotification, err error, complete bool, observer EventNotificationObserver) {
+		if complete {
+			f()
+		}
+		PassthroughEventNotification(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *EventNotificationStream) ToOneWithError() (EventNotification, error) {
+	valuech := make(chan EventNotification, 1)
+	errch := make(chan error, 1)
+	FromEventNotificationObservable(oneFilter().EventNotification(s)).SubscribeFunc(func(next EventNotification, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroEventNotification, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *EventNotificationStream) ToOne() EventNotification {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *EventNotificationStream) ToArrayWithError() ([]EventNotification, error) {
+	array := []EventNotification{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next EventNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *EventNotificationStream) ToArray() []EventNotification {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *EventNotificationStream) ToChannelWithError() (<-chan EventNotification, <-chan error) {
+	ch := make(chan EventNotification, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next EventNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *EventNotificationStream) ToChannel() <-chan EventNotification {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *EventNotificationStream) Seq() iter.Seq[EventNotification] {
+	return func(yield func(EventNotification) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *EventNotificationStream) Seq2() iter.Seq2[EventNotification, error] {
+	return func(yield func(EventNotification, error) bool) {
+		type notification struct {
+			next     EventNotification
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next EventNotification, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroEventNotification, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapEventNotification2Interface struct {
+	parent EventNotificationObservable
+	f      func(EventNotification) interface{}
+}
+
+func (m *mapEventNotification2Interface) Subscribe(observer InterfaceObserver) Subscription {
+	return m.parent.Subscribe(EventNotificationObserverFunc(func(next EventNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInterface maps this stream to an InterfaceStream via f.
+func (s *EventNotificationStream) MapInterface(f func(EventNotification) interface{}) *InterfaceStream {
+	return &InterfaceStream{&mapEventNotification2Interface{s, f}}
+}
+
+type mapEventNotification2Int struct {
+	parent EventNotificationObservable
+	f      func(EventNotification) int
+}
+
+func (m *mapEventNotification2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(EventNotificationObserverFunc(func(next EventNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *EventNotificationStream) MapInt(f func(EventNotification) int) *IntStream {
+	return &IntStream{&mapEventNotification2Int{s, f}}
+}
+
+type mapEventNotification2Bool struct {
+	parent EventNotificationObservable
+	f      func(EventNotification) bool
+}
+
+func (m *mapEventNotification2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(EventNotificationObserverFunc(func(next EventNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *EventNotificationStream) MapBool(f func(EventNotification) bool) *BoolStream {
+	return &BoolStream{&mapEventNotification2Bool{s, f}}
+}
+
+type MappingEventNotification2EventNotificationFunc func(next EventNotification, err error, complete bool, observer EventNotificationObserver)
+type MappingEventNotification2EventNotificationFuncFactory func(observer EventNotificationObserver) MappingEventNotification2EventNotificationFunc
+
+type MappingEventNotification2EventNotificationObservable struct {
+	parent EventNotificationObservable
+	mapper MappingEventNotification2EventNotificationFuncFactory
+}
+
+func (f *MappingEventNotification2EventNotificationObservable) Subscribe(observer EventNotificationObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(EventNotificationObserverFunc(func(next EventNotification, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapEventNotification2EventNotificationObservable(parent EventNotificationObservable, mapper MappingEventNotification2EventNotificationFuncFactory) EventNotificationObservable {
+	return &MappingEventNotification2EventNotificationObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapEventNotification2EventNotificationObserveDirect(parent EventNotificationObservable, mapper MappingEventNotification2EventNotificationFunc) EventNotificationObservable {
+	return MapEventNotification2EventNotificationObservable(parent, func(EventNotificationObserver) MappingEventNotification2EventNotificationFunc {
+		return mapper
+	})
+}
+
+func MapEventNotification2EventNotificationObserveNext(parent EventNotificationObservable, mapper func(EventNotification) EventNotification) EventNotificationObservable {
+	return MapEventNotification2EventNotificationObservable(parent, func(EventNotificationObserver) MappingEventNotification2EventNotificationFunc {
+		return func(next EventNotification, err error, complete bool, observer EventNotificationObserver) {
+			var mapped EventNotification
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughEventNotification(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *EventNotificationStream) Map(f func(EventNotification) EventNotification) *EventNotificationStream {
+	return FromEventNotificationObservable(MapEventNotification2EventNotificationObserveNext(s, f))
+}
+
+type MappingEventNotification2EventFunc func(next EventNotification, err error, complete bool, observer EventObserver)
+type MappingEventNotification2EventFuncFactory func(observer EventObserver) MappingEventNotification2EventFunc
+
+type MappingEventNotification2EventObservable struct {
+	parent EventNotificationObservable
+	mapper MappingEventNotification2EventFuncFactory
+}
+
+func (f *MappingEventNotification2EventObservable) Subscribe(observer EventObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(EventNotificationObserverFunc(func(next EventNotification, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapEventNotification2EventObservable(parent EventNotificationObservable, mapper MappingEventNotification2EventFuncFactory) EventObservable {
+	return &MappingEventNotification2EventObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapEventNotification2EventObserveDirect(parent EventNotificationObservable, mapper MappingEventNotification2EventFunc) EventObservable {
+	return MapEventNotification2EventObservable(parent, func(EventObserver) MappingEventNotification2EventFunc {
+		return mapper
+	})
+}
+
+func MapEventNotification2EventObserveNext(parent EventNotificationObservable, mapper func(EventNotification) Event) EventObservable {
+	return MapEventNotification2EventObservable(parent, func(EventObserver) MappingEventNotification2EventFunc {
+		return func(next EventNotification, err error, complete bool, observer EventObserver) {
+			var mapped Event
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughEvent(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapEventNotification2Event struct {
+	parent EventNotificationObservable
+	mapper func(EventNotification) EventObservable
+}
+
+func (f *flatMapEventNotification2Event) Subscribe(observer EventObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(EventNotificationObserverFunc(func(next EventNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&EventStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &EventStream{ignoreCompletionFilter().Event(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapEvent maps this stream to an EventStream via f.
+func (s *EventNotificationStream) MapEvent(f func(EventNotification) Event) *EventStream {
+	return FromEventObservable(MapEventNotification2EventObserveNext(s, f))
+}
+
+func (s *EventNotificationStream) FlatMapEvent(f func(EventNotification) EventObservable) *EventStream {
+	return &EventStream{&flatMapEventNotification2Event{s, f}}
+}
+
+type TimestampedEventObserver interface {
+	Next(TimestampedEvent)
+	TerminationObserver
+}
+
+// A TimestampedEventSubscriber represents a subscribed TimestampedEventObserver.
+type TimestampedEventSubscriber interface {
+	Subscription
+	TimestampedEventObserver
+}
+
+type implTimestampedEventSubscriber struct {
+	Subscription
+	TimestampedEventObserver
+}
+
+func TimestampedEventObserverAsGenericObserver(observer TimestampedEventObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(TimestampedEvent))
+		}
+	})
+}
+
+func GenericObserverAsTimestampedEventObserver(observer GenericObserver) TimestampedEventObserver {
+	return TimestampedEventObserverFunc(func(next TimestampedEvent, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type TimestampedEventObservableFactory func(observer TimestampedEventObserver, subscription Subscription)
+
+func (f TimestampedEventObservableFactory) Subscribe(observer TimestampedEventObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateTimestampedEvent calls f(observer, subscription) to produce values for a stream.
+func CreateTimestampedEvent(f func(observer TimestampedEventObserver, subscription Subscription)) *TimestampedEventStream {
+	return FromTimestampedEventObservable(TimestampedEventObservableFactory(f))
+}
+func PassthroughTimestampedEvent(next TimestampedEvent, err error, complete bool, observer TimestampedEventObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroTimestampedEvent = *new(TimestampedEvent)
+
+type TimestampedEventObserverFunc func(TimestampedEvent, error, bool)
+
+func (f TimestampedEventObserverFunc) Next(next TimestampedEvent) { f(next, nil, false) }
+func (f TimestampedEventObserverFunc) Error(err error)            { f(zeroTimestampedEvent, err, false) }
+func (f TimestampedEventObserverFunc) Complete()                  { f(zeroTimestampedEvent, nil, true) }
+
+type TimestampedEventObservable interface {
+	Subscribe(TimestampedEventObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a TimestampedEventObservable
+func (f GenericObservableFilterFactory) TimestampedEvent(parent TimestampedEventObservable) TimestampedEventObservable {
+	return MapTimestampedEvent2TimestampedEventObservable(parent, func(observer TimestampedEventObserver) MappingTimestampedEvent2TimestampedEventFunc {
+		gobserver := TimestampedEventObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next TimestampedEvent, err error, complete bool, observer TimestampedEventObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromTimestampedEventArray(array []TimestampedEvent) *TimestampedEventStream {
+	return CreateTimestampedEvent(func(observer TimestampedEventObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromTimestampedEvents(array ...TimestampedEvent) *TimestampedEventStream {
+	return FromTimestampedEventArray(array)
+}
+
+func FromTimestampedEventChannel(ch <-chan TimestampedEvent) *TimestampedEventStream {
+	return CreateTimestampedEvent(func(observer TimestampedEventObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type TimestampedEventStream struct {
+	TimestampedEventObservable
+}
+
+func FromTimestampedEventObservable(observable TimestampedEventObservable) *TimestampedEventStream {
+	return &TimestampedEventStream{observable}
+}
+
+func (s *TimestampedEventStream) SubscribeFunc(f func(TimestampedEvent, error, bool)) Subscription {
+	return s.Subscribe(TimestampedEventObserverFunc(f))
+}
+
+func (s *TimestampedEventStream) SubscribeNext(f func(v TimestampedEvent)) Subscription {
+	return s.SubscribeFunc(func(next TimestampedEvent, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *TimestampedEventStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsTimestampedEventObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *TimestampedEventStream) Filter(f func(TimestampedEvent) bool) *TimestampedEventStream {
+	return FromTimestampedEventObservable(filterFilter(func(v interface{}) bool { return f(v.(TimestampedEvent)) }).TimestampedEvent(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *TimestampedEventStream) Do(f func(next TimestampedEvent)) *TimestampedEventStream {
+	return FromTimestampedEventObservable(MapTimestampedEvent2TimestampedEventObserveNext(s, func(next TimestampedEvent) TimestampedEvent {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *TimestampedEventStream) DoOnError(f func(err error)) *TimestampedEventStream {
+	return FromTimestampedEventObservable(MapTimestampedEvent2TimestampedEventObserveDirect(s, func(next TimestampedEvent, err error, complete bool, observer TimestampedEventObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughTimestampedEvent(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *TimestampedEventStream) DoOnComplete(f func()) *TimestampedEventStream {
+	return FromTimestampedEventObservable(MapTimestampedEvent2TimestampedEventObserveDirect(s, func(next TimestampedEvent, err error, complete bool, observer TimestampedEventObserver) {
+		if complete {
+			f()
+		}
+		PassthroughTimestampedEvent(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *TimestampedEventStream) ToOneWithError() (TimestampedEvent, error) {
+	valuech := make(chan TimestampedEvent, 1)
+	errch := make(chan error, 1)
+	FromTimestampedEventObservable(oneFilter().TimestampedEvent(s)).SubscribeFunc(func(next TimestampedEvent, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroTimestampedEvent, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *TimestampedEventStream) ToOne() TimestampedEvent {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *TimestampedEventStream) ToArrayWithError() ([]TimestampedEvent, error) {
+	array := []TimestampedEvent{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next TimestampedEvent, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *TimestampedEventStream) ToArray() []TimestampedEvent {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *TimestampedEventStream) ToChannelWithError() (<-chan TimestampedEvent, <-chan error) {
+	ch := make(chan TimestampedEvent, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next TimestampedEvent, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *TimestampedEventStream) ToChannel() <-chan TimestampedEvent {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *TimestampedEventStream) Seq() iter.Seq[TimestampedEvent] {
+	return func(yield func(TimestampedEvent) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *TimestampedEventStream) Seq2() iter.Seq2[TimestampedEvent, error] {
+	return func(yield func(TimestampedEvent, error) bool) {
+		type notification struct {
+			next     TimestampedEvent
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next TimestampedEvent, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroTimestampedEvent, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapTimestampedEvent2Interface struct {
+	parent TimestampedEventObservable
+	f      func(TimestampedEvent) interface{}
+}
+
+func (m *mapTimestampedEvent2Interface) Subscribe(observer InterfaceObserver) Subscription {
+	return m.parent.Subscribe(TimestampedEventObserverFunc(func(next TimestampedEvent, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInterface maps this stream to an InterfaceStream via f.
+func (s *TimestampedEventStream) MapInterface(f func(TimestampedEvent) interface{}) *InterfaceStream {
+	return &InterfaceStream{&mapTimestampedEvent2Interface{s, f}}
+}
+
+type mapTimestampedEvent2Int struct {
+	parent TimestampedEventObservable
+	f      func(TimestampedEvent) int
+}
+
+func (m *mapTimestampedEvent2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(TimestampedEventObserverFunc(func(next TimestampedEvent, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *TimestampedEventStream) MapInt(f func(TimestampedEvent) int) *IntStream {
+	return &IntStream{&mapTimestampedEvent2Int{s, f}}
+}
+
+type mapTimestampedEvent2Bool struct {
+	parent TimestampedEventObservable
+	f      func(TimestampedEvent) bool
+}
+
+func (m *mapTimestampedEvent2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(TimestampedEventObserverFunc(func(next TimestampedEvent, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *TimestampedEventStream) MapBool(f func(TimestampedEvent) bool) *BoolStream {
+	return &BoolStream{&mapTimestampedEvent2Bool{s, f}}
+}
+
+type MappingTimestampedEvent2TimestampedEventFunc func(next TimestampedEvent, err error, complete bool, observer TimestampedEventObserver)
+type MappingTimestampedEvent2TimestampedEventFuncFactory func(observer TimestampedEventObserver) MappingTimestampedEvent2TimestampedEventFunc
+
+type MappingTimestampedEvent2TimestampedEventObservable struct {
+	parent TimestampedEventObservable
+	mapper MappingTimestampedEvent2TimestampedEventFuncFactory
+}
+
+func (f *MappingTimestampedEvent2TimestampedEventObservable) Subscribe(observer TimestampedEventObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(TimestampedEventObserverFunc(func(next TimestampedEvent, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapTimestampedEvent2TimestampedEventObservable(parent TimestampedEventObservable, mapper MappingTimestampedEvent2TimestampedEventFuncFactory) TimestampedEventObservable {
+	return &MappingTimestampedEvent2TimestampedEventObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapTimestampedEvent2TimestampedEventObserveDirect(parent TimestampedEventObservable, mapper MappingTimestampedEvent2TimestampedEventFunc) TimestampedEventObservable {
+	return MapTimestampedEvent2TimestampedEventObservable(parent, func(TimestampedEventObserver) MappingTimestampedEvent2TimestampedEventFunc {
+		return mapper
+	})
+}
+
+func MapTimestampedEvent2TimestampedEventObserveNext(parent TimestampedEventObservable, mapper func(TimestampedEvent) TimestampedEvent) TimestampedEventObservable {
+	return MapTimestampedEvent2TimestampedEventObservable(parent, func(TimestampedEventObserver) MappingTimestampedEvent2TimestampedEventFunc {
+		return func(next TimestampedEvent, err error, complete bool, observer TimestampedEventObserver) {
+			var mapped TimestampedEvent
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughTimestampedEvent(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *TimestampedEventStream) Map(f func(TimestampedEvent) TimestampedEvent) *TimestampedEventStream {
+	return FromTimestampedEventObservable(MapTimestampedEvent2TimestampedEventObserveNext(s, f))
+}
+
+type MappingTimestampedEvent2EventFunc func(next TimestampedEvent, err error, complete bool, observer EventObserver)
+type MappingTimestampedEvent2EventFuncFactory func(observer EventObserver) MappingTimestampedEvent2EventFunc
+
+type MappingTimestampedEvent2EventObservable struct {
+	parent TimestampedEventObservable
+	mapper MappingTimestampedEvent2EventFuncFactory
+}
+
+func (f *MappingTimestampedEvent2EventObservable) Subscribe(observer EventObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(TimestampedEventObserverFunc(func(next TimestampedEvent, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapTimestampedEvent2EventObservable(parent TimestampedEventObservable, mapper MappingTimestampedEvent2EventFuncFactory) EventObservable {
+	return &MappingTimestampedEvent2EventObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapTimestampedEvent2EventObserveDirect(parent TimestampedEventObservable, mapper MappingTimestampedEvent2EventFunc) EventObservable {
+	return MapTimestampedEvent2EventObservable(parent, func(EventObserver) MappingTimestampedEvent2EventFunc {
+		return mapper
+	})
+}
+
+func MapTimestampedEvent2EventObserveNext(parent TimestampedEventObservable, mapper func(TimestampedEvent) Event) EventObservable {
+	return MapTimestampedEvent2EventObservable(parent, func(EventObserver) MappingTimestampedEvent2EventFunc {
+		return func(next TimestampedEvent, err error, complete bool, observer EventObserver) {
+			var mapped Event
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughEvent(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapTimestampedEvent2Event struct {
+	parent TimestampedEventObservable
+	mapper func(TimestampedEvent) EventObservable
+}
+
+func (f *flatMapTimestampedEvent2Event) Subscribe(observer EventObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(TimestampedEventObserverFunc(func(next TimestampedEvent, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&EventStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &EventStream{ignoreCompletionFilter().Event(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapEvent maps this stream to an EventStream via f.
+func (s *TimestampedEventStream) MapEvent(f func(TimestampedEvent) Event) *EventStream {
+	return FromEventObservable(MapTimestampedEvent2EventObserveNext(s, f))
+}
+
+func (s *TimestampedEventStream) FlatMapEvent(f func(TimestampedEvent) EventObservable) *EventStream {
+	return &EventStream{&flatMapTimestampedEvent2Event{s, f}}
+}
+
+type IntervalEventObserver interface {
+	Next(IntervalEvent)
+	TerminationObserver
+}
+
+// A IntervalEventSubscriber represents a subscribed IntervalEventObserver.
+type IntervalEventSubscriber interface {
+	Subscription
+	IntervalEventObserver
+}
+
+type implIntervalEventSubscriber struct {
+	Subscription
+	IntervalEventObserver
+}
+
+func IntervalEventObserverAsGenericObserver(observer IntervalEventObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(IntervalEvent))
+		}
+	})
+}
+
+func GenericObserverAsIntervalEventObserver(observer GenericObserver) IntervalEventObserver {
+	return IntervalEventObserverFunc(func(next IntervalEvent, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type IntervalEventObservableFactory func(observer IntervalEventObserver, subscription Subscription)
+
+func (f IntervalEventObservableFactory) Subscribe(observer IntervalEventObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateIntervalEvent calls f(observer, subscription) to produce values for a stream.
+func CreateIntervalEvent(f func(observer IntervalEventObserver, subscription Subscription)) *IntervalEventStream {
+	return FromIntervalEventObservable(IntervalEventObservableFactory(f))
+}
+func PassthroughIntervalEvent(next IntervalEvent, err error, complete bool, observer IntervalEventObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroIntervalEvent = *new(IntervalEvent)
+
+type IntervalEventObserverFunc func(IntervalEvent, error, bool)
+
+func (f IntervalEventObserverFunc) Next(next IntervalEvent) { f(next, nil, false) }
+func (f IntervalEventObserverFunc) Error(err error)         { f(zeroIntervalEvent, err, false) }
+func (f IntervalEventObserverFunc) Complete()               { f(zeroIntervalEvent, nil, true) }
+
+type IntervalEventObservable interface {
+	Subscribe(IntervalEventObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a IntervalEventObservable
+func (f GenericObservableFilterFactory) IntervalEvent(parent IntervalEventObservable) IntervalEventObservable {
+	return MapIntervalEvent2IntervalEventObservable(parent, func(observer IntervalEventObserver) MappingIntervalEvent2IntervalEventFunc {
+		gobserver := IntervalEventObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next IntervalEvent, err error, complete bool, observer IntervalEventObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromIntervalEventArray(array []IntervalEvent) *IntervalEventStream {
+	return CreateIntervalEvent(func(observer IntervalEventObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromIntervalEvents(array ...IntervalEvent) *IntervalEventStream {
+	return FromIntervalEventArray(array)
+}
+
+func FromIntervalEventChannel(ch <-chan IntervalEvent) *IntervalEventStream {
+	return CreateIntervalEvent(func(observer IntervalEventObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type IntervalEventStream struct {
+	IntervalEventObservable
+}
+
+func FromIntervalEventObservable(observable IntervalEventObservable) *IntervalEventStream {
+	return &IntervalEventStream{observable}
+}
+
+func (s *IntervalEventStream) SubscribeFunc(f func(IntervalEvent, error, bool)) Subscription {
+	return s.Subscribe(IntervalEventObserverFunc(f))
+}
+
+func (s *IntervalEventStream) SubscribeNext(f func(v IntervalEvent)) Subscription {
+	return s.SubscribeFunc(func(next IntervalEvent, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *IntervalEventStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsIntervalEventObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *IntervalEventStream) Filter(f func(IntervalEvent) bool) *IntervalEventStream {
+	return FromIntervalEventObservable(filterFilter(func(v interface{}) bool { return f(v.(IntervalEvent)) }).IntervalEvent(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *IntervalEventStream) Do(f func(next IntervalEvent)) *IntervalEventStream {
+	return FromIntervalEventObservable(MapIntervalEvent2IntervalEventObserveNext(s, func(next IntervalEvent) IntervalEvent {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *IntervalEventStream) DoOnError(f func(err error)) *IntervalEventStream {
+	return FromIntervalEventObservable(MapIntervalEvent2IntervalEventObserveDirect(s, func(next IntervalEvent, err error, complete bool, observer IntervalEventObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughIntervalEvent(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *IntervalEventStream) DoOnComplete(f func()) *IntervalEventStream {
+	return FromIntervalEventObservable(MapIntervalEvent2IntervalEventObserveDirect(s, func(next IntervalEvent, err error, complete bool, observer IntervalEventObserver) {
+		if complete {
+			f()
+		}
+		PassthroughIntervalEvent(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *IntervalEventStream) ToOneWithError() (IntervalEvent, error) {
+	valuech := make(chan IntervalEvent, 1)
+	errch := make(chan error, 1)
+	FromIntervalEventObservable(oneFilter().IntervalEvent(s)).SubscribeFunc(func(next IntervalEvent, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroIntervalEvent, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *IntervalEventStream) ToOne() IntervalEvent {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *IntervalEventStream) ToArrayWithError() ([]IntervalEvent, error) {
+	array := []IntervalEvent{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntervalEvent, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *IntervalEventStream) ToArray() []IntervalEvent {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *IntervalEventStream) ToChannelWithError() (<-chan IntervalEvent, <-chan error) {
+	ch := make(chan IntervalEvent, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntervalEvent, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *IntervalEventStream) ToChannel() <-chan IntervalEvent {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *IntervalEventStream) Seq() iter.Seq[IntervalEvent] {
+	return func(yield func(IntervalEvent) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *IntervalEventStream) Seq2() iter.Seq2[IntervalEvent, error] {
+	return func(yield func(IntervalEvent, error) bool) {
+		type notification struct {
+			next     IntervalEvent
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next IntervalEvent, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroIntervalEvent, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapIntervalEvent2Interface struct {
+	parent IntervalEventObservable
+	f      func(IntervalEvent) interface{}
+}
+
+func (m *mapIntervalEvent2Interface) Subscribe(observer InterfaceObserver) Subscription {
+	return m.parent.Subscribe(IntervalEventObserverFunc(func(next IntervalEvent, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInterface maps this stream to an InterfaceStream via f.
+func (s *IntervalEventStream) MapInterface(f func(IntervalEvent) interface{}) *InterfaceStream {
+	return &InterfaceStream{&mapIntervalEvent2Interface{s, f}}
+}
+
+type mapIntervalEvent2Int struct {
+	parent IntervalEventObservable
+	f      func(IntervalEvent) int
+}
+
+func (m *mapIntervalEvent2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(IntervalEventObserverFunc(func(next IntervalEvent, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *IntervalEventStream) MapInt(f func(IntervalEvent) int) *IntStream {
+	return &IntStream{&mapIntervalEvent2Int{s, f}}
+}
+
+type mapIntervalEvent2Bool struct {
+	parent IntervalEventObservable
+	f      func(IntervalEvent) bool
+}
+
+func (m *mapIntervalEvent2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(IntervalEventObserverFunc(func(next IntervalEvent, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *IntervalEventStream) MapBool(f func(IntervalEvent) bool) *BoolStream {
+	return &BoolStream{&mapIntervalEvent2Bool{s, f}}
+}
+
+type MappingIntervalEvent2IntervalEventFunc func(next IntervalEvent, err error, complete bool, observer IntervalEventObserver)
+type MappingIntervalEvent2IntervalEventFuncFactory func(observer IntervalEventObserver) MappingIntervalEvent2IntervalEventFunc
+
+type MappingIntervalEvent2IntervalEventObservable struct {
+	parent IntervalEventObservable
+	mapper MappingIntervalEvent2IntervalEventFuncFactory
+}
+
+func (f *MappingIntervalEvent2IntervalEventObservable) Subscribe(observer IntervalEventObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntervalEventObserverFunc(func(next IntervalEvent, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapIntervalEvent2IntervalEventObservable(parent IntervalEventObservable, mapper MappingIntervalEvent2IntervalEventFuncFactory) IntervalEventObservable {
+	return &MappingIntervalEvent2IntervalEventObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntervalEvent2IntervalEventObserveDirect(parent IntervalEventObservable, mapper MappingIntervalEvent2IntervalEventFunc) IntervalEventObservable {
+	return MapIntervalEvent2IntervalEventObservable(parent, func(IntervalEventObserver) MappingIntervalEvent2IntervalEventFunc {
+		return mapper
+	})
+}
+
+func MapIntervalEvent2IntervalEventObserveNext(parent IntervalEventObservable, mapper func(IntervalEvent) IntervalEvent) IntervalEventObservable {
+	return MapIntervalEvent2IntervalEventObservable(parent, func(IntervalEventObserver) MappingIntervalEvent2IntervalEventFunc {
+		return func(next IntervalEvent, err error, complete bool, observer IntervalEventObserver) {
+			var mapped IntervalEvent
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntervalEvent(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *IntervalEventStream) Map(f func(IntervalEvent) IntervalEvent) *IntervalEventStream {
+	return FromIntervalEventObservable(MapIntervalEvent2IntervalEventObserveNext(s, f))
+}
+
+type MappingIntervalEvent2EventFunc func(next IntervalEvent, err error, complete bool, observer EventObserver)
+type MappingIntervalEvent2EventFuncFactory func(observer EventObserver) MappingIntervalEvent2EventFunc
+
+type MappingIntervalEvent2EventObservable struct {
+	parent IntervalEventObservable
+	mapper MappingIntervalEvent2EventFuncFactory
+}
+
+func (f *MappingIntervalEvent2EventObservable) Subscribe(observer EventObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntervalEventObserverFunc(func(next IntervalEvent, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapIntervalEvent2EventObservable(parent IntervalEventObservable, mapper MappingIntervalEvent2EventFuncFactory) EventObservable {
+	return &MappingIntervalEvent2EventObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntervalEvent2EventObserveDirect(parent IntervalEventObservable, mapper MappingIntervalEvent2EventFunc) EventObservable {
+	return MapIntervalEvent2EventObservable(parent, func(EventObserver) MappingIntervalEvent2EventFunc {
+		return mapper
+	})
+}
+
+func MapIntervalEvent2EventObserveNext(parent IntervalEventObservable, mapper func(IntervalEvent) Event) EventObservable {
+	return MapIntervalEvent2EventObservable(parent, func(EventObserver) MappingIntervalEvent2EventFunc {
+		return func(next IntervalEvent, err error, complete bool, observer EventObserver) {
+			var mapped Event
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughEvent(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapIntervalEvent2Event struct {
+	parent IntervalEventObservable
+	mapper func(IntervalEvent) EventObservable
+}
+
+func (f *flatMapIntervalEvent2Event) Subscribe(observer EventObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntervalEventObserverFunc(func(next IntervalEvent, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&EventStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &EventStream{ignoreCompletionFilter().Event(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapEvent maps this stream to an EventStream via f.
+func (s *IntervalEventStream) MapEvent(f func(IntervalEvent) Event) *EventStream {
+	return FromEventObservable(MapIntervalEvent2EventObserveNext(s, f))
+}
+
+func (s *IntervalEventStream) FlatMapEvent(f func(IntervalEvent) EventObservable) *EventStream {
+	return &EventStream{&flatMapIntervalEvent2Event{s, f}}
+}
+
+type IntObserver interface {
+	Next(int)
+	TerminationObserver
+}
+
+// A IntSubscriber represents a subscribed IntObserver.
+type IntSubscriber interface {
+	Subscription
+	IntObserver
+}
+
+type implIntSubscriber struct {
+	Subscription
+	IntObserver
+}
+
+func IntObserverAsGenericObserver(observer IntObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(int))
+		}
+	})
+}
+
+func GenericObserverAsIntObserver(observer GenericObserver) IntObserver {
+	return IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type IntObservableFactory func(observer IntObserver, subscription Subscription)
+
+func (f IntObservableFactory) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateInt calls f(observer, subscription) to produce values for a stream.
+func CreateInt(f func(observer IntObserver, subscription Subscription)) *IntStream {
+	return FromIntObservable(IntObservableFactory(f))
+}
+
+// Repeat value count times.
+func RepeatInt(value int, count int) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		for i := 0; i < count; i++ {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(value)
+		}
+		observer.Complete()
+	})
+}
+
+// StartInt is designed to be used with functions that return a
+// (int, error) tuple.
+//
+// If the error is non-nil the returned IntStream will be that error,
+// otherwise it will be a single-value stream of int.
+func StartInt(f func() (int, error)) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		if v, err := f(); err != nil {
+			observer.Error(err)
+		} else {
+			observer.Next(v)
+			observer.Complete()
+		}
+	})
+}
+
+type deferIntObservable func() IntObservable
+
+func (f deferIntObservable) Subscribe(observer IntObserver) Subscription {
+	return f().Subscribe(observer)
+}
+
+// DeferInt calls f to create a fresh observable for each subscription.
+func DeferInt(f func() IntObservable) *IntStream {
+	return FromIntObservable(deferIntObservable(f))
+}
+func PassthroughInt(next int, err error, complete bool, observer IntObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroInt = *new(int)
+
+type IntObserverFunc func(int, error, bool)
+
+func (f IntObserverFunc) Next(next int)   { f(next, nil, false) }
+func (f IntObserverFunc) Error(err error) { f(zeroInt, err, false) }
+func (f IntObserverFunc) Complete()       { f(zeroInt, nil, true) }
+
+type IntObservable interface {
+	Subscribe(IntObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a IntObservable
+func (f GenericObservableFilterFactory) Int(parent IntObservable) IntObservable {
+	return MapInt2IntObservable(parent, func(observer IntObserver) MappingInt2IntFunc {
+		gobserver := IntObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next int, err error, complete bool, observer IntObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func NeverInt() *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {})
+}
+
+func EmptyInt() *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		observer.Complete()
+	})
+}
+
+func ThrowInt(err error) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		observer.Error(err)
+	})
+}
+func FromIntArray(array []int) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromInts(array ...int) *IntStream {
+	return FromIntArray(array)
+}
+
+// FromIntSeq emits each value yielded by seq, iterating it once per
+// subscription.
+func FromIntSeq(seq iter.Seq[int]) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		for v := range seq {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+func JustInt(element int) *IntStream {
+	return FromIntArray([]int{element})
+}
+
+func MergeInt(observables ...IntObservable) *IntStream {
+	if len(observables) == 0 {
+		return EmptyInt()
+	}
+	return (&IntStream{observables[0]}).Merge(observables[1:]...)
+}
+
+func MergeIntDelayError(observables ...IntObservable) *IntStream {
+	if len(observables) == 0 {
+		return EmptyInt()
+	}
+	return (&IntStream{observables[0]}).MergeDelayError(observables[1:]...)
+}
+
+type ambIntObservable []IntObservable
+
+func (a ambIntObservable) Subscribe(observer IntObserver) Subscription {
+	lock := sync.Mutex{}
+	winner := -1
+	subscriptions := make([]*LinkedSubscription, len(a))
+	for i := range subscriptions {
+		subscriptions[i] = NewLinkedSubscription()
+	}
+	for i, observable := range a {
+		i := i
+		subscriptions[i].Link(observable.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+			lock.Lock()
+			if winner == -1 {
+				winner = i
+				for j, subscription := range subscriptions {
+					if j != i {
+						subscription.Dispose()
+					}
+				}
+			}
+			won := winner == i
+			lock.Unlock()
+			if won {
+				PassthroughInt(next, err, complete, observer)
+			}
+		})))
+	}
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		for _, s := range subscriptions {
+			s.Dispose()
+		}
+	})
+	return subscription
+}
+
+// AmbInt subscribes to all observables and mirrors whichever emits
+// first, disposing the others.
+func AmbInt(observables ...IntObservable) *IntStream {
+	if len(observables) == 0 {
+		return EmptyInt()
+	}
+	return FromIntObservable(ambIntObservable(observables))
+}
+func FromIntChannel(ch <-chan int) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type IntStream struct {
+	IntObservable
+}
+
+func FromIntObservable(observable IntObservable) *IntStream {
+	return &IntStream{observable}
+}
+
+func (s *IntStream) SubscribeFunc(f func(int, error, bool)) Subscription {
+	return s.Subscribe(IntObserverFunc(f))
+}
+
+func (s *IntStream) SubscribeNext(f func(v int)) Subscription {
+	return s.SubscribeFunc(func(next int, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *IntStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsIntObserver(observer))
+}
+
+// Distinct removes duplicate elements in the stream. Values that can not be
+// map keys, such as slices, are compared with reflect.DeepEqual.
+func (s *IntStream) Distinct() *IntStream {
+	return FromIntObservable(distinctFilter().Int(s))
+}
+
+// ElementAt yields the Nth element of the stream.
+func (s *IntStream) ElementAt(n int) *IntStream {
+	return FromIntObservable(elementAtFilter(n).Int(s))
+}
+
+// Filter elements in the stream on a function.
+func (s *IntStream) Filter(f func(int) bool) *IntStream {
+	return FromIntObservable(filterFilter(func(v interface{}) bool { return f(v.(int)) }).Int(s))
+}
+
+// Last returns just the first element of the stream.
+func (s *IntStream) First() *IntStream {
+	return FromIntObservable(firstFilter().Int(s))
+}
+
+// Last returns just the last element of the stream.
+func (s *IntStream) Last() *IntStream {
+	return FromIntObservable(lastFilter().Int(s))
+}
+
+// SkipLast skips the first N elements of the stream.
+func (s *IntStream) Skip(n int) *IntStream {
+	return FromIntObservable(skipFilter(n).Int(s))
+}
+
+// SkipLast skips the last N elements of the stream.
+func (s *IntStream) SkipLast(n int) *IntStream {
+	return FromIntObservable(skipLastFilter(n).Int(s))
+}
+
+// Take returns just the first N elements of the stream.
+func (s *IntStream) Take(n int) *IntStream {
+	return FromIntObservable(takeFilter(n).Int(s))
+}
+
+// TakeLast returns just the last N elements of the stream.
+func (s *IntStream) TakeLast(n int) *IntStream {
+	return FromIntObservable(takeLastFilter(n).Int(s))
+}
+
+type takeWhileInt struct {
+	parent IntObservable
+	f      func(int) bool
+}
+
+func (t *takeWhileInt) Subscribe(observer IntObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	parent.Link(t.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Complete()
+		case t.f(next):
+			observer.Next(next)
+		default:
+			done = true
+			observer.Complete()
+			parent.Dispose()
+		}
+	})))
+	return parent
+}
+
+// TakeWhile returns elements of the stream until f returns false, then
+// completes and disposes the stream.
+func (s *IntStream) TakeWhile(f func(int) bool) *IntStream {
+	return &IntStream{&takeWhileInt{s, f}}
+}
+
+// SkipWhile skips elements of the stream until f returns false.
+func (s *IntStream) SkipWhile(f func(int) bool) *IntStream {
+	return FromIntObservable(MapInt2IntObservable(s, func(IntObserver) MappingInt2IntFunc {
+		skipping := true
+		return func(next int, err error, complete bool, observer IntObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if skipping && !f(next) {
+					skipping = false
+				}
+				if !skipping {
+					observer.Next(next)
+				}
+			}
+		}
+	}))
+}
+
+// IgnoreElements ignores elements of the stream and emits only the completion events.
+func (s *IntStream) IgnoreElements() *IntStream {
+	return FromIntObservable(ignoreElementsFilter().Int(s))
+}
+
+func (s *IntStream) Replay(size int, duration time.Duration) *IntStream {
+	return FromIntObservable(replayFilter(size, duration).Int(s))
+}
+
+func (s *IntStream) Sample(duration time.Duration) *IntStream {
+	return FromIntObservable(sampleFilter(duration).Int(s))
+}
+
+func (s *IntStream) Debounce(duration time.Duration) *IntStream {
+	return FromIntObservable(debounceFilter(duration).Int(s))
+}
+
+type delayInt struct {
+	parent     IntObservable
+	delay      func(int) time.Duration
+	completion time.Duration
+}
+
+func (d *delayInt) Subscribe(observer IntObserver) Subscription {
+	queue := newDelayQueue(IntObserverAsGenericObserver(observer))
+	parent := d.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			queue.error(err)
+		case complete:
+			queue.enqueue(delayedEntry{complete: true}, d.completion)
+		default:
+			queue.enqueue(delayedEntry{next: next}, d.delay(next))
+		}
+	}))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		queue.dispose()
+	})
+	return subscription
+}
+
+// Delay shifts each value, and completion, forward in time by duration. Errors
+// are not delayed. Values that are still pending are discarded on disposal.
+func (s *IntStream) Delay(duration time.Duration) *IntStream {
+	return &IntStream{&delayInt{s, func(int) time.Duration { return duration }, duration}}
+}
+
+// DelayWhen shifts each value forward in time by the duration returned by f.
+// Values are never reordered, so a value is emitted no earlier than the value
+// before it. Completion is emitted after the last value. Errors are not delayed.
+func (s *IntStream) DelayWhen(f func(int) time.Duration) *IntStream {
+	return &IntStream{&delayInt{s, f, 0}}
+}
+
+// Wait for completion of the stream and return any error.
+func (s *IntStream) Wait() error {
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			errch <- nil
+		default:
+		}
+	})
+	return <-errch
+}
+
+func MakeIntSubscriber(observer IntObserver) IntSubscriber {
+	if subscriber, ok := observer.(IntSubscriber); ok {
+		return subscriber
+	}
+	return &implIntSubscriber{NewGenericSubscription(), observer}
+}
+
+type concatIntSubscriber struct {
+	observable  int
+	observer    IntObserver
+	observables []IntObservable
+	Subscription
+}
+
+func (c *concatIntSubscriber) Next(next int) {
+	c.observer.Next(next)
+}
+
+func (c *concatIntSubscriber) Error(err error) {
+	c.observer.Error(err)
+	c.observable = len(c.observables)
+	c.Dispose()
+}
+
+func (c *concatIntSubscriber) Complete() {
+	c.observable++
+	if c.observable >= len(c.observables) {
+		c.observer.Complete()
+		c.Dispose()
+		return
+	}
+	c.observables[c.observable].Subscribe(c)
+}
+
+type concatIntObservable struct {
+	observables []IntObservable
+}
+
+func (m *concatIntObservable) Subscribe(observer IntObserver) Subscription {
+	if len(m.observables) == 0 {
+		observer.Complete()
+		return ClosedSubscription
+	}
+	subscriber := &concatIntSubscriber{
+		observer:     observer,
+		Subscription: NewGenericSubscription(),
+		observables:  m.observables,
+	}
+	m.observables[0].Subscribe(subscriber)
+	return subscriber
+}
+
+func (s *IntStream) Concat(observables ...IntObservable) *IntStream {
+	return &IntStream{&concatIntObservable{append([]IntObservable{s}, observables...)}}
+}
+
+// StartWith emits values before the values of the stream.
+func (s *IntStream) StartWith(values ...int) *IntStream {
+	return FromIntArray(values).Concat(s)
+}
+
+// EndWith emits values after the stream completes.
+func (s *IntStream) EndWith(values ...int) *IntStream {
+	return s.Concat(FromIntArray(values))
+}
+
+// DefaultIfEmpty emits value if the stream completes without emitting any values.
+func (s *IntStream) DefaultIfEmpty(value int) *IntStream {
+	return FromIntObservable(MapInt2IntObservable(s, func(IntObserver) MappingInt2IntFunc {
+		empty := true
+		return func(next int, err error, complete bool, observer IntObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				if empty {
+					observer.Next(value)
+				}
+				observer.Complete()
+			default:
+				empty = false
+				observer.Next(next)
+			}
+		}
+	}))
+}
+
+type switchIfEmptyIntObservable struct {
+	parent IntObservable
+	other  IntObservable
+}
+
+func (e *switchIfEmptyIntObservable) Subscribe(observer IntObserver) Subscription {
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
+	empty := true
+	link.Link(e.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			if !empty {
+				observer.Complete()
+			} else if !subscription.Disposed() {
+				subscription.Set(e.other.Subscribe(observer))
+			}
+		default:
+			empty = false
+			observer.Next(next)
+		}
+	})))
+	return subscription
+}
+
+// SwitchIfEmpty switches to other if the stream completes without emitting any values.
+func (s *IntStream) SwitchIfEmpty(other IntObservable) *IntStream {
+	return &IntStream{&switchIfEmptyIntObservable{s, other}}
+}
+
+type mergeIntObservable struct {
+	delayError  bool
+	observables []IntObservable
+}
+
+func (m *mergeIntObservable) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	lock := sync.Mutex{}
+	completed := 0
+	var firstError error
+	relay := func(next int, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if completed >= len(m.observables) {
+			return
+		}
+
+		switch {
+		case err != nil:
+			if m.delayError {
+				firstError = err
+				completed++
+			} else {
+				observer.Error(err)
+				completed = len(m.observables)
+			}
+
+		case complete:
+			completed++
+			if completed == len(m.observables) {
+				if firstError != nil {
+					observer.Error(firstError)
+				} else {
+					observer.Complete()
+				}
+			}
+		default:
+			observer.Next(next)
+		}
+	}
+	for _, observable := range m.observables {
+		observable.Subscribe(IntObserverFunc(relay))
+	}
+	return subscription
+}
+
+// Merge an arbitrary number of observables with this one.
+// An error from any of the observables will terminate the merged stream.
+func (s *IntStream) Merge(other ...IntObservable) *IntStream {
+	if len(other) == 0 {
+		return s
+	}
+	return &IntStream{&mergeIntObservable{false, append(other, s)}}
+}
+
+// Merge an arbitrary number of observables with this one.
+// Any error will be deferred until all observables terminate.
+func (s *IntStream) MergeDelayError(other ...IntObservable) *IntStream {
+	if len(other) == 0 {
+		return s
+	}
+	return &IntStream{&mergeIntObservable{true, append(other, s)}}
+}
+
+// Amb mirrors whichever of this stream and others emits first, disposing the rest.
+func (s *IntStream) Amb(others ...IntObservable) *IntStream {
+	return AmbInt(append([]IntObservable{s}, others...)...)
+}
+
+type catchIntObservable struct {
+	parent IntObservable
+	catch  func(err error) IntObservable
+	// Also switch to the fallback when the parent completes. err will be nil.
+	resume bool
+}
+
+func (r *catchIntObservable) Subscribe(observer IntObserver) Subscription {
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
+	run := func(next int, err error, complete bool) {
+		switch {
+		case err != nil || (complete && r.resume):
+			if !subscription.Disposed() {
+				subscription.Set(r.catch(err).Subscribe(observer))
+			}
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	}
+	link.Link(r.parent.Subscribe(IntObserverFunc(run)))
+	return subscription
+}
+
+// Catch switches to the catch observable if the stream errors.
+func (s *IntStream) Catch(catch IntObservable) *IntStream {
+	return s.CatchFunc(func(error) IntObservable { return catch })
+}
+
+// CatchFunc switches to the observable returned by f(err) if the stream errors.
+func (s *IntStream) CatchFunc(f func(err error) IntObservable) *IntStream {
+	return &IntStream{&catchIntObservable{parent: s, catch: f}}
+}
+
+// OnErrorReturn emits the single value returned by f(err) and completes if the stream errors.
+func (s *IntStream) OnErrorReturn(f func(err error) int) *IntStream {
+	return s.CatchFunc(func(err error) IntObservable { return JustInt(f(err)) })
+}
+
+// OnErrorResumeNext switches to next when the stream terminates, whether it
+// completes or errors.
+func (s *IntStream) OnErrorResumeNext(next IntObservable) *IntStream {
+	return &IntStream{&catchIntObservable{
+		parent: s,
+		catch:  func(error) IntObservable { return next },
+		resume: true,
+	}}
+}
+
+type retryIntObservable struct {
+	observable IntObservable
+	policy     RetryPolicy
+}
+
+func (r *retryIntObservable) Subscribe(observer IntObserver) Subscription {
+	subscription := NewSerialSubscription()
+	attempt := 0
+	var subscribe func()
+	subscribe = func() {
+		// Set the link before subscribing, in case the observable fails
+		// and is resubscribed before Subscribe returns.
+		link := NewLinkedSubscription()
+		subscription.Set(link)
+		link.Link(r.observable.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+			switch {
+			case err != nil:
+				if subscription.Disposed() {
+					return
+				}
+				attempt++
+				delay, ok := r.policy(err, attempt)
+				switch {
+				case !ok:
+					observer.Error(err)
+				case delay > 0:
+					time.AfterFunc(delay, func() {
+						if !subscription.Disposed() {
+							subscribe()
+						}
+					})
+				default:
+					subscribe()
+				}
+			case complete:
+				observer.Complete()
+			default:
+				attempt = 0
+				observer.Next(next)
+			}
+		})))
+	}
+	subscribe()
+	return subscription
+}
+
+// Retry resubscribes to the stream immediately, and indefinitely, on error.
+func (s *IntStream) Retry() *IntStream {
+	return s.RetryWhen(func(error, int) (time.Duration, bool) { return 0, true })
+}
+
+// RetryN resubscribes to the stream on error, at most n times in a row without
+// the stream emitting a value in between.
+func (s *IntStream) RetryN(n int) *IntStream {
+	return s.RetryWhen(func(err error, attempt int) (time.Duration, bool) { return 0, attempt <= n })
+}
+
+// RetryWhen calls policy on each error to decide whether, and after how long,
+// to resubscribe to the stream. attempt counts consecutive errors, starting at
+// 1 and reset whenever the stream emits a value.
+func (s *IntStream) RetryWhen(policy func(err error, attempt int) (time.Duration, bool)) *IntStream {
+	return &IntStream{&retryIntObservable{s, policy}}
+}
+
+// RetryWithBackoff resubscribes to the stream on error, indefinitely, with
+// an ExponentialBackoff delay. The delay is reset to initial whenever the
+// stream emits a value.
+func (s *IntStream) RetryWithBackoff(initial, max time.Duration, multiplier, jitter float64) *IntStream {
+	return s.RetryWhen(ExponentialBackoff(initial, max, multiplier, jitter))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *IntStream) Do(f func(next int)) *IntStream {
+	return FromIntObservable(MapInt2IntObserveNext(s, func(next int) int {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *IntStream) DoOnError(f func(err error)) *IntStream {
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughInt(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *IntStream) DoOnComplete(f func()) *IntStream {
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		if complete {
+			f()
+		}
+		PassthroughInt(next, err, complete, observer)
+	}))
+}
+func (s *IntStream) Reduce(initial int, reducer func(int, int) int) *IntStream {
+	value := initial
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			observer.Next(value)
+			observer.Error(err)
+		case complete:
+			observer.Next(value)
+			observer.Complete()
+		default:
+			value = reducer(value, next)
+		}
+	}))
+}
+
+func (s *IntStream) Scan(initial int, f func(int, int) int) *IntStream {
+	value := initial
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			value = f(value, next)
+			observer.Next(value)
+		}
+	}))
+}
+
+type timeoutInt struct {
+	parent  IntObservable
+	timeout time.Duration
+}
+
+func (t *timeoutInt) Subscribe(observer IntObserver) Subscription {
+	subscription := NewChannelSubscription()
+	cancel := t.parent.Subscribe(observer)
+	go func() {
+		select {
+		case <-time.After(t.timeout):
+			observer.Error(ErrTimeout)
+			cancel.Dispose()
+			subscription.Dispose()
+		case <-subscription:
+			cancel.Dispose()
+		}
+	}()
+	return subscription
+}
+
+func (s *IntStream) Timeout(timeout time.Duration) *IntStream {
+	return &IntStream{&timeoutInt{s, timeout}}
+}
+
+type delaySubscriptionInt struct {
+	parent IntObservable
+	delay  time.Duration
+}
+
+func (d *delaySubscriptionInt) Subscribe(observer IntObserver) Subscription {
+	subscription := NewLinkedSubscription()
+	time.AfterFunc(d.delay, func() {
+		if !subscription.Disposed() {
+			subscription.Link(d.parent.Subscribe(observer))
+		}
+	})
+	return subscription
+}
+
+// DelaySubscription waits for delay before subscribing to the stream.
+func (s *IntStream) DelaySubscription(delay time.Duration) *IntStream {
+	return &IntStream{&delaySubscriptionInt{s, delay}}
+}
+
+type takeUntilInt struct {
+	parent IntObservable
+	signal GenericObservable
+}
+
+func (t *takeUntilInt) Subscribe(observer IntObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			// A signal that completes without a value never ends the stream.
+			break
+		default:
+			terminate(observer.Complete)
+		}
+	})))
+	parent.Link(t.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// TakeUntil returns elements of the stream until signal, which may be a
+// stream of any type, emits a value. The stream then completes.
+func (s *IntStream) TakeUntil(signal GenericObservable) *IntStream {
+	return &IntStream{&takeUntilInt{s, signal}}
+}
+
+type skipUntilInt struct {
+	parent IntObservable
+	signal GenericObservable
+}
+
+func (t *skipUntilInt) Subscribe(observer IntObserver) Subscription {
+	lock := sync.Mutex{}
+	open := false
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			break
+		default:
+			lock.Lock()
+			open = true
+			lock.Unlock()
+			signal.Dispose()
+		}
+	})))
+	parent.Link(t.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if open && !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// SkipUntil skips elements of the stream until signal, which may be a stream
+// of any type, emits a value.
+func (s *IntStream) SkipUntil(signal GenericObservable) *IntStream {
+	return &IntStream{&skipUntilInt{s, signal}}
+}
+
+type forkedIntStream struct {
+	lock      sync.Mutex
+	parent    IntObservable
+	observers []IntObserver
+}
+
+func (f *forkedIntStream) Subscribe(observer IntObserver) Subscription {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	i := len(f.observers)
+	f.observers = append(f.observers, observer)
+	sub := new(CallbackSubscription)
+	*sub = CallbackSubscription(func() {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		f.observers[i] = nil
+	})
+	return sub
+}
+
+// Fork replicates each event from the parent to every subscriber of the fork.
+func (s *IntStream) Fork() *IntStream {
+	f := &forkedIntStream{parent: s}
+	go s.Subscribe(IntObserverFunc(func(n int, err error, complete bool) {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		for _, o := range f.observers {
+			if o == nil {
+				continue
+			}
+			switch {
+			case err != nil:
+				o.Error(err)
+			case complete:
+				o.Complete()
+			default:
+				o.Next(n)
+			}
+		}
+	}))
+	return &IntStream{f}
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *IntStream) ToOneWithError() (int, error) {
+	valuech := make(chan int, 1)
+	errch := make(chan error, 1)
+	FromIntObservable(oneFilter().Int(s)).SubscribeFunc(func(next int, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroInt, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *IntStream) ToOne() int {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *IntStream) ToArrayWithError() ([]int, error) {
+	array := []int{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *IntStream) ToArray() []int {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *IntStream) ToChannelWithError() (<-chan int, <-chan error) {
+	ch := make(chan int, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *IntStream) ToChannel() <-chan int {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *IntStream) Seq() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *IntStream) Seq2() iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		type notification struct {
+			next     int
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next int, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroInt, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+// Count returns an IntStream with the count of elements in this stream.
+func (s *IntStream) Count() *IntStream {
+	count := 0
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			observer.Next(count)
+			observer.Error(err)
+		case complete:
+			observer.Next(count)
+			observer.Complete()
+		default:
+			count++
+		}
+	}))
+}
+
+func equalInt(a, b int) bool {
+	return a == b
+}
+
+type decideInt struct {
+	parent IntObservable
+	// decide returns the result and true once the answer is known.
+	decide func(next int) (bool, bool)
+	// otherwise is the result if the stream completes first.
+	otherwise bool
+}
+
+func (d *decideInt) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	subscription := NewLinkedSubscription()
+	subscription.Link(d.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Next(d.otherwise)
+			observer.Complete()
+		default:
+			if result, ok := d.decide(next); ok {
+				done = true
+				observer.Next(result)
+				observer.Complete()
+				subscription.Dispose()
+			}
+		}
+	})))
+	return subscription
+}
+
+// All emits true if f returns true for every value in the stream, or false
+// as soon as it does not.
+func (s *IntStream) All(f func(int) bool) *BoolStream {
+	return FromBoolObservable(&decideInt{s, func(next int) (bool, bool) { return false, !f(next) }, true})
+}
+
+// Any emits true as soon as f returns true for a value in the stream, or
+// false if it never does.
+func (s *IntStream) Any(f func(int) bool) *BoolStream {
+	return FromBoolObservable(&decideInt{s, func(next int) (bool, bool) { return true, f(next) }, false})
+}
+
+// Contains emits true as soon as value is seen in the stream, or false if it
+// never is.
+func (s *IntStream) Contains(value int) *BoolStream {
+	return s.Any(func(next int) bool { return equalInt(next, value) })
+}
+
+// IsEmpty emits true if the stream completes without a value, or false as
+// soon as it emits one.
+func (s *IntStream) IsEmpty() *BoolStream {
+	return FromBoolObservable(&decideInt{s, func(int) (bool, bool) { return false, true }, true})
+}
+
+type sequenceEqualInt struct {
+	parent IntObservable
+	other  IntObservable
+}
+
+func (e *sequenceEqualInt) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	// Values seen on one side that the other has not caught up with yet.
+	// Only one side can be ahead at a time.
+	pending := [2][]int{}
+	completed := [2]bool{}
+	subscriptions := [2]*LinkedSubscription{NewLinkedSubscription(), NewLinkedSubscription()}
+	dispose := func() {
+		subscriptions[0].Dispose()
+		subscriptions[1].Dispose()
+	}
+	finish := func(result bool) {
+		done = true
+		observer.Next(result)
+		observer.Complete()
+		dispose()
+	}
+	observe := func(i int) IntObserver {
+		j := 1 - i
+		return IntObserverFunc(func(next int, err error, complete bool) {
+			lock.Lock()
+			defer lock.Unlock()
+			if done {
+				return
+			}
+			switch {
+			case err != nil:
+				done = true
+				observer.Error(err)
+				dispose()
+			case complete:
+				completed[i] = true
+				if len(pending[j]) > 0 {
+					finish(false)
+				} else if completed[j] {
+					finish(true)
+				}
+			case len(pending[j]) > 0:
+				expected := pending[j][0]
+				pending[j] = pending[j][1:]
+				if !equalInt(next, expected) {
+					finish(false)
+				}
+			case completed[j]:
+				finish(false)
+			default:
+				pending[i] = append(pending[i], next)
+			}
+		})
+	}
+	subscriptions[0].Link(e.parent.Subscribe(observe(0)))
+	subscriptions[1].Link(e.other.Subscribe(observe(1)))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(dispose)
+	return subscription
+}
+
+// SequenceEqual emits true if the stream and other emit equal values in the
+// same order and both complete, or false as soon as they differ.
+func (s *IntStream) SequenceEqual(other IntObservable) *BoolStream {
+	return FromBoolObservable(&sequenceEqualInt{s, other})
+}
+
+func (s *IntStream) Average() *IntStream {
+	var sum int
+	var count int
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			observer.Next(sum / count)
+			observer.Error(err)
+		case complete:
+			observer.Next(sum / count)
+			observer.Complete()
+		default:
+			sum += next
+			count++
+		}
+	}))
+}
+
+func (s *IntStream) Sum() *IntStream {
+	var sum int
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			observer.Next(sum)
+			observer.Error(err)
+		case complete:
+			observer.Next(sum)
+			observer.Complete()
+		default:
+			sum += next
+		}
+	}))
+}
+
+func (s *IntStream) Min() *IntStream {
+	started := false
+	var min int
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			if started {
+				observer.Next(min)
+			}
+			observer.Error(err)
+		case complete:
+			if started {
+				observer.Next(min)
+			}
+			observer.Complete()
+		default:
+			if started {
+				if next < min {
+					min = next
+				}
+			} else {
+				min = next
+				started = true
+			}
+		}
+	}))
+}
+
+func (s *IntStream) Max() *IntStream {
+	started := false
+	var max int
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			if started {
+				observer.Next(max)
+			}
+			observer.Error(err)
+		case complete:
+			if started {
+				observer.Next(max)
+			}
+			observer.Complete()
+		default:
+			if started {
+				if !(next < max) {
+					max = next
+				}
+			} else {
+				max = next
+				started = true
+			}
+		}
+	}))
+}
+
+// Sorted emits all values in ascending order once the stream terminates.
+func (s *IntStream) Sorted() *IntStream {
+	return FromIntObservable(sortedFilter(func(a, b interface{}) bool {
+		x, y := a.(int), b.(int)
+		return x < y
+	}).Int(s))
+}
+
+// IntNotification is a materialized event from a IntStream.
+type IntNotification struct {
+	Kind  NotificationKind
+	Value int   // Set if Kind is NotificationNext.
+	Err   error // Set if Kind is NotificationError.
+}
+
+// Materialize emits every event of the stream, including termination, as a
+// IntNotification. The materialized stream completes after the
+// notification for the termination of this stream.
+func (s *IntStream) Materialize() *IntNotificationStream {
+	return FromIntNotificationObservable(MapInt2IntNotificationObserveDirect(s, func(next int, err error, complete bool, observer IntNotificationObserver) {
+		switch {
+		case err != nil:
+			observer.Next(IntNotification{Kind: NotificationError, Err: err})
+			observer.Complete()
+		case complete:
+			observer.Next(IntNotification{Kind: NotificationComplete})
+			observer.Complete()
+		default:
+			observer.Next(IntNotification{Kind: NotificationNext, Value: next})
+		}
+	}))
+}
+
+// Dematerialize converts materialized notifications back into the events they
+// represent. Notifications after the first termination are ignored.
+func (s *IntNotificationStream) Dematerialize() *IntStream {
+	return FromIntObservable(MapIntNotification2IntObservable(s, func(IntObserver) MappingIntNotification2IntFunc {
+		terminated := false
+		return func(next IntNotification, err error, complete bool, observer IntObserver) {
+			if terminated {
+				return
+			}
+			switch {
+			case err != nil:
+				terminated = true
+				observer.Error(err)
+			case complete:
+				terminated = true
+				observer.Complete()
+			default:
+				switch next.Kind {
+				case NotificationNext:
+					observer.Next(next.Value)
+				case NotificationError:
+					terminated = true
+					observer.Error(next.Err)
+				case NotificationComplete:
+					terminated = true
+					observer.Complete()
+				}
+			}
+		}
+	}))
+}
+
+// TimestampedInt is a value from a IntStream and the time it was emitted.
+type TimestampedInt struct {
+	Value int
+	Time  time.Time
+}
+
+// Timestamp annotates each value in the stream with the time it was emitted.
+func (s *IntStream) Timestamp() *TimestampedIntStream {
+	return FromTimestampedIntObservable(MapInt2TimestampedIntObserveNext(s, func(next int) TimestampedInt {
+		return TimestampedInt{next, Now()}
+	}))
+}
+
+// IntervalInt is a value from a IntStream and the time elapsed
+// since the previous value was emitted.
+type IntervalInt struct {
+	Value    int
+	Interval time.Duration
+}
+
+// TimeInterval annotates each value in the stream with the time elapsed since
+// the previous value, or since subscription for the first value.
+func (s *IntStream) TimeInterval() *IntervalIntStream {
+	return FromIntervalIntObservable(MapInt2IntervalIntObservable(s, func(IntervalIntObserver) MappingInt2IntervalIntFunc {
+		last := Now()
+		return func(next int, err error, complete bool, observer IntervalIntObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				now := Now()
+				observer.Next(IntervalInt{next, now.Sub(last)})
+				last = now
+			}
+		}
+	}))
+}
+
+// DecodeIntJSONLines decodes a int from each line of r. A line
+// that can not be decoded terminates the stream with an error.
+func DecodeIntJSONLines(r io.Reader) *IntStream {
+	return DecodeIntJSONLinesWithPolicy(r, FailOnDecodeError)
+}
+
+// DecodeIntJSONLinesWithPolicy decodes a int from each line of r,
+// passing lines that can not be decoded to policy. Blank lines are ignored.
+func DecodeIntJSONLinesWithPolicy(r io.Reader, policy DecodeErrorPolicy) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		reader := bufio.NewReader(r)
+		for {
+			if subscription.Disposed() {
+				return
+			}
+			line, err := reader.ReadBytes('\n')
+			if err != nil && err != io.EOF {
+				observer.Error(err)
+				return
+			}
+			if len(bytes.TrimSpace(line)) > 0 {
+				var value int
+				if derr := json.Unmarshal(line, &value); derr == nil {
+					observer.Next(value)
+				} else if derr = policy(line, derr); derr != nil {
+					observer.Error(derr)
+					return
+				}
+			}
+			if err == io.EOF {
+				observer.Complete()
+				return
+			}
+		}
+	})
+}
+
+// EncodeJSONLines writes each value in the stream to w as a line of JSON,
+// blocking until the stream completes. The error from the stream or from
+// encoding is returned. An encoding error disposes the stream.
+func (s *IntStream) EncodeJSONLines(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	errch := make(chan error, 1)
+	failed := false
+	subscription := s.SubscribeFunc(func(next int, err error, complete bool) {
+		if failed {
+			return
+		}
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			errch <- nil
+		default:
+			if err := encoder.Encode(next); err != nil {
+				failed = true
+				errch <- err
+			}
+		}
+	})
+	err := <-errch
+	subscription.Dispose()
+	return err
+}
+
+type MappingInt2InterfaceFunc func(next int, err error, complete bool, observer InterfaceObserver)
+type MappingInt2InterfaceFuncFactory func(observer InterfaceObserver) MappingInt2InterfaceFunc
+
+type MappingInt2InterfaceObservable struct {
+	parent IntObservable
+	mapper MappingInt2InterfaceFuncFactory
+}
+
+func (f *MappingInt2InterfaceObservable) Subscribe(observer InterfaceObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInt2InterfaceObservable(parent IntObservable, mapper MappingInt2InterfaceFuncFactory) InterfaceObservable {
+	return &MappingInt2InterfaceObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInt2InterfaceObserveDirect(parent IntObservable, mapper MappingInt2InterfaceFunc) InterfaceObservable {
+	return MapInt2InterfaceObservable(parent, func(InterfaceObserver) MappingInt2InterfaceFunc {
+		return mapper
+	})
+}
+
+func MapInt2InterfaceObserveNext(parent IntObservable, mapper func(int) interface{}) InterfaceObservable {
+	return MapInt2InterfaceObservable(parent, func(InterfaceObserver) MappingInt2InterfaceFunc {
+		return func(next int, err error, complete bool, observer InterfaceObserver) {
+			var mapped interface{}
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInterface(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapInt2Interface struct {
+	parent IntObservable
+	mapper func(int) InterfaceObservable
+}
+
+func (f *flatMapInt2Interface) Subscribe(observer InterfaceObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&InterfaceStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &InterfaceStream{ignoreCompletionFilter().Interface(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInterface maps this stream to an InterfaceStream via f.
+func (s *IntStream) MapInterface(f func(int) interface{}) *InterfaceStream {
+	return FromInterfaceObservable(MapInt2InterfaceObserveNext(s, f))
+}
+
+func (s *IntStream) FlatMapInterface(f func(int) InterfaceObservable) *InterfaceStream {
+	return &InterfaceStream{&flatMapInt2Interface{s, f}}
+}
+
+type MappingInt2EventFunc func(next int, err error, complete bool, observer EventObserver)
+type MappingInt2EventFuncFactory func(observer EventObserver) MappingInt2EventFunc
+
+type MappingInt2EventObservable struct {
+	parent IntObservable
+	mapper MappingInt2EventFuncFactory
+}
+
+func (f *MappingInt2EventObservable) Subscribe(observer EventObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInt2EventObservable(parent IntObservable, mapper MappingInt2EventFuncFactory) EventObservable {
+	return &MappingInt2EventObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInt2EventObserveDirect(parent IntObservable, mapper MappingInt2EventFunc) EventObservable {
+	return MapInt2EventObservable(parent, func(EventObserver) MappingInt2EventFunc {
+		return mapper
+	})
+}
+
+func MapInt2EventObserveNext(parent IntObservable, mapper func(int) Event) EventObservable {
+	return MapInt2EventObservable(parent, func(EventObserver) MappingInt2EventFunc {
+		return func(next int, err error, complete bool, observer EventObserver) {
+			var mapped Event
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughEvent(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapInt2Event struct {
+	parent IntObservable
+	mapper func(int) EventObservable
+}
+
+func (f *flatMapInt2Event) Subscribe(observer EventObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&EventStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &EventStream{ignoreCompletionFilter().Event(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapEvent maps this stream to an EventStream via f.
+func (s *IntStream) MapEvent(f func(int) Event) *EventStream {
+	return FromEventObservable(MapInt2EventObserveNext(s, f))
+}
+
+func (s *IntStream) FlatMapEvent(f func(int) EventObservable) *EventStream {
+	return &EventStream{&flatMapInt2Event{s, f}}
+}
+
+type MappingInt2IntFunc func(next int, err error, complete bool, observer IntObserver)
+type MappingInt2IntFuncFactory func(observer IntObserver) MappingInt2IntFunc
+
+type MappingInt2IntObservable struct {
+	parent IntObservable
+	mapper MappingInt2IntFuncFactory
+}
+
+func (f *MappingInt2IntObservable) Subscribe(observer IntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInt2IntObservable(parent IntObservable, mapper MappingInt2IntFuncFactory) IntObservable {
+	return &MappingInt2IntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInt2IntObserveDirect(parent IntObservable, mapper MappingInt2IntFunc) IntObservable {
+	return MapInt2IntObservable(parent, func(IntObserver) MappingInt2IntFunc {
+		return mapper
+	})
+}
+
+func MapInt2IntObserveNext(parent IntObservable, mapper func(int) int) IntObservable {
+	return MapInt2IntObservable(parent, func(IntObserver) MappingInt2IntFunc {
+		return func(next int, err error, complete bool, observer IntObserver) {
+			var mapped int
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapInt2Int struct {
+	parent IntObservable
+	mapper func(int) IntObservable
+}
+
+func (f *flatMapInt2Int) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&IntStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &IntStream{ignoreCompletionFilter().Int(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// Map maps values in this stream to another value.
+func (s *IntStream) Map(f func(int) int) *IntStream {
+	return FromIntObservable(MapInt2IntObserveNext(s, f))
+}
+
+func (s *IntStream) FlatMap(f func(int) IntObservable) *IntStream {
+	return &IntStream{&flatMapInt2Int{s, f}}
+}
+
+type MappingInt2BoolFunc func(next int, err error, complete bool, observer BoolObserver)
+type MappingInt2BoolFuncFactory func(observer BoolObserver) MappingInt2BoolFunc
+
+type MappingInt2BoolObservable struct {
+	parent IntObservable
+	mapper MappingInt2BoolFuncFactory
+}
+
+func (f *MappingInt2BoolObservable) Subscribe(observer BoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInt2BoolObservable(parent IntObservable, mapper MappingInt2BoolFuncFactory) BoolObservable {
+	return &MappingInt2BoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInt2BoolObserveDirect(parent IntObservable, mapper MappingInt2BoolFunc) BoolObservable {
+	return MapInt2BoolObservable(parent, func(BoolObserver) MappingInt2BoolFunc {
+		return mapper
+	})
+}
+
+func MapInt2BoolObserveNext(parent IntObservable, mapper func(int) bool) BoolObservable {
+	return MapInt2BoolObservable(parent, func(BoolObserver) MappingInt2BoolFunc {
+		return func(next int, err error, complete bool, observer BoolObserver) {
+			var mapped bool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapInt2Bool struct {
+	parent IntObservable
+	mapper func(int) BoolObservable
+}
+
+func (f *flatMapInt2Bool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&BoolStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &BoolStream{ignoreCompletionFilter().Bool(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *IntStream) MapBool(f func(int) bool) *BoolStream {
+	return FromBoolObservable(MapInt2BoolObserveNext(s, f))
+}
+
+func (s *IntStream) FlatMapBool(f func(int) BoolObservable) *BoolStream {
+	return &BoolStream{&flatMapInt2Bool{s, f}}
+}
+
+type MappingInt2IntNotificationFunc func(next int, err error, complete bool, observer IntNotificationObserver)
+type MappingInt2IntNotificationFuncFactory func(observer IntNotificationObserver) MappingInt2IntNotificationFunc
+
+type MappingInt2IntNotificationObservable struct {
+	parent IntObservable
+	mapper MappingInt2IntNotificationFuncFactory
+}
+
+func (f *MappingInt2IntNotificationObservable) Subscribe(observer IntNotificationObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInt2IntNotificationObservable(parent IntObservable, mapper MappingInt2IntNotificationFuncFactory) IntNotificationObservable {
+	return &MappingInt2IntNotificationObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInt2IntNotificationObserveDirect(parent IntObservable, mapper MappingInt2IntNotificationFunc) IntNotificationObservable {
+	return MapInt2IntNotificationObservable(parent, func(IntNotificationObserver) MappingInt2IntNotificationFunc {
+		return mapper
+	})
+}
+
+func MapInt2IntNotificationObserveNext(parent IntObservable, mapper func(int) IntNotification) IntNotificationObservable {
+	return MapInt2IntNotificationObservable(parent, func(IntNotificationObserver) MappingInt2IntNotificationFunc {
+		return func(next int, err error, complete bool, observer IntNotificationObserver) {
+			var mapped IntNotification
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntNotification(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapIntNotification maps this stream to an IntNotificationStream via f.
+func (s *IntStream) MapIntNotification(f func(int) IntNotification) *IntNotificationStream {
+	return FromIntNotificationObservable(MapInt2IntNotificationObserveNext(s, f))
+}
+
+type MappingInt2TimestampedIntFunc func(next int, err error, complete bool, observer TimestampedIntObserver)
+type MappingInt2TimestampedIntFuncFactory func(observer TimestampedIntObserver) MappingInt2TimestampedIntFunc
+
+type MappingInt2TimestampedIntObservable struct {
+	parent IntObservable
+	mapper MappingInt2TimestampedIntFuncFactory
+}
+
+func (f *MappingInt2TimestampedIntObservable) Subscribe(observer TimestampedIntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInt2TimestampedIntObservable(parent IntObservable, mapper MappingInt2TimestampedIntFuncFactory) TimestampedIntObservable {
+	return &MappingInt2TimestampedIntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInt2TimestampedIntObserveDirect(parent IntObservable, mapper MappingInt2TimestampedIntFunc) TimestampedIntObservable {
+	return MapInt2TimestampedIntObservable(parent, func(TimestampedIntObserver) MappingInt2TimestampedIntFunc {
+		return mapper
+	})
+}
+
+func MapInt2TimestampedIntObserveNext(parent IntObservable, mapper func(int) TimestampedInt) TimestampedIntObservable {
+	return MapInt2TimestampedIntObservable(parent, func(TimestampedIntObserver) MappingInt2TimestampedIntFunc {
+		return func(next int, err error, complete bool, observer TimestampedIntObserver) {
+			var mapped TimestampedInt
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughTimestampedInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapTimestampedInt maps this stream to an TimestampedIntStream via f.
+func (s *IntStream) MapTimestampedInt(f func(int) TimestampedInt) *TimestampedIntStream {
+	return FromTimestampedIntObservable(MapInt2TimestampedIntObserveNext(s, f))
+}
+
+type MappingInt2IntervalIntFunc func(next int, err error, complete bool, observer IntervalIntObserver)
+type MappingInt2IntervalIntFuncFactory func(observer IntervalIntObserver) MappingInt2IntervalIntFunc
+
+type MappingInt2IntervalIntObservable struct {
+	parent IntObservable
+	mapper MappingInt2IntervalIntFuncFactory
+}
+
+func (f *MappingInt2IntervalIntObservable) Subscribe(observer IntervalIntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInt2IntervalIntObservable(parent IntObservable, mapper MappingInt2IntervalIntFuncFactory) IntervalIntObservable {
+	return &MappingInt2IntervalIntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInt2IntervalIntObserveDirect(parent IntObservable, mapper MappingInt2IntervalIntFunc) IntervalIntObservable {
+	return MapInt2IntervalIntObservable(parent, func(IntervalIntObserver) MappingInt2IntervalIntFunc {
+		return mapper
+	})
+}
+
+func MapInt2IntervalIntObserveNext(parent IntObservable, mapper func(int) IntervalInt) IntervalIntObservable {
+	return MapInt2IntervalIntObservable(parent, func(IntervalIntObserver) MappingInt2IntervalIntFunc {
+		return func(next int, err error, complete bool, observer IntervalIntObserver) {
+			var mapped IntervalInt
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntervalInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapIntervalInt maps this stream to an IntervalIntStream via f.
+func (s *IntStream) MapIntervalInt(f func(int) IntervalInt) *IntervalIntStream {
+	return FromIntervalIntObservable(MapInt2IntervalIntObserveNext(s, f))
+}
+
+type IntNotificationObserver interface {
+	Next(IntNotification)
+	TerminationObserver
+}
+
+// A IntNotificationSubscriber represents a subscribed IntNotificationObserver.
+type IntNotificationSubscriber interface {
+	Subscription
+	IntNotificationObserver
+}
+
+type implIntNotificationSubscriber struct {
+	Subscription
+	IntNotificationObserver
+}
+
+func IntNotificationObserverAsGenericObserver(observer IntNotificationObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(IntNotification))
+		}
+	})
+}
+
+func GenericObserverAsIntNotificationObserver(observer GenericObserver) IntNotificationObserver {
+	return IntNotificationObserverFunc(func(next IntNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type IntNotificationObservableFactory func(observer IntNotificationObserver, subscription Subscription)
+
+func (f IntNotificationObservableFactory) Subscribe(observer IntNotificationObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateIntNotification calls f(observer, subscription) to produce values for a stream.
+func CreateIntNotification(f func(observer IntNotificationObserver, subscription Subscription)) *IntNotificationStream {
+	return FromIntNotificationObservable(IntNotificationObservableFactory(f))
+}
+func PassthroughIntNotification(next IntNotification, err error, complete bool, observer IntNotificationObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroIntNotification = *new(IntNotification)
+
+type IntNotificationObserverFunc func(IntNotification, error, bool)
+
+func (f IntNotificationObserverFunc) Next(next IntNotification) { f(next, nil, false) }
+func (f IntNotificationObserverFunc) Error(err error)           { f(zeroIntNotification, err, false) }
+func (f IntNotificationObserverFunc) Complete()                 { f(zeroIntNotification, nil, true) }
+
+type IntNotificationObservable interface {
+	Subscribe(IntNotificationObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a IntNotificationObservable
+func (f GenericObservableFilterFactory) IntNotification(parent IntNotificationObservable) IntNotificationObservable {
+	return MapIntNotification2IntNotificationObservable(parent, func(observer IntNotificationObserver) MappingIntNotification2IntNotificationFunc {
+		gobserver := IntNotificationObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next IntNotification, err error, complete bool, observer IntNotificationObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromIntNotificationArray(array []IntNotification) *IntNotificationStream {
+	return CreateIntNotification(func(observer IntNotificationObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromIntNotifications(array ...IntNotification) *IntNotificationStream {
+	return FromIntNotificationArray(array)
+}
+
+func FromIntNotificationChannel(ch <-chan IntNotification) *IntNotificationStream {
+	return CreateIntNotification(func(observer IntNotificationObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type IntNotificationStream struct {
+	IntNotificationObservable
+}
+
+func FromIntNotificationObservable(observable IntNotificationObservable) *IntNotificationStream {
+	return &IntNotificationStream{observable}
+}
+
+func (s *IntNotificationStream) SubscribeFunc(f func(IntNotification, error, bool)) Subscription {
+	return s.Subscribe(IntNotificationObserverFunc(f))
+}
+
+func (s *IntNotificationStream) SubscribeNext(f func(v IntNotification)) Subscription {
+	return s.SubscribeFunc(func(next IntNotification, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *IntNotificationStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsIntNotificationObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *IntNotificationStream) Filter(f func(IntNotification) bool) *IntNotificationStream {
+	return FromIntNotificationObservable(filterFilter(func(v interface{}) bool { return f(v.(IntNotification)) }).IntNotification(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *IntNotificationStream) Do(f func(next IntNotification)) *IntNotificationStream {
+	return FromIntNotificationObservable(MapIntNotification2IntNotificationObserveNext(s, func(next IntNotification) IntNotification {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *IntNotificationStream) DoOnError(f func(err error)) *IntNotificationStream {
+	return FromIntNotificationObservable(MapIntNotification2IntNotificationObserveDirect(s, func(next IntNotification, err error, complete bool, observer IntNotificationObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughIntNotification(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *IntNotificationStream) DoOnComplete(f func()) *IntNotificationStream {
+	return FromIntNotificationObservable(MapIntNotification2IntNotificationObserveDirect(s, func(next IntNotification, err error, complete bool, observer IntNotificationObserver) {
+		if complete {
+			f()
+		}
+		PassthroughIntNotification(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *IntNotificationStream) ToOneWithError() (IntNotification, error) {
+	valuech := make(chan IntNotification, 1)
+	errch := make(chan error, 1)
+	FromIntNotificationObservable(oneFilter().IntNotification(s)).SubscribeFunc(func(next IntNotification, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroIntNotification, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *IntNotificationStream) ToOne() IntNotification {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *IntNotificationStream) ToArrayWithError() ([]IntNotification, error) {
+	array := []IntNotification{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *IntNotificationStream) ToArray() []IntNotification {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *IntNotificationStream) ToChannelWithError() (<-chan IntNotification, <-chan error) {
+	ch := make(chan IntNotification, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *IntNotificationStream) ToChannel() <-chan IntNotification {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *IntNotificationStream) Seq() iter.Seq[IntNotification] {
+	return func(yield func(IntNotification) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *IntNotificationStream) Seq2() iter.Seq2[IntNotification, error] {
+	return func(yield func(IntNotification, error) bool) {
+		type notification struct {
+			next     IntNotification
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next IntNotification, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroIntNotification, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapIntNotification2Interface struct {
+	parent IntNotificationObservable
+	f      func(IntNotification) interface{}
+}
+
+func (m *mapIntNotification2Interface) Subscribe(observer InterfaceObserver) Subscription {
+	return m.parent.Subscribe(IntNotificationObserverFunc(func(next IntNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInterface maps this stream to an InterfaceStream via f.
+func (s *IntNotificationStream) MapInterface(f func(IntNotification) interface{}) *InterfaceStream {
+	return &InterfaceStream{&mapIntNotification2Interface{s, f}}
+}
+
+type mapIntNotification2Event struct {
+	parent IntNotificationObservable
+	f      func(IntNotification) Event
+}
+
+func (m *mapIntNotification2Event) Subscribe(observer EventObserver) Subscription {
+	return m.parent.Subscribe(IntNotificationObserverFunc(func(next IntNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapEvent maps this stream to an EventStream via f.
+func (s *IntNotificationStream) MapEvent(f func(IntNotification) Event) *EventStream {
+	return &EventStream{&mapIntNotification2Event{s, f}}
+}
+
+type mapIntNotification2Bool struct {
+	parent IntNotificationObservable
+	f      func(IntNotification) bool
+}
+
+func (m *mapIntNotification2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(IntNotificationObserverFunc(func(next IntNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *IntNotificationStream) MapBool(f func(IntNotification) bool) *BoolStream {
+	return &BoolStream{&mapIntNotification2Bool{s, f}}
+}
+
+type MappingIntNotification2IntNotificationFunc func(next IntNotification, err error, complete bool, observer IntNotificationObserver)
+type MappingIntNotification2IntNotificationFuncFactory func(observer IntNotificationObserver) MappingIntNotification2IntNotificationFunc
+
+type MappingIntNotification2IntNotificationObservable struct {
+	parent IntNotificationObservable
+	mapper MappingIntNotification2IntNotificationFuncFactory
+}
+
+func (f *MappingIntNotification2IntNotificationObservable) Subscribe(observer IntNotificationObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntNotificationObserverFunc(func(next IntNotification, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapIntNotification2IntNotificationObservable(parent IntNotificationObservable, mapper MappingIntNotification2IntNotificationFuncFactory) IntNotificationObservable {
+	return &MappingIntNotification2IntNotificationObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntNotification2IntNotificationObserveDirect(parent IntNotificationObservable, mapper MappingIntNotification2IntNotificationFunc) IntNotificationObservable {
+	return MapIntNotification2IntNotificationObservable(parent, func(IntNotificationObserver) MappingIntNotification2IntNotificationFunc {
+		return mapper
+	})
+}
+
+func MapIntNotification2IntNotificationObserveNext(parent IntNotificationObservable, mapper func(IntNotification) IntNotification) IntNotificationObservable {
+	return MapIntNotification2IntNotificationObservable(parent, func(IntNotificationObserver) MappingIntNotification2IntNotificationFunc {
+		return func(next IntNotification, err error, complete bool, observer IntNotificationObserver) {
+			var mapped IntNotification
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntNotification(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *IntNotificationStream) Map(f func(IntNotification) IntNotification) *IntNotificationStream {
+	return FromIntNotificationObservable(MapIntNotification2IntNotificationObserveNext(s, f))
+}
+
+type MappingIntNotification2IntFunc func(next IntNotification, err error, complete bool, observer IntObserver)
+type MappingIntNotification2IntFuncFactory func(observer IntObserver) MappingIntNotification2IntFunc
+
+type MappingIntNotification2IntObservable struct {
+	parent IntNotificationObservable
+	mapper MappingIntNotification2IntFuncFactory
+}
+
+func (f *MappingIntNotification2IntObservable) Subscribe(observer IntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntNotificationObserverFunc(func(next IntNotification, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapIntNotification2IntObservable(parent IntNotificationObservable, mapper MappingIntNotification2IntFuncFactory) IntObservable {
+	return &MappingIntNotification2IntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntNotification2IntObserveDirect(parent IntNotificationObservable, mapper MappingIntNotification2IntFunc) IntObservable {
+	return MapIntNotification2IntObservable(parent, func(IntObserver) MappingIntNotification2IntFunc {
+		return mapper
+	})
+}
+
+func MapIntNotification2IntObserveNext(parent IntNotificationObservable, mapper func(IntNotification) int) IntObservable {
+	return MapIntNotification2IntObservable(parent, func(IntObserver) MappingIntNotification2IntFunc {
+		return func(next IntNotification, err error, complete bool, observer IntObserver) {
+			var mapped int
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapIntNotification2Int struct {
+	parent IntNotificationObservable
+	mapper func(IntNotification) IntObservable
+}
+
+func (f *flatMapIntNotification2Int) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntNotificationObserverFunc(func(next IntNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&IntStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &IntStream{ignoreCompletionFilter().Int(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *IntNotificationStream) MapInt(f func(IntNotification) int) *IntStream {
+	return FromIntObservable(MapIntNotification2IntObserveNext(s, f))
+}
+
+func (s *IntNotificationStream) FlatMapInt(f func(IntNotification) IntObservable) *IntStream {
+	return &IntStream{&flatMapIntNotification2Int{s, f}}
+}
+
+type TimestampedIntObserver interface {
+	Next(TimestampedInt)
+	TerminationObserver
+}
+
+// A TimestampedIntSubscriber represents a subscribed TimestampedIntObserver.
+type TimestampedIntSubscriber interface {
+	Subscription
+	TimestampedIntObserver
+}
+
+type implTimestampedIntSubscriber struct {
+	Subscription
+	TimestampedIntObserver
+}
+
+func TimestampedIntObserverAsGenericObserver(observer TimestampedIntObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(TimestampedInt))
+		}
+	})
+}
+
+func GenericObserverAsTimestampedIntObserver(observer GenericObserver) TimestampedIntObserver {
+	return TimestampedIntObserverFunc(func(next TimestampedInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type TimestampedIntObservableFactory func(observer TimestampedIntObserver, subscription Subscription)
+
+func (f TimestampedIntObservableFactory) Subscribe(observer TimestampedIntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateTimestampedInt calls f(observer, subscription) to produce values for a stream.
+func CreateTimestampedInt(f func(observer TimestampedIntObserver, subscription Subscription)) *TimestampedIntStream {
+	return FromTimestampedIntObservable(TimestampedIntObservableFactory(f))
+}
+func PassthroughTimestampedInt(next TimestampedInt, err error, complete bool, observer TimestampedIntObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroTimestampedInt = *new(TimestampedInt)
+
+type TimestampedIntObserverFunc func(TimestampedInt, error, bool)
+
+func (f TimestampedIntObserverFunc) Next(next TimestampedInt) { f(next, nil, false) }
+func (f TimestampedIntObserverFunc) Error(err error)          { f(zeroTimestampedInt, err, false) }
+func (f TimestampedIntObserverFunc) Complete()                { f(zeroTimestampedInt, nil, true) }
+
+type TimestampedIntObservable interface {
+	Subscribe(TimestampedIntObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a TimestampedIntObservable
+func (f GenericObservableFilterFactory) TimestampedInt(parent TimestampedIntObservable) TimestampedIntObservable {
+	return MapTimestampedInt2TimestampedIntObservable(parent, func(observer TimestampedIntObserver) MappingTimestampedInt2TimestampedIntFunc {
+		gobserver := TimestampedIntObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next TimestampedInt, err error, complete bool, observer TimestampedIntObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromTimestampedIntArray(array []TimestampedInt) *TimestampedIntStream {
+	return CreateTimestampedInt(func(observer TimestampedIntObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromTimestampedInts(array ...TimestampedInt) *TimestampedIntStream {
+	return FromTimestampedIntArray(array)
+}
+
+func FromTimestampedIntChannel(ch <-chan TimestampedInt) *TimestampedIntStream {
+	return CreateTimestampedInt(func(observer TimestampedIntObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type TimestampedIntStream struct {
+	TimestampedIntObservable
+}
+
+func FromTimestampedIntObservable(observable TimestampedIntObservable) *TimestampedIntStream {
+	return &TimestampedIntStream{observable}
+}
+
+func (s *TimestampedIntStream) SubscribeFunc(f func(TimestampedInt, error, bool)) Subscription {
+	return s.Subscribe(TimestampedIntObserverFunc(f))
+}
+
+func (s *TimestampedIntStream) SubscribeNext(f func(v TimestampedInt)) Subscription {
+	return s.SubscribeFunc(func(next TimestampedInt, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *TimestampedIntStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsTimestampedIntObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *TimestampedIntStream) Filter(f func(TimestampedInt) bool) *TimestampedIntStream {
+	return FromTimestampedIntObservable(filterFilter(func(v interface{}) bool { return f(v.(TimestampedInt)) }).TimestampedInt(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *TimestampedIntStream) Do(f func(next TimestampedInt)) *TimestampedIntStream {
+	return FromTimestampedIntObservable(MapTimestampedInt2TimestampedIntObserveNext(s, func(next TimestampedInt) TimestampedInt {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *TimestampedIntStream) DoOnError(f func(err error)) *TimestampedIntStream {
+	return FromTimestampedIntObservable(MapTimestampedInt2TimestampedIntObserveDirect(s, func(next TimestampedInt, err error, complete bool, observer TimestampedIntObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughTimestampedInt(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *TimestampedIntStream) DoOnComplete(f func()) *TimestampedIntStream {
+	return FromTimestampedIntObservable(MapTimestampedInt2TimestampedIntObserveDirect(s, func(next TimestampedInt, err error, complete bool, observer TimestampedIntObserver) {
+		if complete {
+			f()
+		}
+		PassthroughTimestampedInt(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *TimestampedIntStream) ToOneWithError() (TimestampedInt, error) {
+	valuech := make(chan TimestampedInt, 1)
+	errch := make(chan error, 1)
+	FromTimestampedIntObservable(oneFilter().TimestampedInt(s)).SubscribeFunc(func(next TimestampedInt, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroTimestampedInt, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *TimestampedIntStream) ToOne() TimestampedInt {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *TimestampedIntStream) ToArrayWithError() ([]TimestampedInt, error) {
+	array := []TimestampedInt{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next TimestampedInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *TimestampedIntStream) ToArray() []TimestampedInt {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *TimestampedIntStream) ToChannelWithError() (<-chan TimestampedInt, <-chan error) {
+	ch := make(chan TimestampedInt, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next TimestampedInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *TimestampedIntStream) ToChannel() <-chan TimestampedInt {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *TimestampedIntStream) Seq() iter.Seq[TimestampedInt] {
+	return func(yield func(TimestampedInt) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *TimestampedIntStream) Seq2() iter.Seq2[TimestampedInt, error] {
+	return func(yield func(TimestampedInt, error) bool) {
+		type notification struct {
+			next     TimestampedInt
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next TimestampedInt, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroTimestampedInt, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapTimestampedInt2Interface struct {
+	parent TimestampedIntObservable
+	f      func(TimestampedInt) interface{}
+}
+
+func (m *mapTimestampedInt2Interface) Subscribe(observer InterfaceObserver) Subscription {
+	return m.parent.Subscribe(TimestampedIntObserverFunc(func(next TimestampedInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInterface maps this stream to an InterfaceStream via f.
+func (s *TimestampedIntStream) MapInterface(f func(TimestampedInt) interface{}) *InterfaceStream {
+	return &InterfaceStream{&mapTimestampedInt2Interface{s, f}}
+}
+
+type mapTimestampedInt2Event struct {
+	parent TimestampedIntObservable
+	f      func(TimestampedInt) Event
+}
+
+func (m *mapTimestampedInt2Event) Subscribe(observer EventObserver) Subscription {
+	return m.parent.Subscribe(TimestampedIntObserverFunc(func(next TimestampedInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapEvent maps this stream to an EventStream via f.
+func (s *TimestampedIntStream) MapEvent(f func(TimestampedInt) Event) *EventStream {
+	return &EventStream{&mapTimestampedInt2Event{s, f}}
+}
+
+type mapTimestampedInt2Bool struct {
+	parent TimestampedIntObservable
+	f      func(TimestampedInt) bool
+}
+
+func (m *mapTimestampedInt2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(TimestampedIntObserverFunc(func(next TimestampedInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *TimestampedIntStream) MapBool(f func(TimestampedInt) bool) *BoolStream {
+	return &BoolStream{&mapTimestampedInt2Bool{s, f}}
+}
+
+type MappingTimestampedInt2TimestampedIntFunc func(next TimestampedInt, err error, complete bool, observer TimestampedIntObserver)
+type MappingTimestampedInt2TimestampedIntFuncFactory func(observer TimestampedIntObserver) MappingTimestampedInt2TimestampedIntFunc
+
+type MappingTimestampedInt2TimestampedIntObservable struct {
+	parent TimestampedIntObservable
+	mapper MappingTimestampedInt2TimestampedIntFuncFactory
+}
+
+func (f *MappingTimestampedInt2TimestampedIntObservable) Subscribe(observer TimestampedIntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(TimestampedIntObserverFunc(func(next TimestampedInt, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapTimestampedInt2TimestampedIntObservable(parent TimestampedIntObservable, mapper MappingTimestampedInt2TimestampedIntFuncFactory) TimestampedIntObservable {
+	return &MappingTimestampedInt2TimestampedIntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapTimestampedInt2TimestampedIntObserveDirect(parent TimestampedIntObservable, mapper MappingTimestampedInt2TimestampedIntFunc) TimestampedIntObservable {
+	return MapTimestampedInt2TimestampedIntObservable(parent, func(TimestampedIntObserver) MappingTimestampedInt2TimestampedIntFunc {
+		return mapper
+	})
+}
+
+func MapTimestampedInt2TimestampedIntObserveNext(parent TimestampedIntObservable, mapper func(TimestampedInt) TimestampedInt) TimestampedIntObservable {
+	return MapTimestampedInt2TimestampedIntObservable(parent, func(TimestampedIntObserver) MappingTimestampedInt2TimestampedIntFunc {
+		return func(next TimestampedInt, err error, complete bool, observer TimestampedIntObserver) {
+			var mapped TimestampedInt
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughTimestampedInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *TimestampedIntStream) Map(f func(TimestampedInt) TimestampedInt) *TimestampedIntStream {
+	return FromTimestampedIntObservable(MapTimestampedInt2TimestampedIntObserveNext(s, f))
+}
+
+type MappingTimestampedInt2IntFunc func(next TimestampedInt, err error, complete bool, observer IntObserver)
+type MappingTimestampedInt2IntFuncFactory func(observer IntObserver) MappingTimestampedInt2IntFunc
+
+type MappingTimestampedInt2IntObservable struct {
+	parent TimestampedIntObservable
+	mapper MappingTimestampedInt2IntFuncFactory
+}
+
+func (f *MappingTimestampedInt2IntObservable) Subscribe(observer IntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(TimestampedIntObserverFunc(func(next TimestampedInt, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapTimestampedInt2IntObservable(parent TimestampedIntObservable, mapper MappingTimestampedInt2IntFuncFactory) IntObservable {
+	return &MappingTimestampedInt2IntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapTimestampedInt2IntObserveDirect(parent TimestampedIntObservable, mapper MappingTimestampedInt2IntFunc) IntObservable {
+	return MapTimestampedInt2IntObservable(parent, func(IntObserver) MappingTimestampedInt2IntFunc {
+		return mapper
+	})
+}
+
+func MapTimestampedInt2IntObserveNext(parent TimestampedIntObservable, mapper func(TimestampedInt) int) IntObservable {
+	return MapTimestampedInt2IntObservable(parent, func(IntObserver) MappingTimestampedInt2IntFunc {
+		return func(next TimestampedInt, err error, complete bool, observer IntObserver) {
+			var mapped int
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapTimestampedInt2Int struct {
+	parent TimestampedIntObservable
+	mapper func(TimestampedInt) IntObservable
+}
+
+func (f *flatMapTimestampedInt2Int) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(TimestampedIntObserverFunc(func(next TimestampedInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&IntStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &IntStream{ignoreCompletionFilter().Int(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *TimestampedIntStream) MapInt(f func(TimestampedInt) int) *IntStream {
+	return FromIntObservable(MapTimestampedInt2IntObserveNext(s, f))
+}
+
+func (s *TimestampedIntStream) FlatMapInt(f func(TimestampedInt) IntObservable) *IntStream {
+	return &IntStream{&flatMapTimestampedInt2Int{s, f}}
+}
+
+type IntervalIntObserver interface {
+	Next(IntervalInt)
+	TerminationObserver
+}
+
+// A IntervalIntSubscriber represents a subscribed IntervalIntObserver.
+type IntervalIntSubscriber interface {
+	Subscription
+	IntervalIntObserver
+}
+
+type implIntervalIntSubscriber struct {
+	Subscription
+	IntervalIntObserver
+}
+
+func IntervalIntObserverAsGenericObserver(observer IntervalIntObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(IntervalInt))
+		}
+	})
+}
+
+func GenericObserverAsIntervalIntObserver(observer GenericObserver) IntervalIntObserver {
+	return IntervalIntObserverFunc(func(next IntervalInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type IntervalIntObservableFactory func(observer IntervalIntObserver, subscription Subscription)
+
+func (f IntervalIntObservableFactory) Subscribe(observer IntervalIntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateIntervalInt calls f(observer, subscription) to produce values for a stream.
+func CreateIntervalInt(f func(observer IntervalIntObserver, subscription Subscription)) *IntervalIntStream {
+	return FromIntervalIntObservable(IntervalIntObservableFactory(f))
+}
+func PassthroughIntervalInt(next IntervalInt, err error, complete bool, observer IntervalIntObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroIntervalInt = *new(IntervalInt)
+
+type IntervalIntObserverFunc func(IntervalInt, error, bool)
+
+func (f IntervalIntObserverFunc) Next(next IntervalInt) { f(next, nil, false) }
+func (f IntervalIntObserverFunc) Error(err error)       { f(zeroIntervalInt, err, false) }
+func (f IntervalIntObserverFunc) Complete()             { f(zeroIntervalInt, nil, true) }
+
+type IntervalIntObservable interface {
+	Subscribe(IntervalIntObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a IntervalIntObservable
+func (f GenericObservableFilterFactory) IntervalInt(parent IntervalIntObservable) IntervalIntObservable {
+	return MapIntervalInt2IntervalIntObservable(parent, func(observer IntervalIntObserver) MappingIntervalInt2IntervalIntFunc {
+		gobserver := IntervalIntObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next IntervalInt, err error, complete bool, observer IntervalIntObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromIntervalIntArray(array []IntervalInt) *IntervalIntStream {
+	return CreateIntervalInt(func(observer IntervalIntObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromIntervalInts(array ...IntervalInt) *IntervalIntStream {
+	return FromIntervalIntArray(array)
+}
+
+func FromIntervalIntChannel(ch <-chan IntervalInt) *IntervalIntStream {
+	return CreateIntervalInt(func(observer IntervalIntObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type IntervalIntStream struct {
+	IntervalIntObservable
+}
+
+func FromIntervalIntObservable(observable IntervalIntObservable) *IntervalIntStream {
+	return &IntervalIntStream{observable}
+}
+
+func (s *IntervalIntStream) SubscribeFunc(f func(IntervalInt, error, bool)) Subscription {
+	return s.Subscribe(IntervalIntObserverFunc(f))
+}
+
+func (s *IntervalIntStream) SubscribeNext(f func(v IntervalInt)) Subscription {
+	return s.SubscribeFunc(func(next IntervalInt, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *IntervalIntStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsIntervalIntObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *IntervalIntStream) Filter(f func(IntervalInt) bool) *IntervalIntStream {
+	return FromIntervalIntObservable(filterFilter(func(v interface{}) bool { return f(v.(IntervalInt)) }).IntervalInt(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *IntervalIntStream) Do(f func(next IntervalInt)) *IntervalIntStream {
+	return FromIntervalIntObservable(MapIntervalInt2IntervalIntObserveNext(s, func(next IntervalInt) IntervalInt {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *IntervalIntStream) DoOnError(f func(err error)) *IntervalIntStream {
+	return FromIntervalIntObservable(MapIntervalInt2IntervalIntObserveDirect(s, func(next IntervalInt, err error, complete bool, observer IntervalIntObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughIntervalInt(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *IntervalIntStream) DoOnComplete(f func()) *IntervalIntStream {
+	return FromIntervalIntObservable(MapIntervalInt2IntervalIntObserveDirect(s, func(next IntervalInt, err error, complete bool, observer IntervalIntObserver) {
+		if complete {
+			f()
+		}
+		PassthroughIntervalInt(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *IntervalIntStream) ToOneWithError() (IntervalInt, error) {
+	valuech := make(chan IntervalInt, 1)
+	errch := make(chan error, 1)
+	FromIntervalIntObservable(oneFilter().IntervalInt(s)).SubscribeFunc(func(next IntervalInt, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroIntervalInt, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *IntervalIntStream) ToOne() IntervalInt {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *IntervalIntStream) ToArrayWithError() ([]IntervalInt, error) {
+	array := []IntervalInt{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntervalInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *IntervalIntStream) ToArray() []IntervalInt {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *IntervalIntStream) ToChannelWithError() (<-chan IntervalInt, <-chan error) {
+	ch := make(chan IntervalInt, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntervalInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *IntervalIntStream) ToChannel() <-chan IntervalInt {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *IntervalIntStream) Seq() iter.Seq[IntervalInt] {
+	return func(yield func(IntervalInt) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *IntervalIntStream) Seq2() iter.Seq2[IntervalInt, error] {
+	return func(yield func(IntervalInt, error) bool) {
+		type notification struct {
+			next     IntervalInt
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next IntervalInt, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroIntervalInt, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapIntervalInt2Interface struct {
+	parent IntervalIntObservable
+	f      func(IntervalInt) interface{}
+}
+
+func (m *mapIntervalInt2Interface) Subscribe(observer InterfaceObserver) Subscription {
+	return m.parent.Subscribe(IntervalIntObserverFunc(func(next IntervalInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInterface maps this stream to an InterfaceStream via f.
+func (s *IntervalIntStream) MapInterface(f func(IntervalInt) interface{}) *InterfaceStream {
+	return &InterfaceStream{&mapIntervalInt2Interface{s, f}}
+}
+
+type mapIntervalInt2Event struct {
+	parent IntervalIntObservable
+	f      func(IntervalInt) Event
+}
+
+func (m *mapIntervalInt2Event) Subscribe(observer EventObserver) Subscription {
+	return m.parent.Subscribe(IntervalIntObserverFunc(func(next IntervalInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapEvent maps this stream to an EventStream via f.
+func (s *IntervalIntStream) MapEvent(f func(IntervalInt) Event) *EventStream {
+	return &EventStream{&mapIntervalInt2Event{s, f}}
+}
+
+type mapIntervalInt2Bool struct {
+	parent IntervalIntObservable
+	f      func(IntervalInt) bool
+}
+
+func (m *mapIntervalInt2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(IntervalIntObserverFunc(func(next IntervalInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *IntervalIntStream) MapBool(f func(IntervalInt) bool) *BoolStream {
+	return &BoolStream{&mapIntervalInt2Bool{s, f}}
+}
+
+type MappingIntervalInt2IntervalIntFunc func(next IntervalInt, err error, complete bool, observer IntervalIntObserver)
+type MappingIntervalInt2IntervalIntFuncFactory func(observer IntervalIntObserver) MappingIntervalInt2IntervalIntFunc
+
+type MappingIntervalInt2IntervalIntObservable struct {
+	parent IntervalIntObservable
+	mapper MappingIntervalInt2IntervalIntFuncFactory
+}
+
+func (f *MappingIntervalInt2IntervalIntObservable) Subscribe(observer IntervalIntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntervalIntObserverFunc(func(next IntervalInt, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapIntervalInt2IntervalIntObservable(parent IntervalIntObservable, mapper MappingIntervalInt2IntervalIntFuncFactory) IntervalIntObservable {
+	return &MappingIntervalInt2IntervalIntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntervalInt2IntervalIntObserveDirect(parent IntervalIntObservable, mapper MappingIntervalInt2IntervalIntFunc) IntervalIntObservable {
+	return MapIntervalInt2IntervalIntObservable(parent, func(IntervalIntObserver) MappingIntervalInt2IntervalIntFunc {
+		return mapper
+	})
+}
+
+func MapIntervalInt2IntervalIntObserveNext(parent IntervalIntObservable, mapper func(IntervalInt) IntervalInt) IntervalIntObservable {
+	return MapIntervalInt2IntervalIntObservable(parent, func(IntervalIntObserver) MappingIntervalInt2IntervalIntFunc {
+		return func(next IntervalInt, err error, complete bool, observer IntervalIntObserver) {
+			var mapped IntervalInt
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntervalInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *IntervalIntStream) Map(f func(IntervalInt) IntervalInt) *IntervalIntStream {
+	return FromIntervalIntObservable(MapIntervalInt2IntervalIntObserveNext(s, f))
+}
+
+type MappingIntervalInt2IntFunc func(next IntervalInt, err error, complete bool, observer IntObserver)
+type MappingIntervalInt2IntFuncFactory func(observer IntObserver) MappingIntervalInt2IntFunc
+
+type MappingIntervalInt2IntObservable struct {
+	parent IntervalIntObservable
+	mapper MappingIntervalInt2IntFuncFactory
+}
+
+func (f *MappingIntervalInt2IntObservable) Subscribe(observer IntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntervalIntObserverFunc(func(next IntervalInt, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapIntervalInt2IntObservable(parent IntervalIntObservable, mapper MappingIntervalInt2IntFuncFactory) IntObservable {
+	return &MappingIntervalInt2IntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntervalInt2IntObserveDirect(parent IntervalIntObservable, mapper MappingIntervalInt2IntFunc) IntObservable {
+	return MapIntervalInt2IntObservable(parent, func(IntObserver) MappingIntervalInt2IntFunc {
+		return mapper
+	})
+}
+
+func MapIntervalInt2IntObserveNext(parent IntervalIntObservable, mapper func(IntervalInt) int) IntObservable {
+	return MapIntervalInt2IntObservable(parent, func(IntObserver) MappingIntervalInt2IntFunc {
+		return func(next IntervalInt, err error, complete bool, observer IntObserver) {
+			var mapped int
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapIntervalInt2Int struct {
+	parent IntervalIntObservable
+	mapper func(IntervalInt) IntObservable
+}
+
+func (f *flatMapIntervalInt2Int) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntervalIntObserverFunc(func(next IntervalInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&IntStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &IntStream{ignoreCompletionFilter().Int(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *IntervalIntStream) MapInt(f func(IntervalInt) int) *IntStream {
+	return FromIntObservable(MapIntervalInt2IntObserveNext(s, f))
+}
+
+func (s *IntervalIntStream) FlatMapInt(f func(IntervalInt) IntObservable) *IntStream {
+	return &IntStream{&flatMapIntervalInt2Int{s, f}}
+}
+
+type BoolObserver interface {
+	Next(bool)
+	TerminationObserver
+}
+
+// A BoolSubscriber represents a subscribed BoolObserver.
+type BoolSubscriber interface {
+	Subscription
+	BoolObserver
+}
+
+type implBoolSubscriber struct {
+	Subscription
+	BoolObserver
+}
+
+func BoolObserverAsGenericObserver(observer BoolObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(bool))
+		}
+	})
+}
+
+func GenericObserverAsBoolObserver(observer GenericObserver) BoolObserver {
+	return BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type BoolObservableFactory func(observer BoolObserver, subscription Subscription)
+
+func (f BoolObservableFactory) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateBool calls f(observer, subscription) to produce values for a stream.
+func CreateBool(f func(observer BoolObserver, subscription Subscription)) *BoolStream {
+	return FromBoolObservable(BoolObservableFactory(f))
+}
+
+// Repeat value count times.
+func RepeatBool(value bool, count int) *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {
+		for i := 0; i < count; i++ {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(value)
+		}
+		observer.Complete()
+	})
+}
+
+// StartBool is designed to be used with functions that return a
+// (bool, error) tuple.
+//
+// If the error is non-nil the returned BoolStream will be that error,
+// otherwise it will be a single-value stream of bool.
+func StartBool(f func() (bool, error)) *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {
+		if v, err := f(); err != nil {
+			observer.Error(err)
+		} else {
+			observer.Next(v)
+			observer.Complete()
+		}
+	})
+}
+
+type deferBoolObservable func() BoolObservable
+
+func (f deferBoolObservable) Subscribe(observer BoolObserver) Subscription {
+	return f().Subscribe(observer)
+}
+
+// DeferBool calls f to create a fresh observable for each subscription.
+func DeferBool(f func() BoolObservable) *BoolStream {
+	return FromBoolObservable(deferBoolObservable(f))
+}
+func PassthroughBool(next bool, err error, complete bool, observer BoolObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroBool = *new(bool)
+
+type BoolObserverFunc func(bool, error, bool)
+
+func (f BoolObserverFunc) Next(next bool)  { f(next, nil, false) }
+func (f BoolObserverFunc) Error(err error) { f(zeroBool, err, false) }
+func (f BoolObserverFunc) Complete()       { f(zeroBool, nil, true) }
+
+type BoolObservable interface {
+	Subscribe(BoolObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a BoolObservable
+func (f GenericObservableFilterFactory) Bool(parent BoolObservable) BoolObservable {
+	return MapBool2BoolObservable(parent, func(observer BoolObserver) MappingBool2BoolFunc {
+		gobserver := BoolObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next bool, err error, complete bool, observer BoolObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func NeverBool() *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {})
+}
+
+func EmptyBool() *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {
+		observer.Complete()
+	})
+}
+
+func ThrowBool(err error) *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {
+		observer.Error(err)
+	})
+}
+func FromBoolArray(array []bool) *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromBools(array ...bool) *BoolStream {
+	return FromBoolArray(array)
+}
+
+// FromBoolSeq emits each value yielded by seq, iterating it once per
+// subscription.
+func FromBoolSeq(seq iter.Seq[bool]) *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {
+		for v := range seq {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+func JustBool(element bool) *BoolStream {
+	return FromBoolArray([]bool{element})
+}
+
+func MergeBool(observables ...BoolObservable) *BoolStream {
+	if len(observables) == 0 {
+		return EmptyBool()
+	}
+	return (&BoolStream{observables[0]}).Merge(observables[1:]...)
+}
+
+func MergeBoolDelayError(observables ...BoolObservable) *BoolStream {
+	if len(observables) == 0 {
+		return EmptyBool()
+	}
+	return (&BoolStream{observables[0]}).MergeDelayError(observables[1:]...)
+}
+
+type ambBoolObservable []BoolObservable
+
+func (a ambBoolObservable) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	winner := -1
+	subscriptions := make([]*LinkedSubscription, len(a))
+	for i := range subscriptions {
+		subscriptions[i] = NewLinkedSubscription()
+	}
+	for i, observable := range a {
+		i := i
+		subscriptions[i].Link(observable.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+			lock.Lock()
+			if winner == -1 {
+				winner = i
+				for j, subscription := range subscriptions {
+					if j != i {
+						subscription.Dispose()
+					}
+				}
+			}
+			won := winner == i
+			lock.Unlock()
+			if won {
+				PassthroughBool(next, err, complete, observer)
+			}
+		})))
+	}
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		for _, s := range subscriptions {
+			s.Dispose()
+		}
+	})
+	return subscription
+}
+
+// AmbBool subscribes to all observables and mirrors whichever emits
+// first, disposing the others.
+func AmbBool(observables ...BoolObservable) *BoolStream {
+	if len(observables) == 0 {
+		return EmptyBool()
+	}
+	return FromBoolObservable(ambBoolObservable(observables))
+}
+func FromBoolChannel(ch <-chan bool) *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type BoolStream struct {
+	BoolObservable
+}
+
+func FromBoolObservable(observable BoolObservable) *BoolStream {
+	return &BoolStream{observable}
+}
+
+func (s *BoolStream) SubscribeFunc(f func(bool, error, bool)) Subscription {
+	return s.Subscribe(BoolObserverFunc(f))
+}
+
+func (s *BoolStream) SubscribeNext(f func(v bool)) Subscription {
+	return s.SubscribeFunc(func(next bool, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *BoolStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsBoolObserver(observer))
+}
+
+// Distinct removes duplicate elements in the stream. Values that can not be
+// map keys, such as slices, are compared with reflect.DeepEqual.
+func (s *BoolStream) Distinct() *BoolStream {
+	return FromBoolObservable(distinctFilter().Bool(s))
+}
+
+// ElementAt yields the Nth element of the stream.
+func (s *BoolStream) ElementAt(n int) *BoolStream {
+	return FromBoolObservable(elementAtFilter(n).Bool(s))
+}
+
+// Filter elements in the stream on a function.
+func (s *BoolStream) Filter(f func(bool) bool) *BoolStream {
+	return FromBoolObservable(filterFilter(func(v interface{}) bool { return f(v.(bool)) }).Bool(s))
+}
+
+// Last returns just the first element of the stream.
+func (s *BoolStream) First() *BoolStream {
+	return FromBoolObservable(firstFilter().Bool(s))
+}
+
+// Last returns just the last element of the stream.
+func (s *BoolStream) Last() *BoolStream {
+	return FromBoolObservable(lastFilter().Bool(s))
+}
+
+// SkipLast skips the first N elements of the stream.
+func (s *BoolStream) Skip(n int) *BoolStream {
+	return FromBoolObservable(skipFilter(n).Bool(s))
+}
+
+// SkipLast skips the last N elements of the stream.
+func (s *BoolStream) SkipLast(n int) *BoolStream {
+	return FromBoolObservable(skipLastFilter(n).Bool(s))
+}
+
+// Take returns just the first N elements of the stream.
+func (s *BoolStream) Take(n int) *BoolStream {
+	return FromBoolObservable(takeFilter(n).Bool(s))
+}
+
+// TakeLast returns just the last N elements of the stream.
+func (s *BoolStream) TakeLast(n int) *BoolStream {
+	return FromBoolObservable(takeLastFilter(n).Bool(s))
+}
+
+type takeWhileBool struct {
+	parent BoolObservable
+	f      func(bool) bool
+}
+
+func (t *takeWhileBool) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	parent.Link(t.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Complete()
+		case t.f(next):
+			observer.Next(next)
+		default:
+			done = true
+			observer.Complete()
+			parent.Dispose()
+		}
+	})))
+	return parent
+}
+
+// TakeWhile returns elements of the stream until f returns false, then
+// completes and disposes the stream.
+func (s *BoolStream) TakeWhile(f func(bool) bool) *BoolStream {
+	return &BoolStream{&takeWhileBool{s, f}}
+}
+
+// SkipWhile skips elements of the stream until f returns false.
+func (s *BoolStream) SkipWhile(f func(bool) bool) *BoolStream {
+	return FromBoolObservable(MapBool2BoolObservable(s, func(BoolObserver) MappingBool2BoolFunc {
+		skipping := true
+		return func(next bool, err error, complete bool, observer BoolObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if skipping && !f(next) {
+					skipping = false
+				}
+				if !skipping {
+					observer.Next(next)
+				}
+			}
+		}
+	}))
+}
+
+// IgnoreElements ignores elements of the stream and emits only the completion events.
+func (s *BoolStream) IgnoreElements() *BoolStream {
+	return FromBoolObservable(ignoreElementsFilter().Bool(s))
+}
+
+func (s *BoolStream) Replay(size int, duration time.Duration) *BoolStream {
+	return FromBoolObservable(replayFilter(size, duration).Bool(s))
+}
+
+func (s *BoolStream) Sample(duration time.Duration) *BoolStream {
+	return FromBoolObservable(sampleFilter(duration).Bool(s))
+}
+
+func (s *BoolStream) Debounce(duration time.Duration) *BoolStream {
+	return FromBoolObservable(debounceFilter(duration).Bool(s))
+}
+
+type delayBool struct {
+	parent     BoolObservable
+	delay      func(bool) time.Duration
+	completion time.Duration
+}
+
+func (d *delayBool) Subscribe(observer BoolObserver) Subscription {
+	queue := newDelayQueue(BoolObserverAsGenericObserver(observer))
+	parent := d.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			queue.error(err)
+		case complete:
+			queue.enqueue(delayedEntry{complete: true}, d.completion)
+		default:
+			queue.enqueue(delayedEntry{next: next}, d.delay(next))
+		}
+	}))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		queue.dispose()
+	})
+	return subscription
+}
+
+// Delay shifts each value, and completion, forward in time by duration. Errors
+// are not delayed. Values that are still pending are discarded on disposal.
+func (s *BoolStream) Delay(duration time.Duration) *BoolStream {
+	return &BoolStream{&delayBool{s, func(bool) time.Duration { return duration }, duration}}
+}
+
+// DelayWhen shifts each value forward in time by the duration returned by f.
+// Values are never reordered, so a value is emitted no earlier than the value
+// before it. Completion is emitted after the last value. Errors are not delayed.
+func (s *BoolStream) DelayWhen(f func(bool) time.Duration) *BoolStream {
+	return &BoolStream{&delayBool{s, f, 0}}
+}
+
+// Wait for completion of the stream and return any error.
+func (s *BoolStream) Wait() error {
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			errch <- nil
+		default:
+		}
+	})
+	return <-errch
+}
+
+func MakeBoolSubscriber(observer BoolObserver) BoolSubscriber {
+	if subscriber, ok := observer.(BoolSubscriber); ok {
+		return subscriber
+	}
+	return &implBoolSubscriber{NewGenericSubscription(), observer}
+}
+
+type concatBoolSubscriber struct {
+	observable  int
+	observer    BoolObserver
+	observables []BoolObservable
+	Subscription
+}
+
+func (c *concatBoolSubscriber) Next(next bool) {
+	c.observer.Next(next)
+}
+
+func (c *concatBoolSubscriber) Error(err error) {
+	c.observer.Error(err)
+	c.observable = len(c.observables)
+	c.Dispose()
+}
+
+func (c *concatBoolSubscriber) Complete() {
+	c.observable++
+	if c.observable >= len(c.observables) {
+		c.observer.Complete()
+		c.Dispose()
+		return
+	}
+	c.observables[c.observable].Subscribe(c)
+}
+
+type concatBoolObservable struct {
+	observables []BoolObservable
+}
+
+func (m *concatBoolObservable) Subscribe(observer BoolObserver) Subscription {
+	if len(m.observables) == 0 {
+		observer.Complete()
+		return ClosedSubscription
+	}
+	subscriber := &concatBoolSubscriber{
+		observer:     observer,
+		Subscription: NewGenericSubscription(),
+		observables:  m.observables,
+	}
+	m.observables[0].Subscribe(subscriber)
+	return subscriber
+}
+
+func (s *BoolStream) Concat(observables ...BoolObservable) *BoolStream {
+	return &BoolStream{&concatBoolObservable{append([]BoolObservable{s}, observables...)}}
+}
+
+// StartWith emits values before the values of the stream.
+func (s *BoolStream) StartWith(values ...bool) *BoolStream {
+	return FromBoolArray(values).Concat(s)
+}
+
+// EndWith emits values after the stream completes.
+func (s *BoolStream) EndWith(values ...bool) *BoolStream {
+	return s.Concat(FromBoolArray(values))
+}
+
+// DefaultIfEmpty emits value if the stream completes without emitting any values.
+func (s *BoolStream) DefaultIfEmpty(value bool) *BoolStream {
+	return FromBoolObservable(MapBool2BoolObservable(s, func(BoolObserver) MappingBool2BoolFunc {
+		empty := true
+		return func(next bool, err error, complete bool, observer BoolObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				if empty {
+					observer.Next(value)
+				}
+				observer.Complete()
+			default:
+				empty = false
+				observer.Next(next)
+			}
+		}
+	}))
+}
+
+type switchIfEmptyBoolObservable struct {
+	parent BoolObservable
+	other  BoolObservable
+}
+
+func (e *switchIfEmptyBoolObservable) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
+	empty := true
+	link.Link(e.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			if !empty {
+				observer.Complete()
+			} else if !subscription.Disposed() {
+				subscription.Set(e.other.Subscribe(observer))
+			}
+		default:
+			empty = false
+			observer.Next(next)
+		}
+	})))
+	return subscription
+}
+
+// SwitchIfEmpty switches to other if the stream completes without emitting any values.
+func (s *BoolStream) SwitchIfEmpty(other BoolObservable) *BoolStream {
+	return &BoolStream{&switchIfEmptyBoolObservable{s, other}}
+}
+
+type mergeBoolObservable struct {
+	delayError  bool
+	observables []BoolObservable
+}
+
+func (m *mergeBoolObservable) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	lock := sync.Mutex{}
+	completed := 0
+	var firstError error
+	relay := func(next bool, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if completed >= len(m.observables) {
+			return
+		}
+
+		switch {
+		case err != nil:
+			if m.delayError {
+				firstError = err
+				completed++
+			} else {
+				observer.Error(err)
+				completed = len(m.observables)
+			}
+
+		case complete:
+			completed++
+			if completed == len(m.observables) {
+				if firstError != nil {
+					observer.Error(firstError)
+				} else {
+					observer.Complete()
+				}
+			}
+		default:
+			observer.Next(next)
+		}
+	}
+	for _, observable := range m.observables {
+		observable.Subscribe(BoolObserverFunc(relay))
+	}
+	return subscription
+}
+
+// Merge an arbitrary number of observables with this one.
+// An error from any of the observables will terminate the merged stream.
+func (s *BoolStream) Merge(other ...BoolObservable) *BoolStream {
+	if len(other) == 0 {
+		return s
+	}
+	return &BoolStream{&mergeBoolObservable{false, append(other, s)}}
+}
+
+// Merge an arbitrary number of observables with this one.
+// Any error will be deferred until all observables terminate.
+func (s *BoolStream) MergeDelayError(other ...BoolObservable) *BoolStream {
+	if len(other) == 0 {
+		return s
+	}
+	return &BoolStream{&mergeBoolObservable{true, append(other, s)}}
+}
+
+// Amb mirrors whichever of this stream and others emits first, disposing the rest.
+func (s *BoolStream) Amb(others ...BoolObservable) *BoolStream {
+	return AmbBool(append([]BoolObservable{s}, others...)...)
+}
+
+type catchBoolObservable struct {
+	parent BoolObservable
+	catch  func(err error) BoolObservable
+	// Also switch to the fallback when the parent completes. err will be nil.
+	resume bool
+}
+
+func (r *catchBoolObservable) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
+	run := func(next bool, err error, complete bool) {
+		switch {
+		case err != nil || (complete && r.resume):
+			if !subscription.Disposed() {
+				subscription.Set(r.catch(err).Subscribe(observer))
+			}
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	}
+	link.Link(r.parent.Subscribe(BoolObserverFunc(run)))
+	return subscription
+}
+
+// Catch switches to the catch observable if the stream errors.
+func (s *BoolStream) Catch(catch BoolObservable) *BoolStream {
+	return s.CatchFunc(func(error) BoolObservable { return catch })
+}
+
+// CatchFunc switches to the observable returned by f(err) if the stream errors.
+func (s *BoolStream) CatchFunc(f func(err error) BoolObservable) *BoolStream {
+	return &BoolStream{&catchBoolObservable{parent: s, catch: f}}
+}
+
+// OnErrorReturn emits the single value returned by f(err) and completes if the stream errors.
+func (s *BoolStream) OnErrorReturn(f func(err error) bool) *BoolStream {
+	return s.CatchFunc(func(err error) BoolObservable { return JustBool(f(err)) })
+}
+
+// OnErrorResumeNext switches to next when the stream terminates, whether it
+// completes or errors.
+func (s *BoolStream) OnErrorResumeNext(next BoolObservable) *BoolStream {
+	return &BoolStream{&catchBoolObservable{
+		parent: s,
+		catch:  func(error) BoolObservable { return next },
+		resume: true,
+	}}
+}
+
+type retryBoolObservable struct {
+	observable BoolObservable
+	policy     RetryPolicy
+}
+
+func (r *retryBoolObservable) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewSerialSubscription()
+	attempt := 0
+	var subscribe func()
+	subscribe = func() {
+		// Set the link before subscribing, in case the observable fails
+		// and is resubscribed before Subscribe returns.
+		link := NewLinkedSubscription()
+		subscription.Set(link)
+		link.Link(r.observable.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+			switch {
+			case err != nil:
+				if subscription.Disposed() {
+					return
+				}
+				attempt++
+				delay, ok := r.policy(err, attempt)
+				switch {
+				case !ok:
+					observer.Error(err)
+				case delay > 0:
+					time.AfterFunc(delay, func() {
+						if !subscription.Disposed() {
+							subscribe()
+						}
+					})
+				default:
+					subscribe()
+				}
+			case complete:
+				observer.Complete()
+			default:
+				attempt = 0
+				observer.Next(next)
+			}
+		})))
+	}
+	subscribe()
+	return subscription
+}
+
+// Retry resubscribes to the stream immediately, and indefinitely, on error.
+func (s *BoolStream) Retry() *BoolStream {
+	return s.RetryWhen(func(error, int) (time.Duration, bool) { return 0, true })
+}
+
+// RetryN resubscribes to the stream on error, at most n times in a row without
+// the stream emitting a value in between.
+func (s *BoolStream) RetryN(n int) *BoolStream {
+	return s.RetryWhen(func(err error, attempt int) (time.Duration, bool) { return 0, attempt <= n })
+}
+
+// RetryWhen calls policy on each error to decide whether, and after how long,
+// to resubscribe to the stream. attempt counts consecutive errors, starting at
+// 1 and reset whenever the stream emits a value.
+func (s *BoolStream) RetryWhen(policy func(err error, attempt int) (time.Duration, bool)) *BoolStream {
+	return &BoolStream{&retryBoolObservable{s, policy}}
+}
+
+// RetryWithBackoff resubscribes to the stream on error, indefinitely, with
+// an ExponentialBackoff delay. The delay is reset to initial whenever the
+// stream emits a value.
+func (s *BoolStream) RetryWithBackoff(initial, max time.Duration, multiplier, jitter float64) *BoolStream {
+	return s.RetryWhen(ExponentialBackoff(initial, max, multiplier, jitter))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *BoolStream) Do(f func(next bool)) *BoolStream {
+	return FromBoolObservable(MapBool2BoolObserveNext(s, func(next bool) bool {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *BoolStream) DoOnError(f func(err error)) *BoolStream {
+	return FromBoolObservable(MapBool2BoolObserveDirect(s, func(next bool, err error, complete bool, observer BoolObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughBool(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *BoolStream) DoOnComplete(f func()) *BoolStream {
+	return FromBoolObservable(MapBool2BoolObserveDirect(s, func(next bool, err error, complete bool, observer BoolObserver) {
+		if complete {
+			f()
+		}
+		PassthroughBool(next, err, complete, observer)
+	}))
+}
+func (s *BoolStream) Reduce(initial bool, reducer func(bool, bool) bool) *BoolStream {
+	value := initial
+	return FromBoolObservable(MapBool2BoolObserveDirect(s, func(next bool, err error, complete bool, observer BoolObserver) {
+		switch {
+		case err != nil:
+			observer.Next(value)
+			observer.Error(err)
+		case complete:
+			observer.Next(value)
+			observer.Complete()
+		default:
+			value = reducer(value, next)
+		}
+	}))
+}
+
+func (s *BoolStream) Scan(initial bool, f func(bool, bool) bool) *BoolStream {
+	value := initial
+	return FromBoolObservable(MapBool2BoolObserveDirect(s, func(next bool, err error, complete bool, observer BoolObserver) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			value = f(value, next)
+			observer.Next(value)
+		}
+	}))
+}
+
+type timeoutBool struct {
+	parent  BoolObservable
+	timeout time.Duration
+}
+
+func (t *timeoutBool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewChannelSubscription()
+	cancel := t.parent.Subscribe(observer)
+	go func() {
+		select {
+		case <-time.After(t.timeout):
+			observer.Error(ErrTimeout)
+			cancel.Dispose()
+			subscription.Dispose()
+		case <-subscription:
+			cancel.Dispose()
+		}
+	}()
+	return subscription
+}
+
+func (s *BoolStream) Timeout(timeout time.Duration) *BoolStream {
+	return &BoolStream{&timeoutBool{s, timeout}}
+}
+
+type delaySubscriptionBool struct {
+	parent BoolObservable
+	delay  time.Duration
+}
+
+func (d *delaySubscriptionBool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewLinkedSubscription()
+	time.AfterFunc(d.delay, func() {
+		if !subscription.Disposed() {
+			subscription.Link(d.parent.Subscribe(observer))
+		}
+	})
+	return subscription
+}
+
+// DelaySubscription waits for delay before subscribing to the stream.
+func (s *BoolStream) DelaySubscription(delay time.Duration) *BoolStream {
+	return &BoolStream{&delaySubscriptionBool{s, delay}}
+}
+
+type takeUntilBool struct {
+	parent BoolObservable
+	signal GenericObservable
+}
+
+func (t *takeUntilBool) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			// A signal that completes without a value never ends the stream.
+			break
+		default:
+			terminate(observer.Complete)
+		}
+	})))
+	parent.Link(t.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// TakeUntil returns elements of the stream until signal, which may be a
+// stream of any type, emits a value. The stream then completes.
+func (s *BoolStream) TakeUntil(signal GenericObservable) *BoolStream {
+	return &BoolStream{&takeUntilBool{s, signal}}
+}
+
+type skipUntilBool struct {
+	parent BoolObservable
+	signal GenericObservable
+}
+
+func (t *skipUntilBool) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	open := false
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			break
+		default:
+			lock.Lock()
+			open = true
+			lock.Unlock()
+			signal.Dispose()
+		}
+	})))
+	parent.Link(t.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if open && !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// SkipUntil skips elements of the stream until signal, which may be a stream
+// of any type, emits a value.
+func (s *BoolStream) SkipUntil(signal GenericObservable) *BoolStream {
+	return &BoolStream{&skipUntilBool{s, signal}}
+}
+
+type forkedBoolStream struct {
+	lock      sync.Mutex
+	parent    BoolObservable
+	observers []BoolObserver
+}
+
+func (f *forkedBoolStream) Subscribe(observer BoolObserver) Subscription {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	i := len(f.observers)
+	f.observers = append(f.observers, observer)
+	sub := new(CallbackSubscription)
+	*sub = CallbackSubscription(func() {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		f.observers[i] = nil
+	})
+	return sub
+}
+
+// Fork replicates each event from the parent to every subscriber of the fork.
+func (s *BoolStream) Fork() *BoolStream {
+	f := &forkedBoolStream{parent: s}
+	go s.Subscribe(BoolObserverFunc(func(n bool, err error, complete bool) {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		for _, o := range f.observers {
+			if o == nil {
+				continue
+			}
+			switch {
+			case err != nil:
+				o.Error(err)
+			case complete:
+				o.Complete()
+			default:
+				o.Next(n)
+			}
+		}
+	}))
+	return &BoolStream{f}
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *BoolStream) ToOneWithError() (bool, error) {
+	valuech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	FromBoolObservable(oneFilter().Bool(s)).SubscribeFunc(func(next bool, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroBool, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *BoolStream) ToOne() bool {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *BoolStream) ToArrayWithError() ([]bool, error) {
+	array := []bool{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *BoolStream) ToArray() []bool {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *BoolStream) ToChannelWithError() (<-chan bool, <-chan error) {
+	ch := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *BoolStream) ToChannel() <-chan bool {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *BoolStream) Seq() iter.Seq[bool] {
+	return func(yield func(bool) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *BoolStream) Seq2() iter.Seq2[bool, error] {
+	return func(yield func(bool, error) bool) {
+		type notification struct {
+			next     bool
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next bool, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroBool, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+// Count returns an IntStream with the count of elements in this stream.
+func (s *BoolStream) Count() *IntStream {
+	count := 0
+	return FromIntObservable(MapBool2IntObserveDirect(s, func(next bool, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			observer.Next(count)
+			observer.Error(err)
+		case complete:
+			observer.Next(count)
+			observer.Complete()
+		default:
+			count++
+		}
+	}))
+}
+
+func equalBool(a, b bool) bool {
+	return a == b
+}
+
+type decideBool struct {
+	parent BoolObservable
+	// decide returns the result and true once the answer is known.
+	decide func(next bool) (bool, bool)
+	// otherwise is the result if the stream completes first.
+	otherwise bool
+}
+
+func (d *decideBool) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	subscription := NewLinkedSubscription()
+	subscription.Link(d.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Next(d.otherwise)
+			observer.Complete()
+		default:
+			if result, ok := d.decide(next); ok {
+				done = true
+				observer.Next(result)
+				observer.Complete()
+				subscription.Dispose()
+			}
+		}
+	})))
+	return subscription
+}
+
+// All emits true if f returns true for every value in the stream, or false
+// as soon as it does not.
+func (s *BoolStream) All(f func(bool) bool) *BoolStream {
+	return FromBoolObservable(&decideBool{s, func(next bool) (bool, bool) { return false, !f(next) }, true})
+}
+
+// Any emits true as soon as f returns true for a value in the stream, or
+// false if it never does.
+func (s *BoolStream) Any(f func(bool) bool) *BoolStream {
+	return FromBoolObservable(&decideBool{s, func(next bool) (bool, bool) { return true, f(next) }, false})
+}
+
+// Contains emits true as soon as value is seen in the stream, or false if it
+// never is.
+func (s *BoolStream) Contains(value bool) *BoolStream {
+	return s.Any(func(next bool) bool { return equalBool(next, value) })
+}
+
+// IsEmpty emits true if the stream completes without a value, or false as
+// soon as it emits one.
+func (s *BoolStream) IsEmpty() *BoolStream {
+	return FromBoolObservable(&decideBool{s, func(bool) (bool, bool) { return false, true }, true})
+}
+
+type sequenceEqualBool struct {
+	parent BoolObservable
+	other  BoolObservable
+}
+
+func (e *sequenceEqualBool) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	// Values seen on one side that the other has not caught up with yet.
+	// Only one side can be ahead at a time.
+	pending := [2][]bool{}
+	completed := [2]bool{}
+	subscriptions := [2]*LinkedSubscription{NewLinkedSubscription(), NewLinkedSubscription()}
+	dispose := func() {
+		subscriptions[0].Dispose()
+		subscriptions[1].Dispose()
+	}
+	finish := func(result bool) {
+		done = true
+		observer.Next(result)
+		observer.Complete()
+		dispose()
+	}
+	observe := func(i int) BoolObserver {
+		j := 1 - i
+		return BoolObserverFunc(func(next bool, err error, complete bool) {
+			lock.Lock()
+			defer lock.Unlock()
+			if done {
+				return
+			}
+			switch {
+			case err != nil:
+				done = true
+				observer.Error(err)
+				dispose()
+			case complete:
+				completed[i] = true
+				if len(pending[j]) > 0 {
+					finish(false)
+				} else if completed[j] {
+					finish(true)
+				}
+			case len(pending[j]) > 0:
+				expected := pending[j][0]
+				pending[j] = pending[j][1:]
+				if !equalBool(next, expected) {
+					finish(false)
+				}
+			case completed[j]:
+				finish(false)
+			default:
+				pending[i] = append(pending[i], next)
+			}
+		})
+	}
+	subscriptions[0].Link(e.parent.Subscribe(observe(0)))
+	subscriptions[1].Link(e.other.Subscribe(observe(1)))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(dispose)
+	return subscription
+}
+
+// SequenceEqual emits true if the stream and other emit equal values in the
+// same order and both complete, or false as soon as they differ.
+func (s *BoolStream) SequenceEqual(other BoolObservable) *BoolStream {
+	return FromBoolObservable(&sequenceEqualBool{s, other})
+}
+
+// BoolNotification is a materialized event from a BoolStream.
+type BoolNotification struct {
+	Kind  NotificationKind
+	Value bool  // Set if Kind is NotificationNext.
+	Err   error // Set if Kind is NotificationError.
+}
+
+// Materialize emits every event of the stream, including termination, as a
+// BoolNotification. The materialized stream completes after the
+// notification for the termination of this stream.
+func (s *BoolStream) Materialize() *BoolNotificationStream {
+	return FromBoolNotificationObservable(MapBool2BoolNotificationObserveDirect(s, func(next bool, err error, complete bool, observer BoolNotificationObserver) {
+		switch {
+		case err != nil:
+			observer.Next(BoolNotification{Kind: NotificationError, Err: err})
+			observer.Complete()
+		case complete:
+			observer.Next(BoolNotification{Kind: NotificationComplete})
+			observer.Complete()
+		default:
+			observer.Next(BoolNotification{Kind: NotificationNext, Value: next})
+		}
+	}))
+}
+
+// Dematerialize converts materialized notifications back into the events they
+// represent. Notifications after the first termination are ignored.
+func (s *BoolNotificationStream) Dematerialize() *BoolStream {
+	return FromBoolObservable(MapBoolNotification2BoolObservable(s, func(BoolObserver) MappingBoolNotification2BoolFunc {
+		terminated := false
+		return func(next BoolNotification, err error, complete bool, observer BoolObserver) {
+			if terminated {
+				return
+			}
+			switch {
+			case err != nil:
+				terminated = true
+				observer.Error(err)
+			case complete:
+				terminated = true
+				observer.Complete()
+			default:
+				switch next.Kind {
+				case NotificationNext:
+					observer.Next(next.Value)
+				case NotificationError:
+					terminated = true
+					observer.Error(next.Err)
+				case NotificationComplete:
+					terminated = true
+					observer.Complete()
+				}
+			}
+		}
+	}))
+}
+
+// TimestampedBool is a value from a BoolStream and the time it was emitted.
+type TimestampedBool struct {
+	Value bool
+	Time  time.Time
+}
+
+// Timestamp annotates each value in the stream with the time it was emitted.
+func (s *BoolStream) Timestamp() *TimestampedBoolStream {
+	return FromTimestampedBoolObservable(MapBool2TimestampedBoolObserveNext(s, func(next bool) TimestampedBool {
+		return TimestampedBool{next, Now()}
+	}))
+}
+
+// IntervalBool is a value from a BoolStream and the time elapsed
+// since the previous value was emitted.
+type IntervalBool struct {
+	Value    bool
+	Interval time.Duration
+}
+
+// TimeInterval annotates each value in the stream with the time elapsed since
+// the previous value, or since subscription for the first value.
+func (s *BoolStream) TimeInterval() *IntervalBoolStream {
+	return FromIntervalBoolObservable(MapBool2IntervalBoolObservable(s, func(IntervalBoolObserver) MappingBool2IntervalBoolFunc {
+		last := Now()
+		return func(next bool, err error, complete bool, observer IntervalBoolObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				now := Now()
+				observer.Next(IntervalBool{next, now.Sub(last)})
+				last = now
+			}
+		}
+	}))
+}
+
+// DecodeBoolJSONLines decodes a bool from each line of r. A line
+// that can not be decoded terminates the stream with an error.
+func DecodeBoolJSONLines(r io.Reader) *BoolStream {
+	return DecodeBoolJSONLinesWithPolicy(r, FailOnDecodeError)
+}
+
+// DecodeBoolJSONLinesWithPolicy decodes a bool from each line of r,
+// passing lines that can not be decoded to policy. Blank lines are ignored.
+func DecodeBoolJSONLinesWithPolicy(r io.Reader, policy DecodeErrorPolicy) *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {
+		reader := bufio.NewReader(r)
+		for {
+			if subscription.Disposed() {
+				return
+			}
+			line, err := reader.ReadBytes('\n')
+			if err != nil && err != io.EOF {
+				observer.Error(err)
+				return
+			}
+			if len(bytes.TrimSpace(line)) > 0 {
+				var value bool
+				if derr := json.Unmarshal(line, &value); derr == nil {
+					observer.Next(value)
+				} else if derr = policy(line, derr); derr != nil {
+					observer.Error(derr)
+					return
+				}
+			}
+			if err == io.EOF {
+				observer.Complete()
+				return
+			}
+		}
+	})
+}
+
+// EncodeJSONLines writes each value in the stream to w as a line of JSON,
+// blocking until the stream completes. The error from the stream or from
+// encoding is returned. An encoding error disposes the stream.
+func (s *BoolStream) EncodeJSONLines(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	errch := make(chan error, 1)
+	failed := false
+	subscription := s.SubscribeFunc(func(next bool, err error, complete bool) {
+		if failed {
+			return
+		}
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			errch <- nil
+		default:
+			if err := encoder.Encode(next); err != nil {
+				failed = true
+				errch <- err
+			}
+		}
+	})
+	err := <-errch
+	subscription.Dispose()
+	return err
+}
+
+type MappingBool2InterfaceFunc func(next bool, err error, complete bool, observer InterfaceObserver)
+type MappingBool2InterfaceFuncFactory func(observer InterfaceObserver) MappingBool2InterfaceFunc
+
+type MappingBool2InterfaceObservable struct {
+	parent BoolObservable
+	mapper MappingBool2InterfaceFuncFactory
+}
+
+func (f *MappingBool2InterfaceObservable) Subscribe(observer InterfaceObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBool2InterfaceObservable(parent BoolObservable, mapper MappingBool2InterfaceFuncFactory) InterfaceObservable {
+	return &MappingBool2InterfaceObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBool2InterfaceObserveDirect(parent BoolObservable, mapper MappingBool2InterfaceFunc) InterfaceObservable {
+	return MapBool2InterfaceObservable(parent, func(InterfaceObserver) MappingBool2InterfaceFunc {
+		return mapper
+	})
+}
+
+func MapBool2InterfaceObserveNext(parent BoolObservable, mapper func(bool) interface{}) InterfaceObservable {
+	return MapBool2InterfaceObservable(parent, func(InterfaceObserver) MappingBool2InterfaceFunc {
+		return func(next bool, err error, complete bool, observer InterfaceObserver) {
+			var mapped interface{}
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInterface(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapBool2Interface struct {
+	parent BoolObservable
+	mapper func(bool) InterfaceObservable
+}
+
+func (f *flatMapBool2Interface) Subscribe(observer InterfaceObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&InterfaceStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &InterfaceStream{ignoreCompletionFilter().Interface(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInterface maps this stream to an InterfaceStream via f.
+func (s *BoolStream) MapInterface(f func(bool) interface{}) *InterfaceStream {
+	return FromInterfaceObservable(MapBool2InterfaceObserveNext(s, f))
+}
+
+func (s *BoolStream) FlatMapInterface(f func(bool) InterfaceObservable) *InterfaceStream {
+	return &InterfaceStream{&flatMapBool2Interface{s, f}}
+}
+
+type MappingBool2EventFunc func(next bool, err error, complete bool, observer EventObserver)
+type MappingBool2EventFuncFactory func(observer EventObserver) MappingBool2EventFunc
+
+type MappingBool2EventObservable struct {
+	parent BoolObservable
+	mapper MappingBool2EventFuncFactory
+}
+
+func (f *MappingBool2EventObservable) Subscribe(observer EventObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBool2EventObservable(parent BoolObservable, mapper MappingBool2EventFuncFactory) EventObservable {
+	return &MappingBool2EventObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBool2EventObserveDirect(parent BoolObservable, mapper MappingBool2EventFunc) EventObservable {
+	return MapBool2EventObservable(parent, func(EventObserver) MappingBool2EventFunc {
+		return mapper
+	})
+}
+
+func MapBool2EventObserveNext(parent BoolObservable, mapper func(bool) Event) EventObservable {
+	return MapBool2EventObservable(parent, func(EventObserver) MappingBool2EventFunc {
+		return func(next bool, err error, complete bool, observer EventObserver) {
+			var mapped Event
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughEvent(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapBool2Event struct {
+	parent BoolObservable
+	mapper func(bool) EventObservable
+}
+
+func (f *flatMapBool2Event) Subscribe(observer EventObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&EventStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &EventStream{ignoreCompletionFilter().Event(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapEvent maps this stream to an EventStream via f.
+func (s *BoolStream) MapEvent(f func(bool) Event) *EventStream {
+	return FromEventObservable(MapBool2EventObserveNext(s, f))
+}
+
+func (s *BoolStream) FlatMapEvent(f func(bool) EventObservable) *EventStream {
+	return &EventStream{&flatMapBool2Event{s, f}}
+}
+
+type MappingBool2IntFunc func(next bool, err error, complete bool, observer IntObserver)
+type MappingBool2IntFuncFactory func(observer IntObserver) MappingBool2IntFunc
+
+type MappingBool2IntObservable struct {
+	parent BoolObservable
+	mapper MappingBool2IntFuncFactory
+}
+
+func (f *MappingBool2IntObservable) Subscribe(observer IntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBool2IntObservable(parent BoolObservable, mapper MappingBool2IntFuncFactory) IntObservable {
+	return &MappingBool2IntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBool2IntObserveDirect(parent BoolObservable, mapper MappingBool2IntFunc) IntObservable {
+	return MapBool2IntObservable(parent, func(IntObserver) MappingBool2IntFunc {
+		return mapper
+	})
+}
+
+func MapBool2IntObserveNext(parent BoolObservable, mapper func(bool) int) IntObservable {
+	return MapBool2IntObservable(parent, func(IntObserver) MappingBool2IntFunc {
+		return func(next bool, err error, complete bool, observer IntObserver) {
+			var mapped int
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapBool2Int struct {
+	parent BoolObservable
+	mapper func(bool) IntObservable
+}
+
+func (f *flatMapBool2Int) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&IntStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &IntStream{ignoreCompletionFilter().Int(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *BoolStream) MapInt(f func(bool) int) *IntStream {
+	return FromIntObservable(MapBool2IntObserveNext(s, f))
+}
+
+func (s *BoolStream) FlatMapInt(f func(bool) IntObservable) *IntStream {
+	return &IntStream{&flatMapBool2Int{s, f}}
+}
+
+type MappingBool2BoolFunc func(next bool, err error, complete bool, observer BoolObserver)
+type MappingBool2BoolFuncFactory func(observer BoolObserver) MappingBool2BoolFunc
+
+type MappingBool2BoolObservable struct {
+	parent BoolObservable
+	mapper MappingBool2BoolFuncFactory
+}
+
+func (f *MappingBool2BoolObservable) Subscribe(observer BoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBool2BoolObservable(parent BoolObservable, mapper MappingBool2BoolFuncFactory) BoolObservable {
+	return &MappingBool2BoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBool2BoolObserveDirect(parent BoolObservable, mapper MappingBool2BoolFunc) BoolObservable {
+	return MapBool2BoolObservable(parent, func(BoolObserver) MappingBool2BoolFunc {
+		return mapper
+	})
+}
+
+func MapBool2BoolObserveNext(parent BoolObservable, mapper func(bool) bool) BoolObservable {
+	return MapBool2BoolObservable(parent, func(BoolObserver) MappingBool2BoolFunc {
+		return func(next bool, err error, complete bool, observer BoolObserver) {
+			var mapped bool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapBool2Bool struct {
+	parent BoolObservable
+	mapper func(bool) BoolObservable
+}
+
+func (f *flatMapBool2Bool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&BoolStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &BoolStream{ignoreCompletionFilter().Bool(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// Map maps values in this stream to another value.
+func (s *BoolStream) Map(f func(bool) bool) *BoolStream {
+	return FromBoolObservable(MapBool2BoolObserveNext(s, f))
+}
+
+func (s *BoolStream) FlatMap(f func(bool) BoolObservable) *BoolStream {
+	return &BoolStream{&flatMapBool2Bool{s, f}}
+}
+
+type MappingBool2BoolNotificationFunc func(next bool, err error, complete bool, observer BoolNotificationObserver)
+type MappingBool2BoolNotificationFuncFactory func(observer BoolNotificationObserver) MappingBool2BoolNotificationFunc
+
+type MappingBool2BoolNotificationObservable struct {
+	parent BoolObservable
+	mapper MappingBool2BoolNotificationFuncFactory
+}
+
+func (f *MappingBool2BoolNotificationObservable) Subscribe(observer BoolNotificationObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBool2BoolNotificationObservable(parent BoolObservable, mapper MappingBool2BoolNotificationFuncFactory) BoolNotificationObservable {
+	return &MappingBool2BoolNotificationObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBool2BoolNotificationObserveDirect(parent BoolObservable, mapper MappingBool2BoolNotificationFunc) BoolNotificationObservable {
+	return MapBool2BoolNotificationObservable(parent, func(BoolNotificationObserver) MappingBool2BoolNotificationFunc {
+		return mapper
+	})
+}
+
+func MapBool2BoolNotificationObserveNext(parent BoolObservable, mapper func(bool) BoolNotification) BoolNotificationObservable {
+	return MapBool2BoolNotificationObservable(parent, func(BoolNotificationObserver) MappingBool2BoolNotificationFunc {
+		return func(next bool, err error, complete bool, observer BoolNotificationObserver) {
+			var mapped BoolNotification
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBoolNotification(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapBoolNotification maps this stream to an BoolNotificationStream via f.
+func (s *BoolStream) MapBoolNotification(f func(bool) BoolNotification) *BoolNotificationStream {
+	return FromBoolNotificationObservable(MapBool2BoolNotificationObserveNext(s, f))
+}
+
+type MappingBool2TimestampedBoolFunc func(next bool, err error, complete bool, observer TimestampedBoolObserver)
+type MappingBool2TimestampedBoolFuncFactory func(observer TimestampedBoolObserver) MappingBool2TimestampedBoolFunc
+
+type MappingBool2TimestampedBoolObservable struct {
+	parent BoolObservable
+	mapper MappingBool2TimestampedBoolFuncFactory
+}
+
+func (f *MappingBool2TimestampedBoolObservable) Subscribe(observer TimestampedBoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBool2TimestampedBoolObservable(parent BoolObservable, mapper MappingBool2TimestampedBoolFuncFactory) TimestampedBoolObservable {
+	return &MappingBool2TimestampedBoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBool2TimestampedBoolObserveDirect(parent BoolObservable, mapper MappingBool2TimestampedBoolFunc) TimestampedBoolObservable {
+	return MapBool2TimestampedBoolObservable(parent, func(TimestampedBoolObserver) MappingBool2TimestampedBoolFunc {
+		return mapper
+	})
+}
+
+func MapBool2TimestampedBoolObserveNext(parent BoolObservable, mapper func(bool) TimestampedBool) TimestampedBoolObservable {
+	return MapBool2TimestampedBoolObservable(parent, func(TimestampedBoolObserver) MappingBool2TimestampedBoolFunc {
+		return func(next bool, err error, complete bool, observer TimestampedBoolObserver) {
+			var mapped TimestampedBool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughTimestampedBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapTimestampedBool maps this stream to an TimestampedBoolStream via f.
+func (s *BoolStream) MapTimestampedBool(f func(bool) TimestampedBool) *TimestampedBoolStream {
+	return FromTimestampedBoolObservable(MapBool2TimestampedBoolObserveNext(s, f))
+}
+
+type MappingBool2IntervalBoolFunc func(next bool, err error, complete bool, observer IntervalBoolObserver)
+type MappingBool2IntervalBoolFuncFactory func(observer IntervalBoolObserver) MappingBool2IntervalBoolFunc
+
+type MappingBool2IntervalBoolObservable struct {
+	parent BoolObservable
+	mapper MappingBool2IntervalBoolFuncFactory
+}
+
+func (f *MappingBool2IntervalBoolObservable) Subscribe(observer IntervalBoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBool2IntervalBoolObservable(parent BoolObservable, mapper MappingBool2IntervalBoolFuncFactory) IntervalBoolObservable {
+	return &MappingBool2IntervalBoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBool2IntervalBoolObserveDirect(parent BoolObservable, mapper MappingBool2IntervalBoolFunc) IntervalBoolObservable {
+	return MapBool2IntervalBoolObservable(parent, func(IntervalBoolObserver) MappingBool2IntervalBoolFunc {
+		return mapper
+	})
+}
+
+func MapBool2IntervalBoolObserveNext(parent BoolObservable, mapper func(bool) IntervalBool) IntervalBoolObservable {
+	return MapBool2IntervalBoolObservable(parent, func(IntervalBoolObserver) MappingBool2IntervalBoolFunc {
+		return func(next bool, err error, complete bool, observer IntervalBoolObserver) {
+			var mapped IntervalBool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntervalBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapIntervalBool maps this stream to an IntervalBoolStream via f.
+func (s *BoolStream) MapIntervalBool(f func(bool) IntervalBool) *IntervalBoolStream {
+	return FromIntervalBoolObservable(MapBool2IntervalBoolObserveNext(s, f))
+}
+
+type BoolNotificationObserver interface {
+	Next(BoolNotification)
+	TerminationObserver
+}
+
+// A BoolNotificationSubscriber represents a subscribed BoolNotificationObserver.
+type BoolNotificationSubscriber interface {
+	Subscription
+	BoolNotificationObserver
+}
+
+type implBoolNotificationSubscriber struct {
+	Subscription
+	BoolNotificationObserver
+}
+
+func BoolNotificationObserverAsGenericObserver(observer BoolNotificationObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(BoolNotification))
+		}
+	})
+}
+
+func GenericObserverAsBoolNotificationObserver(observer GenericObserver) BoolNotificationObserver {
+	return BoolNotificationObserverFunc(func(next BoolNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type BoolNotificationObservableFactory func(observer BoolNotificationObserver, subscription Subscription)
+
+func (f BoolNotificationObservableFactory) Subscribe(observer BoolNotificationObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateBoolNotification calls f(observer, subscription) to produce values for a stream.
+func CreateBoolNotification(f func(observer BoolNotificationObserver, subscription Subscription)) *BoolNotificationStream {
+	return FromBoolNotificationObservable(BoolNotificationObservableFactory(f))
+}
+func PassthroughBoolNotification(next BoolNotification, err error, complete bool, observer BoolNotificationObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroBoolNotification = *new(BoolNotification)
+
+type BoolNotificationObserverFunc func(BoolNotification, error, bool)
+
+func (f BoolNotificationObserverFunc) Next(next BoolNotification) { f(next, nil, false) }
+func (f BoolNotificationObserverFunc) Error(err error)            { f(zeroBoolNotification, err, false) }
+func (f BoolNotificationObserverFunc) Complete()                  { f(zeroBoolNotification, nil, true) }
+
+type BoolNotificationObservable interface {
+	Subscribe(BoolNotificationObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a BoolNotificationObservable
+func (f GenericObservableFilterFactory) BoolNotification(parent BoolNotificationObservable) BoolNotificationObservable {
+	return MapBoolNotification2BoolNotificationObservable(parent, func(observer BoolNotificationObserver) MappingBoolNotification2BoolNotificationFunc {
+		gobserver := BoolNotificationObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next BoolNotification, err error, complete bool, observer BoolNotificationObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromBoolNotificationArray(array []BoolNotification) *BoolNotificationStream {
+	return CreateBoolNotification(func(observer BoolNotificationObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromBoolNotifications(array ...BoolNotification) *BoolNotificationStream {
+	return FromBoolNotificationArray(array)
+}
+
+func FromBoolNotificationChannel(ch <-chan BoolNotification) *BoolNotificationStream {
+	return CreateBoolNotification(func(observer BoolNotificationObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type BoolNotificationStream struct {
+	BoolNotificationObservable
+}
+
+func FromBoolNotificationObservable(observable BoolNotificationObservable) *BoolNotificationStream {
+	return &BoolNotificationStream{observable}
+}
+
+func (s *BoolNotificationStream) SubscribeFunc(f func(BoolNotification, error, bool)) Subscription {
+	return s.Subscribe(BoolNotificationObserverFunc(f))
+}
+
+func (s *BoolNotificationStream) SubscribeNext(f func(v BoolNotification)) Subscription {
+	return s.SubscribeFunc(func(next BoolNotification, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *BoolNotificationStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsBoolNotificationObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *BoolNotificationStream) Filter(f func(BoolNotification) bool) *BoolNotificationStream {
+	return FromBoolNotificationObservable(filterFilter(func(v interface{}) bool { return f(v.(BoolNotification)) }).BoolNotification(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *BoolNotificationStream) Do(f func(next BoolNotification)) *BoolNotificationStream {
+	return FromBoolNotificationObservable(MapBoolNotification2BoolNotificationObserveNext(s, func(next BoolNotification) BoolNotification {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *BoolNotificationStream) DoOnError(f func(err error)) *BoolNotificationStream {
+	return FromBoolNotificationObservable(MapBoolNotification2BoolNotificationObserveDirect(s, func(next BoolNotification, err error, complete bool, observer BoolNotificationObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughBoolNotification(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *BoolNotificationStream) DoOnComplete(f func()) *BoolNotificationStream {
+	return FromBoolNotificationObservable(MapBoolNotification2BoolNotificationObserveDirect(s, func(next BoolNotification, err error, complete bool, observer BoolNotificationObserver) {
+		if complete {
+			f()
+		}
+		PassthroughBoolNotification(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *BoolNotificationStream) ToOneWithError() (BoolNotification, error) {
+	valuech := make(chan BoolNotification, 1)
+	errch := make(chan error, 1)
+	FromBoolNotificationObservable(oneFilter().BoolNotification(s)).SubscribeFunc(func(next BoolNotification, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroBoolNotification, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *BoolNotificationStream) ToOne() BoolNotification {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *BoolNotificationStream) ToArrayWithError() ([]BoolNotification, error) {
+	array := []BoolNotification{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next BoolNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *BoolNotificationStream) ToArray() []BoolNotification {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *BoolNotificationStream) ToChannelWithError() (<-chan BoolNotification, <-chan error) {
+	ch := make(chan BoolNotification, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next BoolNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *BoolNotificationStream) ToChannel() <-chan BoolNotification {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *BoolNotificationStream) Seq() iter.Seq[BoolNotification] {
+	return func(yield func(BoolNotification) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *BoolNotificationStream) Seq2() iter.Seq2[BoolNotification, error] {
+	return func(yield func(BoolNotification, error) bool) {
+		type notification struct {
+			next     BoolNotification
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next BoolNotification, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroBoolNotification, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapBoolNotification2Interface struct {
+	parent BoolNotificationObservable
+	f      func(BoolNotification) interface{}
+}
+
+func (m *mapBoolNotification2Interface) Subscribe(observer InterfaceObserver) Subscription {
+	return m.parent.Subscribe(BoolNotificationObserverFunc(func(next BoolNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInterface maps this stream to an InterfaceStream via f.
+func (s *BoolNotificationStream) MapInterface(f func(BoolNotification) interface{}) *InterfaceStream {
+	return &InterfaceStream{&mapBoolNotification2Interface{s, f}}
+}
+
+type mapBoolNotification2Event struct {
+	parent BoolNotificationObservable
+	f      func(BoolNotification) Event
+}
+
+func (m *mapBoolNotification2Event) Subscribe(observer EventObserver) Subscription {
+	return m.parent.Subscribe(BoolNotificationObserverFunc(func(next BoolNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapEvent maps this stream to an EventStream via f.
+func (s *BoolNotificationStream) MapEvent(f func(BoolNotification) Event) *EventStream {
+	return &EventStream{&mapBoolNotification2Event{s, f}}
+}
+
+type mapBoolNotification2Int struct {
+	parent BoolNotificationObservable
+	f      func(BoolNotification) int
+}
+
+func (m *mapBoolNotification2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(BoolNotificationObserverFunc(func(next BoolNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *BoolNotificationStream) MapInt(f func(BoolNotification) int) *IntStream {
+	return &IntStream{&mapBoolNotification2Int{s, f}}
+}
+
+type MappingBoolNotification2BoolNotificationFunc func(next BoolNotification, err error, complete bool, observer BoolNotificationObserver)
+type MappingBoolNotification2BoolNotificationFuncFactory func(observer BoolNotificationObserver) MappingBoolNotification2BoolNotificationFunc
+
+type MappingBoolNotification2BoolNotificationObservable struct {
+	parent BoolNotificationObservable
+	mapper MappingBoolNotification2BoolNotificationFuncFactory
+}
+
+func (f *MappingBoolNotification2BoolNotificationObservable) Subscribe(observer BoolNotificationObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolNotificationObserverFunc(func(next BoolNotification, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBoolNotification2BoolNotificationObservable(parent BoolNotificationObservable, mapper MappingBoolNotification2BoolNotificationFuncFactory) BoolNotificationObservable {
+	return &MappingBoolNotification2BoolNotificationObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBoolNotification2BoolNotificationObserveDirect(parent BoolNotificationObservable, mapper MappingBoolNotification2BoolNotificationFunc) BoolNotificationObservable {
+	return MapBoolNotification2BoolNotificationObservable(parent, func(BoolNotificationObserver) MappingBoolNotification2BoolNotificationFunc {
+		return mapper
+	})
+}
+
+func MapBoolNotification2BoolNotificationObserveNext(parent BoolNotificationObservable, mapper func(BoolNotification) BoolNotification) BoolNotificationObservable {
+	return MapBoolNotification2BoolNotificationObservable(parent, func(BoolNotificationObserver) MappingBoolNotification2BoolNotificationFunc {
+		return func(next BoolNotification, err error, complete bool, observer BoolNotificationObserver) {
+			var mapped BoolNotification
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBoolNotification(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *BoolNotificationStream) Map(f func(BoolNotification) BoolNotification) *BoolNotificationStream {
+	return FromBoolNotificationObservable(MapBoolNotification2BoolNotificationObserveNext(s, f))
+}
+
+type MappingBoolNotification2BoolFunc func(next BoolNotification, err error, complete bool, observer BoolObserver)
+type MappingBoolNotification2BoolFuncFactory func(observer BoolObserver) MappingBoolNotification2BoolFunc
+
+type MappingBoolNotification2BoolObservable struct {
+	parent BoolNotificationObservable
+	mapper MappingBoolNotification2BoolFuncFactory
+}
+
+func (f *MappingBoolNotification2BoolObservable) Subscribe(observer BoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolNotificationObserverFunc(func(next BoolNotification, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBoolNotification2BoolObservable(parent BoolNotificationObservable, mapper MappingBoolNotification2BoolFuncFactory) BoolObservable {
+	return &MappingBoolNotification2BoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBoolNotification2BoolObserveDirect(parent BoolNotificationObservable, mapper MappingBoolNotification2BoolFunc) BoolObservable {
+	return MapBoolNotification2BoolObservable(parent, func(BoolObserver) MappingBoolNotification2BoolFunc {
+		return mapper
+	})
+}
+
+func MapBoolNotification2BoolObserveNext(parent BoolNotificationObservable, mapper func(BoolNotification) bool) BoolObservable {
+	return MapBoolNotification2BoolObservable(parent, func(BoolObserver) MappingBoolNotification2BoolFunc {
+		return func(next BoolNotification, err error, complete bool, observer BoolObserver) {
+			var mapped bool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapBoolNotification2Bool struct {
+	parent BoolNotificationObservable
+	mapper func(BoolNotification) BoolObservable
+}
+
+func (f *flatMapBoolNotification2Bool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(BoolNotificationObserverFunc(func(next BoolNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&BoolStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &BoolStream{ignoreCompletionFilter().Bool(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *BoolNotificationStream) MapBool(f func(BoolNotification) bool) *BoolStream {
+	return FromBoolObservable(MapBoolNotification2BoolObserveNext(s, f))
+}
+
+func (s *BoolNotificationStream) FlatMapBool(f func(BoolNotification) BoolObservable) *BoolStream {
+	return &BoolStream{&flatMapBoolNotification2Bool{s, f}}
+}
+
+type TimestampedBoolObserver interface {
+	Next(TimestampedBool)
+	TerminationObserver
+}
+
+// A TimestampedBoolSubscriber represents a subscribed TimestampedBoolObserver.
+type TimestampedBoolSubscriber interface {
+	Subscription
+	TimestampedBoolObserver
+}
+
+type implTimestampedBoolSubscriber struct {
+	Subscription
+	TimestampedBoolObserver
+}
+
+func TimestampedBoolObserverAsGenericObserver(observer TimestampedBoolObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(TimestampedBool))
+		}
+	})
+}
+
+func GenericObserverAsTimestampedBoolObserver(observer GenericObserver) TimestampedBoolObserver {
+	return TimestampedBoolObserverFunc(func(next TimestampedBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type TimestampedBoolObservableFactory func(observer TimestampedBoolObserver, subscription Subscription)
+
+func (f TimestampedBoolObservableFactory) Subscribe(observer TimestampedBoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateTimestampedBool calls f(observer, subscription) to produce values for a stream.
+func CreateTimestampedBool(f func(observer TimestampedBoolObserver, subscription Subscription)) *TimestampedBoolStream {
+	return FromTimestampedBoolObservable(TimestampedBoolObservableFactory(f))
+}
+func PassthroughTimestampedBool(next TimestampedBool, err error, complete bool, observer TimestampedBoolObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroTimestampedBool = *new(TimestampedBool)
+
+type TimestampedBoolObserverFunc func(TimestampedBool, error, bool)
+
+func (f TimestampedBoolObserverFunc) Next(next TimestampedBool) { f(next, nil, false) }
+func (f TimestampedBoolObserverFunc) Error(err error)           { f(zeroTimestampedBool, err, false) }
+func (f TimestampedBoolObserverFunc) Complete()                 { f(zeroTimestampedBool, nil, true) }
+
+type TimestampedBoolObservable interface {
+	Subscribe(TimestampedBoolObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a TimestampedBoolObservable
+func (f GenericObservableFilterFactory) TimestampedBool(parent TimestampedBoolObservable) TimestampedBoolObservable {
+	return MapTimestampedBool2TimestampedBoolObservable(parent, func(observer TimestampedBoolObserver) MappingTimestampedBool2TimestampedBoolFunc {
+		gobserver := TimestampedBoolObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next TimestampedBool, err error, complete bool, observer TimestampedBoolObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromTimestampedBoolArray(array []TimestampedBool) *TimestampedBoolStream {
+	return CreateTimestampedBool(func(observer TimestampedBoolObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromTimestampedBools(array ...TimestampedBool) *TimestampedBoolStream {
+	return FromTimestampedBoolArray(array)
+}
+
+func FromTimestampedBoolChannel(ch <-chan TimestampedBool) *TimestampedBoolStream {
+	return CreateTimestampedBool(func(observer TimestampedBoolObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type TimestampedBoolStream struct {
+	TimestampedBoolObservable
+}
+
+func FromTimestampedBoolObservable(observable TimestampedBoolObservable) *TimestampedBoolStream {
+	return &TimestampedBoolStream{observable}
+}
+
+func (s *TimestampedBoolStream) SubscribeFunc(f func(TimestampedBool, error, bool)) Subscription {
+	return s.Subscribe(TimestampedBoolObserverFunc(f))
+}
+
+func (s *TimestampedBoolStream) SubscribeNext(f func(v TimestampedBool)) Subscription {
+	return s.SubscribeFunc(func(next TimestampedBool, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *TimestampedBoolStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsTimestampedBoolObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *TimestampedBoolStream) Filter(f func(TimestampedBool) bool) *TimestampedBoolStream {
+	return FromTimestampedBoolObservable(filterFilter(func(v interface{}) bool { return f(v.(TimestampedBool)) }).TimestampedBool(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *TimestampedBoolStream) Do(f func(next TimestampedBool)) *TimestampedBoolStream {
+	return FromTimestampedBoolObservable(MapTimestampedBool2TimestampedBoolObserveNext(s, func(next TimestampedBool) TimestampedBool {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *TimestampedBoolStream) DoOnError(f func(err error)) *TimestampedBoolStream {
+	return FromTimestampedBoolObservable(MapTimestampedBool2TimestampedBoolObserveDirect(s, func(next TimestampedBool, err error, complete bool, observer TimestampedBoolObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughTimestampedBool(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *TimestampedBoolStream) DoOnComplete(f func()) *TimestampedBoolStream {
+	return FromTimestampedBoolObservable(MapTimestampedBool2TimestampedBoolObserveDirect(s, func(next TimestampedBool, err error, complete bool, observer TimestampedBoolObserver) {
+		if complete {
+			f()
+		}
+		PassthroughTimestampedBool(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *TimestampedBoolStream) ToOneWithError() (TimestampedBool, error) {
+	valuech := make(chan TimestampedBool, 1)
+	errch := make(chan error, 1)
+	FromTimestampedBoolObservable(oneFilter().TimestampedBool(s)).SubscribeFunc(func(next TimestampedBool, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroTimestampedBool, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *TimestampedBoolStream) ToOne() TimestampedBool {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *TimestampedBoolStream) ToArrayWithError() ([]TimestampedBool, error) {
+	array := []TimestampedBool{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next TimestampedBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *TimestampedBoolStream) ToArray() []TimestampedBool {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *TimestampedBoolStream) ToChannelWithError() (<-chan TimestampedBool, <-chan error) {
+	ch := make(chan TimestampedBool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next TimestampedBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *TimestampedBoolStream) ToChannel() <-chan TimestampedBool {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *TimestampedBoolStream) Seq() iter.Seq[TimestampedBool] {
+	return func(yield func(TimestampedBool) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *TimestampedBoolStream) Seq2() iter.Seq2[TimestampedBool, error] {
+	return func(yield func(TimestampedBool, error) bool) {
+		type notification struct {
+			next     TimestampedBool
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next TimestampedBool, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroTimestampedBool, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapTimestampedBool2Interface struct {
+	parent TimestampedBoolObservable
+	f      func(TimestampedBool) interface{}
+}
+
+func (m *mapTimestampedBool2Interface) Subscribe(observer InterfaceObserver) Subscription {
+	return m.parent.Subscribe(TimestampedBoolObserverFunc(func(next TimestampedBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInterface maps this stream to an InterfaceStream via f.
+func (s *TimestampedBoolStream) MapInterface(f func(TimestampedBool) interface{}) *InterfaceStream {
+	return &InterfaceStream{&mapTimestampedBool2Interface{s, f}}
+}
+
+type mapTimestampedBool2Event struct {
+	parent TimestampedBoolObservable
+	f      func(TimestampedBool) Event
+}
+
+func (m *mapTimestampedBool2Event) Subscribe(observer EventObserver) Subscription {
+	return m.parent.Subscribe(TimestampedBoolObserverFunc(func(next TimestampedBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapEvent maps this stream to an EventStream via f.
+func (s *TimestampedBoolStream) MapEvent(f func(TimestampedBool) Event) *EventStream {
+	return &EventStream{&mapTimestampedBool2Event{s, f}}
+}
+
+type mapTimestampedBool2Int struct {
+	parent TimestampedBoolObservable
+	f      func(TimestampedBool) int
+}
+
+func (m *mapTimestampedBool2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(TimestampedBoolObserverFunc(func(next TimestampedBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *TimestampedBoolStream) MapInt(f func(TimestampedBool) int) *IntStream {
+	return &IntStream{&mapTimestampedBool2Int{s, f}}
+}
+
+type MappingTimestampedBool2TimestampedBoolFunc func(next TimestampedBool, err error, complete bool, observer TimestampedBoolObserver)
+type MappingTimestampedBool2TimestampedBoolFuncFactory func(observer TimestampedBoolObserver) MappingTimestampedBool2TimestampedBoolFunc
+
+type MappingTimestampedBool2TimestampedBoolObservable struct {
+	parent TimestampedBoolObservable
+	mapper MappingTimestampedBool2TimestampedBoolFuncFactory
+}
+
+func (f *MappingTimestampedBool2TimestampedBoolObservable) Subscribe(observer TimestampedBoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(TimestampedBoolObserverFunc(func(next TimestampedBool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapTimestampedBool2TimestampedBoolObservable(parent TimestampedBoolObservable, mapper MappingTimestampedBool2TimestampedBoolFuncFactory) TimestampedBoolObservable {
+	return &MappingTimestampedBool2TimestampedBoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapTimestampedBool2TimestampedBoolObserveDirect(parent TimestampedBoolObservable, mapper MappingTimestampedBool2TimestampedBoolFunc) TimestampedBoolObservable {
+	return MapTimestampedBool2TimestampedBoolObservable(parent, func(TimestampedBoolObserver) MappingTimestampedBool2TimestampedBoolFunc {
+		return mapper
+	})
+}
+
+func MapTimestampedBool2TimestampedBoolObserveNext(parent TimestampedBoolObservable, mapper func(TimestampedBool) TimestampedBool) TimestampedBoolObservable {
+	return MapTimestampedBool2TimestampedBoolObservable(parent, func(TimestampedBoolObserver) MappingTimestampedBool2TimestampedBoolFunc {
+		return func(next TimestampedBool, err error, complete bool, observer TimestampedBoolObserver) {
+			var mapped TimestampedBool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughTimestampedBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *TimestampedBoolStream) Map(f func(TimestampedBool) TimestampedBool) *TimestampedBoolStream {
+	return FromTimestampedBoolObservable(MapTimestampedBool2TimestampedBoolObserveNext(s, f))
+}
+
+type MappingTimestampedBool2BoolFunc func(next TimestampedBool, err error, complete bool, observer BoolObserver)
+type MappingTimestampedBool2BoolFuncFactory func(observer BoolObserver) MappingTimestampedBool2BoolFunc
+
+type MappingTimestampedBool2BoolObservable struct {
+	parent TimestampedBoolObservable
+	mapper MappingTimestampedBool2BoolFuncFactory
+}
+
+func (f *MappingTimestampedBool2BoolObservable) Subscribe(observer BoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(TimestampedBoolObserverFunc(func(next TimestampedBool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapTimestampedBool2BoolObservable(parent TimestampedBoolObservable, mapper MappingTimestampedBool2BoolFuncFactory) BoolObservable {
+	return &MappingTimestampedBool2BoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapTimestampedBool2BoolObserveDirect(parent TimestampedBoolObservable, mapper MappingTimestampedBool2BoolFunc) BoolObservable {
+	return MapTimestampedBool2BoolObservable(parent, func(BoolObserver) MappingTimestampedBool2BoolFunc {
+		return mapper
+	})
+}
+
+func MapTimestampedBool2BoolObserveNext(parent TimestampedBoolObservable, mapper func(TimestampedBool) bool) BoolObservable {
+	return MapTimestampedBool2BoolObservable(parent, func(BoolObserver) MappingTimestampedBool2BoolFunc {
+		return func(next TimestampedBool, err error, complete bool, observer BoolObserver) {
+			var mapped bool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapTimestampedBool2Bool struct {
+	parent TimestampedBoolObservable
+	mapper func(TimestampedBool) BoolObservable
+}
+
+func (f *flatMapTimestampedBool2Bool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(TimestampedBoolObserverFunc(func(next TimestampedBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&BoolStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &BoolStream{ignoreCompletionFilter().Bool(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *TimestampedBoolStream) MapBool(f func(TimestampedBool) bool) *BoolStream {
+	return FromBoolObservable(MapTimestampedBool2BoolObserveNext(s, f))
+}
+
+func (s *TimestampedBoolStream) FlatMapBool(f func(TimestampedBool) BoolObservable) *BoolStream {
+	return &BoolStream{&flatMapTimestampedBool2Bool{s, f}}
+}
+
+type IntervalBoolObserver interface {
+	Next(IntervalBool)
+	TerminationObserver
+}
+
+// A IntervalBoolSubscriber represents a subscribed IntervalBoolObserver.
+type IntervalBoolSubscriber interface {
+	Subscription
+	IntervalBoolObserver
+}
+
+type implIntervalBoolSubscriber struct {
+	Subscription
+	IntervalBoolObserver
+}
+
+func IntervalBoolObserverAsGenericObserver(observer IntervalBoolObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(IntervalBool))
+		}
+	})
+}
+
+func GenericObserverAsIntervalBoolObserver(observer GenericObserver) IntervalBoolObserver {
+	return IntervalBoolObserverFunc(func(next IntervalBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type IntervalBoolObservableFactory func(observer IntervalBoolObserver, subscription Subscription)
+
+func (f IntervalBoolObservableFactory) Subscribe(observer IntervalBoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateIntervalBool calls f(observer, subscription) to produce values for a stream.
+func CreateIntervalBool(f func(observer IntervalBoolObserver, subscription Subscription)) *IntervalBoolStream {
+	return FromIntervalBoolObservable(IntervalBoolObservableFactory(f))
+}
+func PassthroughIntervalBool(next IntervalBool, err error, complete bool, observer IntervalBoolObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroIntervalBool = *new(IntervalBool)
+
+type IntervalBoolObserverFunc func(IntervalBool, error, bool)
+
+func (f IntervalBoolObserverFunc) Next(next IntervalBool) { f(next, nil, false) }
+func (f IntervalBoolObserverFunc) Error(err error)        { f(zeroIntervalBool, err, false) }
+func (f IntervalBoolObserverFunc) Complete()              { f(zeroIntervalBool, nil, true) }
+
+type IntervalBoolObservable interface {
+	Subscribe(IntervalBoolObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a IntervalBoolObservable
+func (f GenericObservableFilterFactory) IntervalBool(parent IntervalBoolObservable) IntervalBoolObservable {
+	return MapIntervalBool2IntervalBoolObservable(parent, func(observer IntervalBoolObserver) MappingIntervalBool2IntervalBoolFunc {
+		gobserver := IntervalBoolObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next IntervalBool, err error, complete bool, observer IntervalBoolObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromIntervalBoolArray(array []IntervalBool) *IntervalBoolStream {
+	return CreateIntervalBool(func(observer IntervalBoolObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromIntervalBools(array ...IntervalBool) *IntervalBoolStream {
+	return FromIntervalBoolArray(array)
+}
+
+func FromIntervalBoolChannel(ch <-chan IntervalBool) *IntervalBoolStream {
+	return CreateIntervalBool(func(observer IntervalBoolObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type IntervalBoolStream struct {
+	IntervalBoolObservable
+}
+
+func FromIntervalBoolObservable(observable IntervalBoolObservable) *IntervalBoolStream {
+	return &IntervalBoolStream{observable}
+}
+
+func (s *IntervalBoolStream) SubscribeFunc(f func(IntervalBool, error, bool)) Subscription {
+	return s.Subscribe(IntervalBoolObserverFunc(f))
+}
+
+func (s *IntervalBoolStream) SubscribeNext(f func(v IntervalBool)) Subscription {
+	return s.SubscribeFunc(func(next IntervalBool, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *IntervalBoolStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsIntervalBoolObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *IntervalBoolStream) Filter(f func(IntervalBool) bool) *IntervalBoolStream {
+	return FromIntervalBoolObservable(filterFilter(func(v interface{}) bool { return f(v.(IntervalBool)) }).IntervalBool(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *IntervalBoolStream) Do(f func(next IntervalBool)) *IntervalBoolStream {
+	return FromIntervalBoolObservable(MapIntervalBool2IntervalBoolObserveNext(s, func(next IntervalBool) IntervalBool {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *IntervalBoolStream) DoOnError(f func(err error)) *IntervalBoolStream {
+	return FromIntervalBoolObservable(MapIntervalBool2IntervalBoolObserveDirect(s, func(next IntervalBool, err error, complete bool, observer IntervalBoolObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughIntervalBool(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *IntervalBoolStream) DoOnComplete(f func()) *IntervalBoolStream {
+	return FromIntervalBoolObservable(MapIntervalBool2IntervalBoolObserveDirect(s, func(next IntervalBool, err error, complete bool, observer IntervalBoolObserver) {
+		if complete {
+			f()
+		}
+		PassthroughIntervalBool(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *IntervalBoolStream) ToOneWithError() (IntervalBool, error) {
+	valuech := make(chan IntervalBool, 1)
+	errch := make(chan error, 1)
+	FromIntervalBoolObservable(oneFilter().IntervalBool(s)).SubscribeFunc(func(next IntervalBool, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroIntervalBool, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *IntervalBoolStream) ToOne() IntervalBool {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *IntervalBoolStream) ToArrayWithError() ([]IntervalBool, error) {
+	array := []IntervalBool{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntervalBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *IntervalBoolStream) ToArray() []IntervalBool {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *IntervalBoolStream) ToChannelWithError() (<-chan IntervalBool, <-chan error) {
+	ch := make(chan IntervalBool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntervalBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *IntervalBoolStream) ToChannel() <-chan IntervalBool {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *IntervalBoolStream) Seq() iter.Seq[IntervalBool] {
+	return func(yield func(IntervalBool) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *IntervalBoolStream) Seq2() iter.Seq2[IntervalBool, error] {
+	return func(yield func(IntervalBool, error) bool) {
+		type notification struct {
+			next     IntervalBool
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next IntervalBool, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroIntervalBool, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapIntervalBool2Interface struct {
+	parent IntervalBoolObservable
+	f      func(IntervalBool) interface{}
+}
+
+func (m *mapIntervalBool2Interface) Subscribe(observer InterfaceObserver) Subscription {
+	return m.parent.Subscribe(IntervalBoolObserverFunc(func(next IntervalBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInterface maps this stream to an InterfaceStream via f.
+func (s *IntervalBoolStream) MapInterface(f func(IntervalBool) interface{}) *InterfaceStream {
+	return &InterfaceStream{&mapIntervalBool2Interface{s, f}}
+}
+
+type mapIntervalBool2Event struct {
+	parent IntervalBoolObservable
+	f      func(IntervalBool) Event
+}
+
+func (m *mapIntervalBool2Event) Subscribe(observer EventObserver) Subscription {
+	return m.parent.Subscribe(IntervalBoolObserverFunc(func(next IntervalBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapEvent maps this stream to an EventStream via f.
+func (s *IntervalBoolStream) MapEvent(f func(IntervalBool) Event) *EventStream {
+	return &EventStream{&mapIntervalBool2Event{s, f}}
+}
+
+type mapIntervalBool2Int struct {
+	parent IntervalBoolObservable
+	f      func(IntervalBool) int
+}
+
+func (m *mapIntervalBool2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(IntervalBoolObserverFunc(func(next IntervalBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *IntervalBoolStream) MapInt(f func(IntervalBool) int) *IntStream {
+	return &IntStream{&mapIntervalBool2Int{s, f}}
+}
+
+type MappingIntervalBool2IntervalBoolFunc func(next IntervalBool, err error, complete bool, observer IntervalBoolObserver)
+type MappingIntervalBool2IntervalBoolFuncFactory func(observer IntervalBoolObserver) MappingIntervalBool2IntervalBoolFunc
+
+type MappingIntervalBool2IntervalBoolObservable struct {
+	parent IntervalBoolObservable
+	mapper MappingIntervalBool2IntervalBoolFuncFactory
+}
+
+func (f *MappingIntervalBool2IntervalBoolObservable) Subscribe(observer IntervalBoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntervalBoolObserverFunc(func(next IntervalBool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapIntervalBool2IntervalBoolObservable(parent IntervalBoolObservable, mapper MappingIntervalBool2IntervalBoolFuncFactory) IntervalBoolObservable {
+	return &MappingIntervalBool2IntervalBoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntervalBool2IntervalBoolObserveDirect(parent IntervalBoolObservable, mapper MappingIntervalBool2IntervalBoolFunc) IntervalBoolObservable {
+	return MapIntervalBool2IntervalBoolObservable(parent, func(IntervalBoolObserver) MappingIntervalBool2IntervalBoolFunc {
+		return mapper
+	})
+}
+
+func MapIntervalBool2IntervalBoolObserveNext(parent IntervalBoolObservable, mapper func(IntervalBool) IntervalBool) IntervalBoolObservable {
+	return MapIntervalBool2IntervalBoolObservable(parent, func(IntervalBoolObserver) MappingIntervalBool2IntervalBoolFunc {
+		return func(next IntervalBool, err error, complete bool, observer IntervalBoolObserver) {
+			var mapped IntervalBool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntervalBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *IntervalBoolStream) Map(f func(IntervalBool) IntervalBool) *IntervalBoolStream {
+	return FromIntervalBoolObservable(MapIntervalBool2IntervalBoolObserveNext(s, f))
+}
+
+type MappingIntervalBool2BoolFunc func(next IntervalBool, err error, complete bool, observer BoolObserver)
+type MappingIntervalBool2BoolFuncFactory func(observer BoolObserver) MappingIntervalBool2BoolFunc
+
+type MappingIntervalBool2BoolObservable struct {
+	parent IntervalBoolObservable
+	mapper MappingIntervalBool2BoolFuncFactory
+}
+
+func (f *MappingIntervalBool2BoolObservable) Subscribe(observer BoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntervalBoolObserverFunc(func(next IntervalBool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapIntervalBool2BoolObservable(parent IntervalBoolObservable, mapper MappingIntervalBool2BoolFuncFactory) BoolObservable {
+	return &MappingIntervalBool2BoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntervalBool2BoolObserveDirect(parent IntervalBoolObservable, mapper MappingIntervalBool2BoolFunc) BoolObservable {
+	return MapIntervalBool2BoolObservable(parent, func(BoolObserver) MappingIntervalBool2BoolFunc {
+		return mapper
+	})
+}
+
+func MapIntervalBool2BoolObserveNext(parent IntervalBoolObservable, mapper func(IntervalBool) bool) BoolObservable {
+	return MapIntervalBool2BoolObservable(parent, func(BoolObserver) MappingIntervalBool2BoolFunc {
+		return func(next IntervalBool, err error, complete bool, observer BoolObserver) {
+			var mapped bool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapIntervalBool2Bool struct {
+	parent IntervalBoolObservable
+	mapper func(IntervalBool) BoolObservable
+}
+
+func (f *flatMapIntervalBool2Bool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntervalBoolObserverFunc(func(next IntervalBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&BoolStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &BoolStream{ignoreCompletionFilter().Bool(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *IntervalBoolStream) MapBool(f func(IntervalBool) bool) *BoolStream {
+	return FromBoolObservable(MapIntervalBool2BoolObserveNext(s, f))
+}
+
+func (s *IntervalBoolStream) FlatMapBool(f func(IntervalBool) BoolObservable) *BoolStream {
+	return &BoolStream{&flatMapIntervalBool2Bool{s, f}}
+}
@@ -1,14 +1,17 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 	"go/ast"
 	"go/parser"
+	"go/token"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/alecthomas/template"
@@ -21,8 +24,8 @@ var (
 )
 
 var (
-	packageArg      = kingpin.Arg("package", "Go package.").Required().String()
-	typesArg        = kingpin.Arg("types", "List of types to provide Reactive types for.").Strings()
+	packageArg      = kingpin.Arg("package", "Go package.").String()
+	typesArg        = kingpin.Arg("types", "List of types to provide Reactive types for, optionally as NAME=TYPE.").Strings()
 	commonTypesFlag = kingpin.Flag("common-types", "Define extensions for common Go types.").Action(func(*kingpin.ParseContext) error {
 		*typesArg = append(*typesArg, "int", "string", "float32", "float64")
 		return nil
@@ -31,35 +34,61 @@ var (
 		*typesArg = append(*typesArg, baseTypes...)
 		return nil
 	}).Bool()
-	importsFlag   = kingpin.Flag("import", "Extra imports.").PlaceHolder("PKG...").Strings()
-	outputFlag    = kingpin.Flag("output", "File to write to.").Short('o').String()
-	debugFlag     = kingpin.Flag("debug", "Debug mode.").Bool()
-	maxReplayFlag = kingpin.Flag("max-replay", "Maximum size of replayed data.").Default("16384").Int()
+	importsFlag     = kingpin.Flag("import", "Extra imports.").PlaceHolder("PKG...").Strings()
+	outputFlag      = kingpin.Flag("output", "File to write to.").Short('o').String()
+	outputDirFlag   = kingpin.Flag("output-dir", "Directory to write rx_core.go and one rx_<type>.go per type to.").PlaceHolder("DIR").String()
+	templateDirFlag = kingpin.Flag("template-dir", "Directory of extra *.tmpl templates to generate operators from.").PlaceHolder("DIR").String()
+	debugFlag       = kingpin.Flag("debug", "Debug mode.").Bool()
+	maxReplayFlag   = kingpin.Flag("max-replay", "Maximum size of replayed data.").Default("16384").Int()
+	jsonFlag        = kingpin.Flag("json", "Generate JSON Lines decoders and encoders for each type.").Bool()
+	csvFlag         = kingpin.Flag("csv", "Generate CSV decoders and encoders for each type.").Bool()
+	valuesFuncFlag  = kingpin.Flag("values-func", "Name the variadic constructor of the type named NAME FUNC instead of From<NAME>s.").PlaceHolder("NAME=FUNC").StringMap()
+	checkFlag       = kingpin.Flag("check", "Regenerate FILE from its //go:generate line and fail with a diff if it is stale.").PlaceHolder("FILE").String()
 )
 
-var reactTemplate = `// Package {{.Package}} implements ReactiveX extensions for Go.
-package {{.Package}}
-
+// headerTemplate starts every generated file. Only the file containing the
+// core types carries the package comment and go:generate directive.
+var headerTemplate = `{{if .Core}}// Package {{.Package}} implements ReactiveX extensions for Go.
+{{end}}package {{.Package}}
+{{if .Core}}
 //go:generate {{.Generate|Join}}
-
+{{end}}
 // NOTE: This file was generated by github.com/alecthomas/gorx/cmd/gorx. Do not modify.
 
 import (
-	"errors"
-	"time"
+{{if .Core}}	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+{{end}}{{if or .Core .Emit}}	"time"
 	"sync"
-	"sync/atomic"
-{{range .Imports}}
+{{end}}{{if .Emit}}	"iter"
+{{end}}{{if or (and .JSON (.Emit|AnySupportsJSON)) (and .CSV (or .Core .Emit))}}	"io"
+{{end}}{{if and .JSON (.Emit|AnySupportsJSON)}}	"bufio"
+	"bytes"
+	"encoding/json"
+{{end}}{{if and .CSV (or .Core .Emit)}}	"encoding/csv"
+{{end}}{{if .Core}}	"sync/atomic"
+{{end}}{{if or .Emit .Extensions}}{{range .Imports}}
 	"{{.}}"
-{{end}}\
+{{end}}{{end}}\
 )
+`
 
+// coreTemplate contains the subscriptions, generic filters and type
+// independent creation functions.
+var coreTemplate = `
 // ErrTimeout is delivered to an observer if the stream times out.
 var ErrTimeout = errors.New("timeout")
 
 // MaxReplaySize is the maximum size of a replay buffer. Can be modified.
 var MaxReplaySize = {{.MaxReplaySize}}
 
+// Now is the clock used by Timestamp and TimeInterval. Can be modified.
+var Now = time.Now
+
 // A Subscription to an observable.
 type Subscription interface {
 	// Dispose unsubscribes from the subscription.
@@ -123,6 +152,51 @@ func (l *LinkedSubscription) Disposed() bool {
  }
 
 
+// A SerialSubscription holds a replaceable Subscription. Disposing it disposes
+// the current Subscription and any set afterwards.
+type SerialSubscription struct {
+	lock sync.Mutex
+	disposed bool
+	current Subscription
+}
+
+func NewSerialSubscription() *SerialSubscription {
+	return &SerialSubscription{}
+}
+
+// Set replaces the current Subscription, disposing the previous one.
+func (s *SerialSubscription) Set(subscription Subscription) {
+	s.lock.Lock()
+	if s.disposed {
+		s.lock.Unlock()
+		subscription.Dispose()
+		return
+	}
+	previous := s.current
+	s.current = subscription
+	s.lock.Unlock()
+	if previous != nil {
+		previous.Dispose()
+	}
+}
+
+func (s *SerialSubscription) Dispose() {
+	s.lock.Lock()
+	s.disposed = true
+	current := s.current
+	s.current = nil
+	s.lock.Unlock()
+	if current != nil {
+		current.Dispose()
+	}
+}
+
+func (s *SerialSubscription) Disposed() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.disposed
+}
+
 // ChannelSubscription is implemented with a channel which is closed when
 // unsubscribed.
 type ChannelSubscription chan struct{}
@@ -132,7 +206,8 @@ func NewChannelSubscription() ChannelSubscription {
 }
 
 func (c ChannelSubscription) Dispose() {
-	defer recover()
+	// Disposing more than once is a no-op.
+	defer func() { recover() }()
 	close(c)
 }
 
@@ -205,6 +280,12 @@ func (f *GenericObserverFunc) Next(next interface{}) { f.f(next, nil, false) }
 func (f *GenericObserverFunc) Error(err error)       { f.f(nil, err, false) }
 func (f *GenericObserverFunc) Complete()             { f.f(nil, nil, true) }
 
+// GenericObservable is implemented by streams of every type, allowing a
+// stream of any type to be used as a signal, eg. for TakeUntil.
+type GenericObservable interface {
+	SubscribeGeneric(GenericObserver) Subscription
+}
+
 type GenericObservableFilter func(next interface{}, err error, complete bool, observer GenericObserver)
 
 // Turtles all the way down!
@@ -245,6 +326,41 @@ func distinctFilter() GenericObservableFilterFactory {
 	}
 }
 
+// distinctDeepEqualFilter is distinctFilter for types whose values may not be
+// comparable, such as slices or interfaces. Those values are compared with
+// reflect.DeepEqual.
+func distinctDeepEqualFilter() GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		seen := map[interface{}]struct{}{}
+		// Values that are not comparable (slices, maps, funcs) can't be map keys.
+		var unhashable []interface{}
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if !reflect.ValueOf(next).Comparable() {
+					for _, v := range unhashable {
+						if reflect.DeepEqual(v, next) {
+							return
+						}
+					}
+					unhashable = append(unhashable, next)
+					observer.Next(next)
+					return
+				}
+				if _, ok := seen[next]; ok {
+					return
+				}
+				seen[next] = struct{}{}
+				observer.Next(next)
+			}
+		}
+	}
+}
+
 func elementAtFilter(n int) GenericObservableFilterFactory {
 	return func(GenericObserver) GenericObservableFilter {
 		i := 0
@@ -446,7 +562,7 @@ func oneFilter() GenericObservableFilterFactory {
 			case err != nil:
 				observer.Error(err)
 			case complete:
-				if count == 2 {
+				if count != 1 {
 					observer.Error(errors.New("expected one value"))
 				} else {
 					observer.Next(value)
@@ -464,6 +580,30 @@ func oneFilter() GenericObservableFilterFactory {
 	}
 }
 
+func sortedFilter(less func(a, b interface{}) bool) GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		values := []interface{}{}
+		flush := func(observer GenericObserver) {
+			sort.SliceStable(values, func(i, j int) bool { return less(values[i], values[j]) })
+			for _, v := range values {
+				observer.Next(v)
+			}
+		}
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				flush(observer)
+				observer.Error(err)
+			case complete:
+				flush(observer)
+				observer.Complete()
+			default:
+				values = append(values, next)
+			}
+		}
+	}
+}
+
 func sampleFilter(window time.Duration) GenericObservableFilterFactory {
 	return func(observer GenericObserver) GenericObservableFilter {
 		mutex := &sync.Mutex{}
@@ -546,6 +686,127 @@ func debounceFilter(duration time.Duration) GenericObservableFilterFactory {
 	}
 }
 
+// deepEqual is reflect.DeepEqual. Files generated for each type use it so
+// that only the core file needs to import reflect.
+func deepEqual(a, b interface{}) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+type delayedEntry struct {
+	next interface{}
+	complete bool
+	due time.Time
+}
+
+// delayQueue emits values, and completion, to an observer once they are due,
+// preserving order. Errors are delivered immediately.
+type delayQueue struct {
+	mutex sync.Mutex
+	observer GenericObserver
+	wake chan bool
+	stop chan bool
+	queue []delayedEntry
+	done bool
+	last time.Time
+}
+
+func newDelayQueue(observer GenericObserver) *delayQueue {
+	q := &delayQueue{
+		observer: observer,
+		wake: make(chan bool, 1),
+		stop: make(chan bool),
+	}
+	go q.run()
+	return q
+}
+
+func (q *delayQueue) run() {
+	for {
+		q.mutex.Lock()
+		for len(q.queue) == 0 && !q.done {
+			q.mutex.Unlock()
+			select {
+			case <-q.wake:
+			case <-q.stop:
+				return
+			}
+			q.mutex.Lock()
+		}
+		if q.done {
+			q.mutex.Unlock()
+			return
+		}
+		entry := q.queue[0]
+		q.mutex.Unlock()
+		timer := time.NewTimer(entry.due.Sub(time.Now()))
+		select {
+		case <-timer.C:
+		case <-q.stop:
+			timer.Stop()
+			return
+		}
+		q.mutex.Lock()
+		if q.done {
+			q.mutex.Unlock()
+			return
+		}
+		q.queue = q.queue[1:]
+		if entry.complete {
+			q.done = true
+			q.observer.Complete()
+		} else {
+			q.observer.Next(entry.next)
+		}
+		q.mutex.Unlock()
+	}
+}
+
+func (q *delayQueue) enqueue(entry delayedEntry, delay time.Duration) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if q.done {
+		return
+	}
+	// Never schedule before an earlier entry, so order is preserved.
+	entry.due = time.Now().Add(delay)
+	if entry.due.Before(q.last) {
+		entry.due = q.last
+	}
+	q.last = entry.due
+	q.queue = append(q.queue, entry)
+	select {
+	case q.wake <- true:
+	default:
+	}
+}
+
+func (q *delayQueue) error(err error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if !q.done {
+		q.observer.Error(err)
+	}
+	q.terminate()
+}
+
+// dispose discards any pending entries and stops the queue.
+func (q *delayQueue) dispose() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.terminate()
+}
+
+// terminate must be called with mutex held.
+func (q *delayQueue) terminate() {
+	q.done = true
+	q.queue = nil
+	select {
+	case <-q.stop:
+	default:
+		close(q.stop)
+	}
+}
+
 type timedEntry struct {
 	v interface{}
 	t time.Time
@@ -598,6 +859,86 @@ func replayFilter(size int, duration time.Duration) GenericObservableFilterFacto
 	}
 }
 
+// A RetryPolicy decides whether, and after how long, to resubscribe to a
+// stream after attempt consecutive errors. attempt starts at 1, and is reset
+// whenever the stream emits a value.
+type RetryPolicy func(err error, attempt int) (time.Duration, bool)
+
+// ExponentialBackoff returns a RetryPolicy that always retries. The delay
+// starts at initial and is multiplied by multiplier after each attempt, up to
+// max. Each delay is then randomly adjusted by up to +/- jitter (0.0-1.0) of
+// itself.
+func ExponentialBackoff(initial, max time.Duration, multiplier, jitter float64) RetryPolicy {
+	return func(err error, attempt int) (time.Duration, bool) {
+		delay := float64(initial) * math.Pow(multiplier, float64(attempt-1))
+		if delay > float64(max) {
+			delay = float64(max)
+		}
+		delay += delay * jitter * (rand.Float64()*2 - 1)
+		return time.Duration(delay), true
+	}
+}
+
+// NotificationKind is the kind of event a materialized notification represents.
+type NotificationKind int
+
+const (
+	NotificationNext NotificationKind = iota
+	NotificationError
+	NotificationComplete
+)
+
+func (n NotificationKind) String() string {
+	switch n {
+	case NotificationNext:
+		return "Next"
+	case NotificationError:
+		return "Error"
+	case NotificationComplete:
+		return "Complete"
+	}
+	return "NotificationKind(" + strconv.Itoa(int(n)) + ")"
+}
+{{if .JSON}}
+// A DecodeErrorPolicy is called by decoders with each line that could not be
+// decoded. If it returns nil the line is skipped, otherwise the stream
+// terminates with the returned error.
+type DecodeErrorPolicy func(line []byte, err error) error
+
+// FailOnDecodeError terminates the stream with the decode error.
+func FailOnDecodeError(line []byte, err error) error {
+	return err
+}
+
+// SkipDecodeErrors skips lines that could not be decoded.
+func SkipDecodeErrors(line []byte, err error) error {
+	return nil
+}
+{{end}}{{if .CSV}}
+// CSVOptions configures CSV decoding.
+type CSVOptions struct {
+	// Comma is the field delimiter. Defaults to ','.
+	Comma rune
+	// Comment, if not 0, starts lines that are ignored.
+	Comment rune
+	// SkipHeader skips the first record.
+	SkipHeader bool
+	// LazyQuotes allows quotes in unquoted fields, and single quotes in quoted
+	// fields.
+	LazyQuotes bool
+}
+
+func (o CSVOptions) newReader(r io.Reader) *csv.Reader {
+	reader := csv.NewReader(r)
+	if o.Comma != 0 {
+		reader.Comma = o.Comma
+	}
+	reader.Comment = o.Comment
+	reader.LazyQuotes = o.LazyQuotes
+	return reader
+}
+{{end}}
+
 func Range(start, count int) *IntStream {
 	end := start + count
 	return CreateInt(func(observer IntObserver, subscription Subscription) {
@@ -626,7 +967,39 @@ func Interval(interval time.Duration) *IntStream {
 	})
 }
 
-{{range $type := .Types}}
+// Timer emits 0 after delay, then completes.
+func Timer(delay time.Duration) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		time.Sleep(delay)
+		if subscription.Disposed() {
+			return
+		}
+		observer.Next(0)
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+// TimerPeriodic emits 0 after delay, then an incrementing count every period.
+func TimerPeriodic(delay, period time.Duration) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		time.Sleep(delay)
+		i := 0
+		for {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(i)
+			i++
+			time.Sleep(period)
+		}
+	})
+}
+`
+
+// typesTemplate contains the observables and operators for each type in .Emit.
+var typesTemplate = `
+{{range $type := .Emit}}
 {{with $name := .|TypeName}}
 type {{$name}}Observer interface {
 	Next({{$type}})
@@ -652,7 +1025,13 @@ func {{$name}}ObserverAsGenericObserver(observer {{$name}}Observer) GenericObser
 		case complete:
 			observer.Complete()
 		default:
+{{if $type|IsInterface}}\
+			// A nil interface value can't be type asserted.
+			value, _ := next.({{$type}})
+			observer.Next(value)
+{{else}}\
 			observer.Next(next.({{$type}}))
+{{end}}\
 		}
 	})
 }
@@ -682,6 +1061,7 @@ func (f {{$name}}ObservableFactory) Subscribe(observer {{$name}}Observer) Subscr
 func Create{{$name}}(f func (observer {{$name}}Observer, subscription Subscription)) *{{$name}}Stream {
 	return From{{$name}}Observable({{$name}}ObservableFactory(f))
 }
+{{if not ($.IsDerived $type)}}\
 
 // Repeat value count times.
 func Repeat{{$name}}(value {{$type}}, count int) *{{$name}}Stream {
@@ -712,6 +1092,18 @@ func Start{{$name}}(f func () ({{$type}}, error)) *{{$name}}Stream {
 	})
 }
 
+type defer{{$name}}Observable func() {{$name}}Observable
+
+func (f defer{{$name}}Observable) Subscribe(observer {{$name}}Observer) Subscription {
+	return f().Subscribe(observer)
+}
+
+// Defer{{$name}} calls f to create a fresh observable for each subscription.
+func Defer{{$name}}(f func() {{$name}}Observable) *{{$name}}Stream {
+	return From{{$name}}Observable(defer{{$name}}Observable(f))
+}
+{{end}}\
+
 func Passthrough{{$name}}(next {{$type}}, err error, complete bool, observer {{$name}}Observer) {
 	switch {
 	case err != nil:
@@ -747,6 +1139,7 @@ func (f GenericObservableFilterFactory) {{$name}}(parent {{$name}}Observable) {{
 	)
 }
 
+{{if not ($.IsDerived $type)}}\
 func Never{{$name}}() *{{$name}}Stream {
 	return Create{{$name}}(func (observer {{$name}}Observer, subscription Subscription) {})
 }
@@ -762,6 +1155,7 @@ func Throw{{$name}}(err error) *{{$name}}Stream {
 		observer.Error(err)
 	})
 }
+{{end}}\
 
 func From{{$name}}Array(array []{{$type}}) *{{$name}}Stream {
 	return Create{{$name}}(func (observer {{$name}}Observer, subscription Subscription) {
@@ -776,10 +1170,25 @@ func From{{$name}}Array(array []{{$type}}) *{{$name}}Stream {
 	})
 }
 
-func From{{$name}}s(array ...{{$type}}) *{{$name}}Stream {
+func {{$.ValuesFunc $name}}(array ...{{$type}}) *{{$name}}Stream {
 	return From{{$name}}Array(array)
 }
 
+{{if not ($.IsDerived $type)}}\
+// From{{$name}}Seq emits each value yielded by seq, iterating it once per
+// subscription.
+func From{{$name}}Seq(seq iter.Seq[{{$type}}]) *{{$name}}Stream {
+	return Create{{$name}}(func(observer {{$name}}Observer, subscription Subscription) {
+		for v := range seq {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
 func Just{{$name}}(element {{$type}}) *{{$name}}Stream {
 	return From{{$name}}Array([]{{$type}}{element})
 }
@@ -798,6 +1207,53 @@ func Merge{{$name}}DelayError(observables ... {{$name}}Observable) *{{$name}}Str
 	return (&{{$name}}Stream{observables[0]}).MergeDelayError(observables[1:]...)
 }
 
+type amb{{$name}}Observable []{{$name}}Observable
+
+func (a amb{{$name}}Observable) Subscribe(observer {{$name}}Observer) Subscription {
+	lock := sync.Mutex{}
+	winner := -1
+	subscriptions := make([]*LinkedSubscription, len(a))
+	for i := range subscriptions {
+		subscriptions[i] = NewLinkedSubscription()
+	}
+	for i, observable := range a {
+		i := i
+		subscriptions[i].Link(observable.Subscribe({{$name}}ObserverFunc(func(next {{$type}}, err error, complete bool) {
+			lock.Lock()
+			if winner == -1 {
+				winner = i
+				for j, subscription := range subscriptions {
+					if j != i {
+						subscription.Dispose()
+					}
+				}
+			}
+			won := winner == i
+			lock.Unlock()
+			if won {
+				Passthrough{{$name}}(next, err, complete, observer)
+			}
+		})))
+	}
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		for _, s := range subscriptions {
+			s.Dispose()
+		}
+	})
+	return subscription
+}
+
+// Amb{{$name}} subscribes to all observables and mirrors whichever emits
+// first, disposing the others.
+func Amb{{$name}}(observables ... {{$name}}Observable) *{{$name}}Stream {
+	if len(observables) == 0 {
+		return Empty{{$name}}()
+	}
+	return From{{$name}}Observable(amb{{$name}}Observable(observables))
+}
+{{end}}\
+
 func From{{$name}}Channel(ch <-chan {{$type}}) *{{$name}}Stream {
 	return Create{{$name}}(func (observer {{$name}}Observer, subscription Subscription) {
 		for v := range ch {
@@ -830,20 +1286,40 @@ func (s *{{$name}}Stream) SubscribeNext(f func (v {{$type}})) Subscription {
 	})
 }
 
-// Distinct removes duplicate elements in the stream.
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *{{$name}}Stream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAs{{$name}}Observer(observer))
+}
+
+{{if not ($.IsDerived $type)}}\
+// Distinct removes duplicate elements in the stream. Values that can not be
+// map keys, such as slices, are compared with reflect.DeepEqual.
 func (s *{{$name}}Stream) Distinct() *{{$name}}Stream {
+{{if and ($type|IsComparable) (not ($type|IsInterface))}}\
 	return From{{$name}}Observable(distinctFilter().{{$name}}(s))
+{{else}}\
+	return From{{$name}}Observable(distinctDeepEqualFilter().{{$name}}(s))
+{{end}}\
 }
 
 // ElementAt yields the Nth element of the stream.
 func (s *{{$name}}Stream) ElementAt(n int) *{{$name}}Stream {
 	return From{{$name}}Observable(elementAtFilter(n).{{$name}}(s))
 }
+{{end}}\
 
 // Filter elements in the stream on a function.
 func (s *{{$name}}Stream) Filter(f func({{$type}}) bool) *{{$name}}Stream {
+{{if $type|IsInterface}}\
+	return From{{$name}}Observable(filterFilter(func(v interface{}) bool {
+		value, _ := v.({{$type}})
+		return f(value)
+	}).{{$name}}(s))
+{{else}}\
 	return From{{$name}}Observable(filterFilter(func(v interface{}) bool { return f(v.({{$type}})) }).{{$name}}(s))
+{{end}}\
 }
+{{if not ($.IsDerived $type)}}\
 
 // Last returns just the first element of the stream.
 func (s *{{$name}}Stream) First() *{{$name}}Stream {
@@ -875,6 +1351,67 @@ func (s *{{$name}}Stream) TakeLast(n int) *{{$name}}Stream {
 	return From{{$name}}Observable(takeLastFilter(n).{{$name}}(s))
 }
 
+type takeWhile{{$name}} struct {
+	parent {{$name}}Observable
+	f func({{$type}}) bool
+}
+
+func (t *takeWhile{{$name}}) Subscribe(observer {{$name}}Observer) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	parent.Link(t.parent.Subscribe({{$name}}ObserverFunc(func(next {{$type}}, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Complete()
+		case t.f(next):
+			observer.Next(next)
+		default:
+			done = true
+			observer.Complete()
+			parent.Dispose()
+		}
+	})))
+	return parent
+}
+
+// TakeWhile returns elements of the stream until f returns false, then
+// completes and disposes the stream.
+func (s *{{$name}}Stream) TakeWhile(f func({{$type}}) bool) *{{$name}}Stream {
+	return &{{$name}}Stream{&takeWhile{{$name}}{s, f}}
+}
+
+// SkipWhile skips elements of the stream until f returns false.
+func (s *{{$name}}Stream) SkipWhile(f func({{$type}}) bool) *{{$name}}Stream {
+	return From{{$name}}Observable(Map{{$name}}2{{$name}}Observable(s, func({{$name}}Observer) Mapping{{$name}}2{{$name}}Func {
+		skipping := true
+		return func(next {{$type}}, err error, complete bool, observer {{$name}}Observer) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if skipping && !f(next) {
+					skipping = false
+				}
+				if !skipping {
+					observer.Next(next)
+				}
+			}
+		}
+	}))
+}
+
 // IgnoreElements ignores elements of the stream and emits only the completion events.
 func (s *{{$name}}Stream) IgnoreElements() *{{$name}}Stream {
 	return From{{$name}}Observable(ignoreElementsFilter().{{$name}}(s))
@@ -892,6 +1429,45 @@ func (s *{{$name}}Stream) Debounce(duration time.Duration) *{{$name}}Stream {
 	return From{{$name}}Observable(debounceFilter(duration).{{$name}}(s))
 }
 
+type delay{{$name}} struct {
+	parent {{$name}}Observable
+	delay func({{$type}}) time.Duration
+	completion time.Duration
+}
+
+func (d *delay{{$name}}) Subscribe(observer {{$name}}Observer) Subscription {
+	queue := newDelayQueue({{$name}}ObserverAsGenericObserver(observer))
+	parent := d.parent.Subscribe({{$name}}ObserverFunc(func(next {{$type}}, err error, complete bool) {
+		switch {
+		case err != nil:
+			queue.error(err)
+		case complete:
+			queue.enqueue(delayedEntry{complete: true}, d.completion)
+		default:
+			queue.enqueue(delayedEntry{next: next}, d.delay(next))
+		}
+	}))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		queue.dispose()
+	})
+	return subscription
+}
+
+// Delay shifts each value, and completion, forward in time by duration. Errors
+// are not delayed. Values that are still pending are discarded on disposal.
+func (s *{{$name}}Stream) Delay(duration time.Duration) *{{$name}}Stream {
+	return &{{$name}}Stream{&delay{{$name}}{s, func({{$type}}) time.Duration { return duration }, duration}}
+}
+
+// DelayWhen shifts each value forward in time by the duration returned by f.
+// Values are never reordered, so a value is emitted no earlier than the value
+// before it. Completion is emitted after the last value. Errors are not delayed.
+func (s *{{$name}}Stream) DelayWhen(f func({{$type}}) time.Duration) *{{$name}}Stream {
+	return &{{$name}}Stream{&delay{{$name}}{s, f, 0}}
+}
+
 // Wait for completion of the stream and return any error.
 func (s *{{$name}}Stream) Wait() error {
 	errch := make(chan error, 1)
@@ -963,30 +1539,94 @@ func (s *{{$name}}Stream) Concat(observables ... {{$name}}Observable) *{{$name}}
 	return &{{$name}}Stream{&concat{{$name}}Observable{append([]{{$name}}Observable{s}, observables...)} }
 }
 
-type merge{{$name}}Observable struct {
-	delayError bool
-	observables []{{$name}}Observable
+// StartWith emits values before the values of the stream.
+func (s *{{$name}}Stream) StartWith(values ...{{$type}}) *{{$name}}Stream {
+	return From{{$name}}Array(values).Concat(s)
 }
 
-func (m *merge{{$name}}Observable) Subscribe(observer {{$name}}Observer) Subscription {
-	subscription := NewGenericSubscription()
-	lock := sync.Mutex{}
-	completed := 0
-	var firstError error
-	relay := func(next {{$type}}, err error, complete bool) {
-		lock.Lock()
-		defer lock.Unlock()
-		if completed >= len(m.observables) {
-			return
-		}
+// EndWith emits values after the stream completes.
+func (s *{{$name}}Stream) EndWith(values ...{{$type}}) *{{$name}}Stream {
+	return s.Concat(From{{$name}}Array(values))
+}
 
-		switch {
-		case err != nil:
-			if m.delayError {
-				firstError = err
-				completed++
-			} else {
-				observer.Error(err)
+// DefaultIfEmpty emits value if the stream completes without emitting any values.
+func (s *{{$name}}Stream) DefaultIfEmpty(value {{$type}}) *{{$name}}Stream {
+	return From{{$name}}Observable(Map{{$name}}2{{$name}}Observable(s, func({{$name}}Observer) Mapping{{$name}}2{{$name}}Func {
+		empty := true
+		return func(next {{$type}}, err error, complete bool, observer {{$name}}Observer) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				if empty {
+					observer.Next(value)
+				}
+				observer.Complete()
+			default:
+				empty = false
+				observer.Next(next)
+			}
+		}
+	}))
+}
+
+type switchIfEmpty{{$name}}Observable struct {
+	parent {{$name}}Observable
+	other {{$name}}Observable
+}
+
+func (e *switchIfEmpty{{$name}}Observable) Subscribe(observer {{$name}}Observer) Subscription {
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
+	empty := true
+	link.Link(e.parent.Subscribe({{$name}}ObserverFunc(func(next {{$type}}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			if !empty {
+				observer.Complete()
+			} else if !subscription.Disposed() {
+				subscription.Set(e.other.Subscribe(observer))
+			}
+		default:
+			empty = false
+			observer.Next(next)
+		}
+	})))
+	return subscription
+}
+
+// SwitchIfEmpty switches to other if the stream completes without emitting any values.
+func (s *{{$name}}Stream) SwitchIfEmpty(other {{$name}}Observable) *{{$name}}Stream {
+	return &{{$name}}Stream{&switchIfEmpty{{$name}}Observable{s, other}}
+}
+
+type merge{{$name}}Observable struct {
+	delayError bool
+	observables []{{$name}}Observable
+}
+
+func (m *merge{{$name}}Observable) Subscribe(observer {{$name}}Observer) Subscription {
+	subscription := NewGenericSubscription()
+	lock := sync.Mutex{}
+	completed := 0
+	var firstError error
+	relay := func(next {{$type}}, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if completed >= len(m.observables) {
+			return
+		}
+
+		switch {
+		case err != nil:
+			if m.delayError {
+				firstError = err
+				completed++
+			} else {
+				observer.Error(err)
 				completed = len(m.observables)
 			}
 
@@ -1027,61 +1667,134 @@ func (s *{{$name}}Stream) MergeDelayError(other ... {{$name}}Observable) *{{$nam
 	return &{{$name}}Stream{&merge{{$name}}Observable{true, append(other, s) } }
 }
 
+// Amb mirrors whichever of this stream and others emits first, disposing the rest.
+func (s *{{$name}}Stream) Amb(others ... {{$name}}Observable) *{{$name}}Stream {
+	return Amb{{$name}}(append([]{{$name}}Observable{s}, others...)...)
+}
+
 type catch{{$name}}Observable struct {
 	parent {{$name}}Observable
-	catch {{$name}}Observable
+	catch func(err error) {{$name}}Observable
+	// Also switch to the fallback when the parent completes. err will be nil.
+	resume bool
 }
 
 func (r *catch{{$name}}Observable) Subscribe(observer {{$name}}Observer) Subscription {
-	subscription := NewGenericSubscription()
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
 	run := func(next {{$type}}, err error, complete bool) {
 		switch {
-		case err != nil:
-			r.catch.Subscribe(observer)
+		case err != nil || (complete && r.resume):
+			if !subscription.Disposed() {
+				subscription.Set(r.catch(err).Subscribe(observer))
+			}
 		case complete:
 			observer.Complete()
 		default:
 			observer.Next(next)
 		}
 	}
-	r.parent.Subscribe({{$name}}ObserverFunc(run))
+	link.Link(r.parent.Subscribe({{$name}}ObserverFunc(run)))
 	return subscription
 }
 
+// Catch switches to the catch observable if the stream errors.
 func (s *{{$name}}Stream) Catch(catch {{$name}}Observable) *{{$name}}Stream {
-	return &{{$name}}Stream{ &catch{{$name}}Observable{s, catch} }
+	return s.CatchFunc(func(error) {{$name}}Observable { return catch })
 }
 
-type retry{{$name}}Observable struct {
-	observable {{$name}}Observable
+// CatchFunc switches to the observable returned by f(err) if the stream errors.
+func (s *{{$name}}Stream) CatchFunc(f func(err error) {{$name}}Observable) *{{$name}}Stream {
+	return &{{$name}}Stream{ &catch{{$name}}Observable{parent: s, catch: f} }
 }
 
-type retry{{$name}}Observer struct {
-	observable {{$name}}Observable
-	observer {{$name}}Observer
+// OnErrorReturn emits the single value returned by f(err) and completes if the stream errors.
+func (s *{{$name}}Stream) OnErrorReturn(f func(err error) {{$type}}) *{{$name}}Stream {
+	return s.CatchFunc(func(err error) {{$name}}Observable { return Just{{$name}}(f(err)) })
 }
 
-func (r *retry{{$name}}Observer) retry(next {{$type}}, err error, complete bool) {
-	switch {
-	case err != nil:
-		r.observable.Subscribe({{$name}}ObserverFunc(r.retry))
-	case complete:
-		r.observer.Complete()
-	default:
-		r.observer.Next(next)
-	}
+// OnErrorResumeNext switches to next when the stream terminates, whether it
+// completes or errors.
+func (s *{{$name}}Stream) OnErrorResumeNext(next {{$name}}Observable) *{{$name}}Stream {
+	return &{{$name}}Stream{ &catch{{$name}}Observable{
+		parent: s,
+		catch: func(error) {{$name}}Observable { return next },
+		resume: true,
+	} }
+}
+
+type retry{{$name}}Observable struct {
+	observable {{$name}}Observable
+	policy RetryPolicy
 }
 
 func (r *retry{{$name}}Observable) Subscribe(observer {{$name}}Observer) Subscription {
-	subscription := NewGenericSubscription()
-	ro := &retry{{$name}}Observer{r.observable, observer}
-	r.observable.Subscribe({{$name}}ObserverFunc(ro.retry))
+	subscription := NewSerialSubscription()
+	attempt := 0
+	var subscribe func()
+	subscribe = func() {
+		// Set the link before subscribing, in case the observable fails
+		// and is resubscribed before Subscribe returns.
+		link := NewLinkedSubscription()
+		subscription.Set(link)
+		link.Link(r.observable.Subscribe({{$name}}ObserverFunc(func(next {{$type}}, err error, complete bool) {
+			switch {
+			case err != nil:
+				if subscription.Disposed() {
+					return
+				}
+				attempt++
+				delay, ok := r.policy(err, attempt)
+				switch {
+				case !ok:
+					observer.Error(err)
+				case delay > 0:
+					time.AfterFunc(delay, func() {
+						if !subscription.Disposed() {
+							subscribe()
+						}
+					})
+				default:
+					subscribe()
+				}
+			case complete:
+				observer.Complete()
+			default:
+				attempt = 0
+				observer.Next(next)
+			}
+		})))
+	}
+	subscribe()
 	return subscription
 }
 
+// Retry resubscribes to the stream immediately, and indefinitely, on error.
 func (s *{{$name}}Stream) Retry() *{{$name}}Stream {
-	return &{{$name}}Stream{ &retry{{$name}}Observable{s} }
+	return s.RetryWhen(func(error, int) (time.Duration, bool) { return 0, true })
+}
+
+// RetryN resubscribes to the stream on error, at most n times in a row without
+// the stream emitting a value in between.
+func (s *{{$name}}Stream) RetryN(n int) *{{$name}}Stream {
+	return s.RetryWhen(func(err error, attempt int) (time.Duration, bool) { return 0, attempt <= n })
+}
+
+// RetryWhen calls policy on each error to decide whether, and after how long,
+// to resubscribe to the stream. attempt counts consecutive errors, starting at
+// 1 and reset whenever the stream emits a value.
+func (s *{{$name}}Stream) RetryWhen(policy func(err error, attempt int) (time.Duration, bool)) *{{$name}}Stream {
+	return &{{$name}}Stream{ &retry{{$name}}Observable{s, policy} }
+}
+
+// RetryWithBackoff resubscribes to the stream on error, indefinitely, with
+// an ExponentialBackoff delay. The delay is reset to initial whenever the
+// stream emits a value.
+func (s *{{$name}}Stream) RetryWithBackoff(initial, max time.Duration, multiplier, jitter float64) *{{$name}}Stream {
+	return s.RetryWhen(ExponentialBackoff(initial, max, multiplier, jitter))
 }
+{{end}}\
 
 // Do applies a function for each value passing through the stream.
 func (s *{{$name}}Stream) Do(f func(next {{$type}})) *{{$name}}Stream {
@@ -1110,6 +1823,7 @@ func (s *{{$name}}Stream) DoOnComplete(f func()) *{{$name}}Stream {
 		Passthrough{{$name}}(next, err, complete, observer)
 	}))
 }
+{{if not ($.IsDerived $type)}}\
 
 func (s *{{$name}}Stream) Reduce(initial {{$type}}, reducer func ({{$type}}, {{$type}}) {{$type}}) *{{$name}}Stream {
 	value := initial
@@ -1167,6 +1881,149 @@ func (s *{{$name}}Stream) Timeout(timeout time.Duration) *{{$name}}Stream {
 	return &{{$name}}Stream{&timeout{{$name}}{s, timeout}}
 }
 
+type delaySubscription{{$name}} struct {
+	parent {{$name}}Observable
+	delay time.Duration
+}
+
+func (d *delaySubscription{{$name}}) Subscribe(observer {{$name}}Observer) Subscription {
+	subscription := NewLinkedSubscription()
+	time.AfterFunc(d.delay, func() {
+		if !subscription.Disposed() {
+			subscription.Link(d.parent.Subscribe(observer))
+		}
+	})
+	return subscription
+}
+
+// DelaySubscription waits for delay before subscribing to the stream.
+func (s *{{$name}}Stream) DelaySubscription(delay time.Duration) *{{$name}}Stream {
+	return &{{$name}}Stream{&delaySubscription{{$name}}{s, delay}}
+}
+
+type takeUntil{{$name}} struct {
+	parent {{$name}}Observable
+	signal GenericObservable
+}
+
+func (t *takeUntil{{$name}}) Subscribe(observer {{$name}}Observer) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			// A signal that completes without a value never ends the stream.
+			break
+		default:
+			terminate(observer.Complete)
+		}
+	})))
+	parent.Link(t.parent.Subscribe({{$name}}ObserverFunc(func(next {{$type}}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// TakeUntil returns elements of the stream until signal, which may be a
+// stream of any type, emits a value. The stream then completes.
+func (s *{{$name}}Stream) TakeUntil(signal GenericObservable) *{{$name}}Stream {
+	return &{{$name}}Stream{&takeUntil{{$name}}{s, signal}}
+}
+
+type skipUntil{{$name}} struct {
+	parent {{$name}}Observable
+	signal GenericObservable
+}
+
+func (t *skipUntil{{$name}}) Subscribe(observer {{$name}}Observer) Subscription {
+	lock := sync.Mutex{}
+	open := false
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			break
+		default:
+			lock.Lock()
+			open = true
+			lock.Unlock()
+			signal.Dispose()
+		}
+	})))
+	parent.Link(t.parent.Subscribe({{$name}}ObserverFunc(func(next {{$type}}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if open && !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// SkipUntil skips elements of the stream until signal, which may be a stream
+// of any type, emits a value.
+func (s *{{$name}}Stream) SkipUntil(signal GenericObservable) *{{$name}}Stream {
+	return &{{$name}}Stream{&skipUntil{{$name}}{s, signal}}
+}
+
 type forked{{$name}}Stream struct {
 	lock sync.Mutex
 	parent {{$name}}Observable
@@ -1209,10 +2066,11 @@ func (s *{{$name}}Stream) Fork() *{{$name}}Stream {
 	}))
 	return &{{$name}}Stream{f}
 }
+{{end}}\
 
 // ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
 func (s *{{$name}}Stream) ToOneWithError() ({{$type}}, error) {
-	valuech := make(chan {{$type}}, 1)
+	valuech := make({{ChanOf $type}}, 1)
 	errch := make(chan error, 1)
 	From{{$name}}Observable(oneFilter().{{$name}}(s)).SubscribeFunc(func (next {{$type}}, err error, complete bool) {
 		if err != nil {
@@ -1268,7 +2126,7 @@ func (s *{{$name}}Stream) ToArray() []{{$type}} {
 
 // ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
 func (s *{{$name}}Stream) ToChannelWithError() (<-chan {{$type}}, <-chan error) {
-	ch := make(chan {{$type}}, 1)
+	ch := make({{ChanOf $type}}, 1)
 	errch := make(chan error, 1)
 	s.SubscribeFunc(func(next {{$type}}, err error, complete bool) {
 		switch {
@@ -1290,6 +2148,56 @@ func (s *{{$name}}Stream) ToChannel() <-chan {{$type}} {
 	return ch
 }
 
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *{{$name}}Stream) Seq() iter.Seq[{{$type}}] {
+	return func(yield func({{$type}}) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *{{$name}}Stream) Seq2() iter.Seq2[{{$type}}, error] {
+	return func(yield func({{$type}}, error) bool) {
+		type notification struct {
+			next {{$type}}
+			err error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next {{$type}}, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zero{{$name}}, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+{{if not ($.IsDerived $type)}}\
 // Count returns an IntStream with the count of elements in this stream.
 func (s *{{$name}}Stream) Count() *IntStream {
 	count := 0
@@ -1307,6 +2215,145 @@ func (s *{{$name}}Stream) Count() *IntStream {
 	}))
 }
 
+func equal{{$name}}(a, b {{$type}}) bool {
+{{if and ($type|IsComparable) (not ($type|IsInterface))}}\
+	return a == b
+{{else}}\
+	return deepEqual(a, b)
+{{end}}\
+}
+
+type decide{{$name}} struct {
+	parent {{$name}}Observable
+	// decide returns the result and true once the answer is known.
+	decide func(next {{$type}}) (bool, bool)
+	// otherwise is the result if the stream completes first.
+	otherwise bool
+}
+
+func (d *decide{{$name}}) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	subscription := NewLinkedSubscription()
+	subscription.Link(d.parent.Subscribe({{$name}}ObserverFunc(func(next {{$type}}, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Next(d.otherwise)
+			observer.Complete()
+		default:
+			if result, ok := d.decide(next); ok {
+				done = true
+				observer.Next(result)
+				observer.Complete()
+				subscription.Dispose()
+			}
+		}
+	})))
+	return subscription
+}
+
+// All emits true if f returns true for every value in the stream, or false
+// as soon as it does not.
+func (s *{{$name}}Stream) All(f func({{$type}}) bool) *BoolStream {
+	return FromBoolObservable(&decide{{$name}}{s, func(next {{$type}}) (bool, bool) { return false, !f(next) }, true})
+}
+
+// Any emits true as soon as f returns true for a value in the stream, or
+// false if it never does.
+func (s *{{$name}}Stream) Any(f func({{$type}}) bool) *BoolStream {
+	return FromBoolObservable(&decide{{$name}}{s, func(next {{$type}}) (bool, bool) { return true, f(next) }, false})
+}
+
+// Contains emits true as soon as value is seen in the stream, or false if it
+// never is.
+func (s *{{$name}}Stream) Contains(value {{$type}}) *BoolStream {
+	return s.Any(func(next {{$type}}) bool { return equal{{$name}}(next, value) })
+}
+
+// IsEmpty emits true if the stream completes without a value, or false as
+// soon as it emits one.
+func (s *{{$name}}Stream) IsEmpty() *BoolStream {
+	return FromBoolObservable(&decide{{$name}}{s, func({{$type}}) (bool, bool) { return false, true }, true})
+}
+
+type sequenceEqual{{$name}} struct {
+	parent {{$name}}Observable
+	other {{$name}}Observable
+}
+
+func (e *sequenceEqual{{$name}}) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	// Values seen on one side that the other has not caught up with yet.
+	// Only one side can be ahead at a time.
+	pending := [2][]{{$type}}{}
+	completed := [2]bool{}
+	subscriptions := [2]*LinkedSubscription{NewLinkedSubscription(), NewLinkedSubscription()}
+	dispose := func() {
+		subscriptions[0].Dispose()
+		subscriptions[1].Dispose()
+	}
+	finish := func(result bool) {
+		done = true
+		observer.Next(result)
+		observer.Complete()
+		dispose()
+	}
+	observe := func(i int) {{$name}}Observer {
+		j := 1 - i
+		return {{$name}}ObserverFunc(func(next {{$type}}, err error, complete bool) {
+			lock.Lock()
+			defer lock.Unlock()
+			if done {
+				return
+			}
+			switch {
+			case err != nil:
+				done = true
+				observer.Error(err)
+				dispose()
+			case complete:
+				completed[i] = true
+				if len(pending[j]) > 0 {
+					finish(false)
+				} else if completed[j] {
+					finish(true)
+				}
+			case len(pending[j]) > 0:
+				expected := pending[j][0]
+				pending[j] = pending[j][1:]
+				if !equal{{$name}}(next, expected) {
+					finish(false)
+				}
+			case completed[j]:
+				finish(false)
+			default:
+				pending[i] = append(pending[i], next)
+			}
+		})
+	}
+	subscriptions[0].Link(e.parent.Subscribe(observe(0)))
+	subscriptions[1].Link(e.other.Subscribe(observe(1)))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(dispose)
+	return subscription
+}
+
+// SequenceEqual emits true if the stream and other emit equal values in the
+// same order and both complete, or false as soon as they differ.
+func (s *{{$name}}Stream) SequenceEqual(other {{$name}}Observable) *BoolStream {
+	return FromBoolObservable(&sequenceEqual{{$name}}{s, other})
+}
+
 {{if $type|IsNumeric}}\
 func (s *{{$name}}Stream) Average() *{{$name}}Stream {
 	var sum {{$type}}
@@ -1341,7 +2388,8 @@ func (s *{{$name}}Stream) Sum() *{{$name}}Stream {
 		}
 	}))
 }
-
+{{end}}\
+{{if $type|IsOrdered}}
 func (s *{{$name}}Stream) Min() *{{$name}}Stream {
 	started := false
 	var min {{$type}}
@@ -1359,7 +2407,7 @@ func (s *{{$name}}Stream) Min() *{{$name}}Stream {
 			observer.Complete()
 		default:
 			if started {
-				if min > next {
+				if {{Less $type "next" "min"}} {
 					min = next
 				}
 			} else {
@@ -1387,7 +2435,7 @@ func (s *{{$name}}Stream) Max() *{{$name}}Stream {
 			observer.Complete()
 		default:
 			if started {
-				if max <= next {
+				if !({{Less $type "next" "max"}}) {
 					max = next
 				}
 			} else {
@@ -1397,29 +2445,292 @@ func (s *{{$name}}Stream) Max() *{{$name}}Stream {
 		}
 	}))
 }
-{{end}}\
 
-{{range $other := $.Types}}\
-{{with $otherName := .|TypeName}}\
-{{with $mapName := printf "%s2%s" $name $otherName}}
-type Mapping{{$mapName}}Func func(next {{$type}}, err error, complete bool, observer {{$otherName}}Observer)
-type Mapping{{$mapName}}FuncFactory func (observer {{$otherName}}Observer) Mapping{{$mapName}}Func
+// Sorted emits all values in ascending order once the stream terminates.
+func (s *{{$name}}Stream) Sorted() *{{$name}}Stream {
+	return From{{$name}}Observable(sortedFilter(func(a, b interface{}) bool {
+		x, y := a.({{$type}}), b.({{$type}})
+		return {{Less $type "x" "y"}}
+	}).{{$name}}(s))
+}
+{{end}}\
 
-type Mapping{{$mapName}}Observable struct {
-	parent  {{$name}}Observable
-	mapper Mapping{{$mapName}}FuncFactory
+// {{$name}}Notification is a materialized event from a {{$name}}Stream.
+type {{$name}}Notification struct {
+	Kind NotificationKind
+	Value {{$type}} // Set if Kind is NotificationNext.
+	Err error // Set if Kind is NotificationError.
 }
 
-func (f *Mapping{{$mapName}}Observable) Subscribe(observer {{$otherName}}Observer) Subscription {
-	mapper := f.mapper(observer)
-	return f.parent.Subscribe({{$name}}ObserverFunc(func(next {{$type}}, err error, complete bool) {
-		mapper(next, err, complete, observer)
+// Materialize emits every event of the stream, including termination, as a
+// {{$name}}Notification. The materialized stream completes after the
+// notification for the termination of this stream.
+func (s *{{$name}}Stream) Materialize() *{{$name}}NotificationStream {
+	return From{{$name}}NotificationObservable(Map{{$name}}2{{$name}}NotificationObserveDirect(s, func(next {{$type}}, err error, complete bool, observer {{$name}}NotificationObserver) {
+		switch {
+		case err != nil:
+			observer.Next({{$name}}Notification{Kind: NotificationError, Err: err})
+			observer.Complete()
+		case complete:
+			observer.Next({{$name}}Notification{Kind: NotificationComplete})
+			observer.Complete()
+		default:
+			observer.Next({{$name}}Notification{Kind: NotificationNext, Value: next})
+		}
 	}))
 }
 
-func Map{{$mapName}}Observable(parent {{$name}}Observable, mapper Mapping{{$mapName}}FuncFactory) {{$otherName}}Observable {
-	return &Mapping{{$mapName}}Observable{
-		parent:  parent,
+// Dematerialize converts materialized notifications back into the events they
+// represent. Notifications after the first termination are ignored.
+func (s *{{$name}}NotificationStream) Dematerialize() *{{$name}}Stream {
+	return From{{$name}}Observable(Map{{$name}}Notification2{{$name}}Observable(s, func({{$name}}Observer) Mapping{{$name}}Notification2{{$name}}Func {
+		terminated := false
+		return func(next {{$name}}Notification, err error, complete bool, observer {{$name}}Observer) {
+			if terminated {
+				return
+			}
+			switch {
+			case err != nil:
+				terminated = true
+				observer.Error(err)
+			case complete:
+				terminated = true
+				observer.Complete()
+			default:
+				switch next.Kind {
+				case NotificationNext:
+					observer.Next(next.Value)
+				case NotificationError:
+					terminated = true
+					observer.Error(next.Err)
+				case NotificationComplete:
+					terminated = true
+					observer.Complete()
+				}
+			}
+		}
+	}))
+}
+
+// Timestamped{{$name}} is a value from a {{$name}}Stream and the time it was emitted.
+type Timestamped{{$name}} struct {
+	Value {{$type}}
+	Time time.Time
+}
+
+// Timestamp annotates each value in the stream with the time it was emitted.
+func (s *{{$name}}Stream) Timestamp() *Timestamped{{$name}}Stream {
+	return FromTimestamped{{$name}}Observable(Map{{$name}}2Timestamped{{$name}}ObserveNext(s, func(next {{$type}}) Timestamped{{$name}} {
+		return Timestamped{{$name}}{next, Now()}
+	}))
+}
+
+// Interval{{$name}} is a value from a {{$name}}Stream and the time elapsed
+// since the previous value was emitted.
+type Interval{{$name}} struct {
+	Value {{$type}}
+	Interval time.Duration
+}
+
+// TimeInterval annotates each value in the stream with the time elapsed since
+// the previous value, or since subscription for the first value.
+func (s *{{$name}}Stream) TimeInterval() *Interval{{$name}}Stream {
+	return FromInterval{{$name}}Observable(Map{{$name}}2Interval{{$name}}Observable(s, func(Interval{{$name}}Observer) Mapping{{$name}}2Interval{{$name}}Func {
+		last := Now()
+		return func(next {{$type}}, err error, complete bool, observer Interval{{$name}}Observer) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				now := Now()
+				observer.Next(Interval{{$name}}{next, now.Sub(last)})
+				last = now
+			}
+		}
+	}))
+}
+{{if and $.JSON ($type|SupportsJSON)}}
+// Decode{{$name}}JSONLines decodes a {{$type}} from each line of r. A line
+// that can not be decoded terminates the stream with an error.
+func Decode{{$name}}JSONLines(r io.Reader) *{{$name}}Stream {
+	return Decode{{$name}}JSONLinesWithPolicy(r, FailOnDecodeError)
+}
+
+// Decode{{$name}}JSONLinesWithPolicy decodes a {{$type}} from each line of r,
+// passing lines that can not be decoded to policy. Blank lines are ignored.
+func Decode{{$name}}JSONLinesWithPolicy(r io.Reader, policy DecodeErrorPolicy) *{{$name}}Stream {
+	return Create{{$name}}(func(observer {{$name}}Observer, subscription Subscription) {
+		reader := bufio.NewReader(r)
+		for {
+			if subscription.Disposed() {
+				return
+			}
+			line, err := reader.ReadBytes('\n')
+			if err != nil && err != io.EOF {
+				observer.Error(err)
+				return
+			}
+			if len(bytes.TrimSpace(line)) > 0 {
+				var value {{$type}}
+				if derr := json.Unmarshal(line, &value); derr == nil {
+					observer.Next(value)
+				} else if derr = policy(line, derr); derr != nil {
+					observer.Error(derr)
+					return
+				}
+			}
+			if err == io.EOF {
+				observer.Complete()
+				return
+			}
+		}
+	})
+}
+
+// EncodeJSONLines writes each value in the stream to w as a line of JSON,
+// blocking until the stream completes. The error from the stream or from
+// encoding is returned. An encoding error disposes the stream.
+func (s *{{$name}}Stream) EncodeJSONLines(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	errch := make(chan error, 1)
+	failed := false
+	subscription := s.SubscribeFunc(func(next {{$type}}, err error, complete bool) {
+		if failed {
+			return
+		}
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			errch <- nil
+		default:
+			if err := encoder.Encode(next); err != nil {
+				failed = true
+				errch <- err
+			}
+		}
+	})
+	err := <-errch
+	subscription.Dispose()
+	return err
+}
+{{end}}\
+{{if $.CSV}}
+// Decode{{$name}}CSV decodes a {{$type}} from each record of the CSV in r
+// with decode. An error from parsing or from decode terminates the stream.
+func Decode{{$name}}CSV(r io.Reader, options CSVOptions, decode func(record []string) ({{$type}}, error)) *{{$name}}Stream {
+	return Create{{$name}}(func(observer {{$name}}Observer, subscription Subscription) {
+		reader := options.newReader(r)
+		skip := options.SkipHeader
+		for {
+			if subscription.Disposed() {
+				return
+			}
+			record, err := reader.Read()
+			if err == io.EOF {
+				observer.Complete()
+				return
+			} else if err != nil {
+				observer.Error(err)
+				return
+			}
+			if skip {
+				skip = false
+				continue
+			}
+			value, err := decode(record)
+			if err != nil {
+				observer.Error(err)
+				return
+			}
+			observer.Next(value)
+		}
+	})
+}
+
+// EncodeCSV writes each value in the stream to w as the CSV record returned by
+// encode, blocking until the stream completes. The error from the stream or
+// from writing is returned. A write error disposes the stream.
+func (s *{{$name}}Stream) EncodeCSV(w io.Writer, encode func({{$type}}) []string) error {
+	writer := csv.NewWriter(w)
+	errch := make(chan error, 1)
+	failed := false
+	subscription := s.SubscribeFunc(func(next {{$type}}, err error, complete bool) {
+		if failed {
+			return
+		}
+		switch {
+		case err != nil:
+			writer.Flush()
+			errch <- err
+		case complete:
+			writer.Flush()
+			errch <- writer.Error()
+		default:
+			if err := writer.Write(encode(next)); err != nil {
+				failed = true
+				errch <- err
+			}
+		}
+	})
+	err := <-errch
+	subscription.Dispose()
+	return err
+}
+{{end}}\
+{{end}}\
+{{range $other := $.MapTargets $type}}\
+{{with $otherName := .|TypeName}}\
+{{with $mapName := printf "%s2%s" $name $otherName}}
+type map{{$mapName}} struct {
+	parent {{$name}}Observable
+	f func({{$type}}) {{$other}}
+}
+
+func (m *map{{$mapName}}) Subscribe(observer {{$otherName}}Observer) Subscription {
+	return m.parent.Subscribe({{$name}}ObserverFunc(func(next {{$type}}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// Map{{$otherName}} maps this stream to an {{$otherName}}Stream via f.
+func (s *{{$name}}Stream) Map{{$otherName}}(f func({{$type}}) {{$other}}) *{{$otherName}}Stream {
+	return &{{$otherName}}Stream{&map{{$mapName}}{s, f}}
+}
+{{end}}\
+{{end}}\
+{{end}}\
+
+{{range $other := $.Targets $type}}\
+{{with $otherName := .|TypeName}}\
+{{with $mapName := printf "%s2%s" $name $otherName}}
+type Mapping{{$mapName}}Func func(next {{$type}}, err error, complete bool, observer {{$otherName}}Observer)
+type Mapping{{$mapName}}FuncFactory func (observer {{$otherName}}Observer) Mapping{{$mapName}}Func
+
+type Mapping{{$mapName}}Observable struct {
+	parent  {{$name}}Observable
+	mapper Mapping{{$mapName}}FuncFactory
+}
+
+func (f *Mapping{{$mapName}}Observable) Subscribe(observer {{$otherName}}Observer) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe({{$name}}ObserverFunc(func(next {{$type}}, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func Map{{$mapName}}Observable(parent {{$name}}Observable, mapper Mapping{{$mapName}}FuncFactory) {{$otherName}}Observable {
+	return &Mapping{{$mapName}}Observable{
+		parent:  parent,
 		mapper: mapper,
 	}
 }
@@ -1443,6 +2754,7 @@ func Map{{$mapName}}ObserveNext(parent {{$name}}Observable, mapper func({{$type}
 	)
 }
 
+{{if not ($.IsDerived $other)}}\
 type flatMap{{$mapName}} struct {
 	parent {{$name}}Observable
 	mapper func ({{$type}}) {{$otherName}}Observable
@@ -1471,25 +2783,28 @@ func (f *flatMap{{$mapName}}) Subscribe(observer {{$otherName}}Observer) Subscri
 	}))
 	return subscription
 }
+{{end}}\
 
 {{if ne $type $other}}
 // Map{{$otherName}} maps this stream to an {{$otherName}}Stream via f.
 func (s *{{$name}}Stream) Map{{$otherName}}(f func ({{$type}}) {{$other}}) *{{$otherName}}Stream {
 	return From{{$otherName}}Observable(Map{{$mapName}}ObserveNext(s, f))
 }
-
+{{if not ($.IsDerived $other)}}
 func (s *{{$name}}Stream) FlatMap{{$otherName}}(f func ({{$type}}) {{$otherName}}Observable) *{{$otherName}}Stream {
 	return &{{$otherName}}Stream{&flatMap{{$mapName}}{s, f}}
 }
+{{end}}\
 {{else}}\
 // Map maps values in this stream to another value.
 func (s *{{$name}}Stream) Map(f func ({{$type}}) {{$other}}) *{{$name}}Stream {
 	return From{{$name}}Observable(Map{{$mapName}}ObserveNext(s, f))
 }
-
+{{if not ($.IsDerived $other)}}
 func (s *{{$name}}Stream) FlatMap(f func ({{$type}}) {{$name}}Observable) *{{$name}}Stream {
 	return &{{$name}}Stream{&flatMap{{$mapName}}{s, f}}
 }
+{{end}}\
 {{end}}
 {{end}}
 {{end}}\
@@ -1504,6 +2819,143 @@ type Context struct {
 	Types         []string
 	Imports       []string
 	MaxReplaySize int
+	// JSON enables generation of JSON Lines decoders and encoders.
+	JSON bool
+	// CSV enables generation of CSV decoders and encoders.
+	CSV bool
+	// Names overrides the name of a type in generated identifiers.
+	Names map[string]string
+	// ValuesFuncs overrides the name of the variadic constructor of the type
+	// with the given name.
+	ValuesFuncs map[string]string
+	// derived maps each type derived from one of Types, such as
+	// IntNotification, to the type it was derived from.
+	derived map[string]string
+}
+
+// Derived returns the types derived from t. These wrap values of t, such as
+// tNotification, and are emitted alongside t with minimal streams that can be
+// subscribed to, converted, filtered and mapped, but have no other operators.
+func (c *Context) Derived(t string) []string {
+	if c.IsDerived(t) {
+		return nil
+	}
+	name := c.TypeName(t)
+	return []string{name + "Notification", "Timestamped" + name, "Interval" + name}
+}
+
+// ValuesFunc returns the name of the variadic constructor for the type named
+// name. This is From<name>s unless overridden with --values-func, eg. because
+// the package declares its own From<name>s, as gorx does with FromSignals.
+func (c *Context) ValuesFunc(name string) string {
+	if f, ok := c.ValuesFuncs[name]; ok {
+		return f
+	}
+	return "From" + name + "s"
+}
+
+// IsDerived returns true if t was derived from another type.
+func (c *Context) IsDerived(t string) bool {
+	_, ok := c.derived[t]
+	return ok
+}
+
+// Targets returns the types that t can be mapped to with the full set of
+// mapping functions and operators.
+//
+// Derived types only get these for themselves and the type they were derived
+// from. They are mapped to the other types by MapTargets.
+func (c *Context) Targets(t string) []string {
+	if base, ok := c.derived[t]; ok {
+		return []string{t, base}
+	}
+	return append(append([]string{}, c.Types...), c.Derived(t)...)
+}
+
+// MapTargets returns the types that t can be mapped to with just a
+// Map<Type> operator. This keeps the code generated for derived types linear
+// in the number of types, while still allowing eg. TimeInterval().MapDuration().
+func (c *Context) MapTargets(t string) []string {
+	base, ok := c.derived[t]
+	if !ok {
+		return nil
+	}
+	targets := []string{}
+	for _, other := range c.Types {
+		if other != base {
+			targets = append(targets, other)
+		}
+	}
+	return targets
+}
+
+// A File is a single generated source file.
+type File struct {
+	Path       string   // Output path, or "" for stdout.
+	Core       bool     // Include the core types and functions.
+	Emit       []string // Types to emit observables and operators for.
+	Extensions []string // Extension templates to execute after the types.
+}
+
+// Files returns the files to generate. If outputDir is empty everything is
+// generated into output, otherwise the core goes into rx_core.go, each type
+// into its own rx_<type>.go and each extension into rx_<extension>.go. It is
+// an error for two of these to have the same name, eg. a type named Core.
+func (c *Context) Files(output, outputDir string, extensions []string) ([]*File, error) {
+	if outputDir == "" {
+		emit := []string{}
+		for _, t := range c.Types {
+			emit = append(append(emit, t), c.Derived(t)...)
+		}
+		return []*File{{Path: output, Core: true, Emit: emit, Extensions: extensions}}, nil
+	}
+	files := []*File{{Path: filepath.Join(outputDir, "rx_core.go"), Core: true}}
+	for _, t := range c.Types {
+		name := "rx_" + strings.ToLower(c.TypeName(t)) + ".go"
+		emit := append([]string{t}, c.Derived(t)...)
+		files = append(files, &File{Path: filepath.Join(outputDir, name), Emit: emit})
+	}
+	for _, e := range extensions {
+		name := "rx_" + strings.TrimSuffix(e, filepath.Ext(e)) + ".go"
+		files = append(files, &File{Path: filepath.Join(outputDir, name), Extensions: []string{e}})
+	}
+	seen := map[string]bool{}
+	for _, f := range files {
+		if seen[f.Path] {
+			return nil, fmt.Errorf("more than one file would be generated as %s, use NAME=TYPE or rename the template", f.Path)
+		}
+		seen[f.Path] = true
+	}
+	return files, nil
+}
+
+// Render the template for a single File.
+func (c *Context) Render(t *template.Template, f *File) ([]byte, error) {
+	w := &bytes.Buffer{}
+	context := &struct {
+		*Context
+		*File
+	}{c, f}
+	if err := t.ExecuteTemplate(w, "header", context); err != nil {
+		return nil, err
+	}
+	if f.Core {
+		if err := t.ExecuteTemplate(w, "core", context); err != nil {
+			return nil, err
+		}
+	}
+	if len(f.Emit) > 0 {
+		if err := t.ExecuteTemplate(w, "types", context); err != nil {
+			return nil, err
+		}
+	}
+	// Extensions see the same Context as the builtin templates, not the File.
+	for _, e := range f.Extensions {
+		if err := t.ExecuteTemplate(w, e, c); err != nil {
+			return nil, err
+		}
+	}
+	return w.Bytes(), nil
 }
 
 func typeName(t ast.Expr) []string {
@@ -1520,6 +2972,24 @@ func typeName(t ast.Expr) []string {
 		return keys
 	case *ast.ArrayType:
 		return append(typeName(n.Elt), "Slice")
+	case *ast.ChanType:
+		switch n.Dir {
+		case ast.RECV:
+			return append(typeName(n.Value), "RecvChan")
+		case ast.SEND:
+			return append(typeName(n.Value), "SendChan")
+		}
+		return append(typeName(n.Value), "Chan")
+	case *ast.FuncType:
+		// Func types can't be named after their signatures without getting
+		// unwieldy, so distinct func types need an explicit NAME=TYPE.
+		return []string{"Func"}
+	case *ast.InterfaceType:
+		return []string{"Interface"}
+	case *ast.StructType:
+		return []string{"Struct"}
+	case *ast.ParenExpr:
+		return typeName(n.X)
 	case *ast.Ident:
 		return []string{strings.Title(n.Name)}
 	default:
@@ -1527,69 +2997,367 @@ func typeName(t ast.Expr) []string {
 	}
 }
 
-func main() {
-	kingpin.Parse()
-	t := template.Must(template.New("react").Funcs(template.FuncMap{
-		"TypeName": func(s string) string {
-			st, err := parser.ParseExpr(s)
-			kingpin.FatalIfError(err, "invalid type %q", s)
-			return strings.Join(typeName(st), "")
+// TypeName returns the name used for type t in generated identifiers.
+func (c *Context) TypeName(t string) string {
+	if name, ok := c.Names[t]; ok {
+		return name
+	}
+	st, err := parser.ParseExpr(t)
+	kingpin.FatalIfError(err, "invalid type %q", t)
+	return strings.Join(typeName(st), "")
+}
+
+// parseTemplates parses the builtin templates plus any *.tmpl extension
+// templates in dir, returning the template set and the extension names.
+func parseTemplates(dir string, context *Context, info TypeInfo) (*template.Template, []string) {
+	// Derived types are not decoded from JSON.
+	supportsJSON := func(t string) bool {
+		return !context.IsDerived(t) && info.SupportsJSON(t)
+	}
+	t := template.New("react").Funcs(template.FuncMap{
+		"TypeName":    context.TypeName,
+		"IsNumeric":   info.IsNumeric,
+		"IsInterface": info.IsInterface,
+		"IsComparable": func(t string) bool {
+			// Derived types are comparable if the type they wrap is.
+			if base, ok := context.derived[t]; ok {
+				t = base
+			}
+			return info.IsComparable(t)
 		},
-		"IsNumeric": func(v string) bool {
-			switch v {
-			case "byte", "uint", "int", "uint8", "int8", "uint16", "int16", "uint32",
-				"int32", "uint64", "int64", "float32", "float64":
-				return true
+		"SupportsJSON": supportsJSON,
+		"AnySupportsJSON": func(types []string) bool {
+			for _, t := range types {
+				if supportsJSON(t) {
+					return true
+				}
 			}
 			return false
 		},
-		"Join": func(a []string) string { return strings.Join(a, " ") },
-	}).Parse(reactTemplate))
-	// We always include int. As an aside, this kind of verbosity is my
-	// biggest peeve with Go. 10 lines compared to basically any other
-	// language:
-	//
-	// 		if *typesArg.Contains("int") { ... }
-	//
-	// Super annoying.
-	found := false
-	for _, s := range *typesArg {
-		if s == "int" {
-			found = true
-			break
-		}
+		"IsOrdered": info.IsOrdered,
+		"Less":      info.Less,
+		"Join":      joinArgs,
+		"ChanOf":    chanOf,
+	})
+	template.Must(t.New("header").Parse(headerTemplate))
+	template.Must(t.New("core").Parse(coreTemplate))
+	template.Must(t.New("types").Parse(typesTemplate))
+	if dir == "" {
+		return t, nil
 	}
-	if !found {
-		*typesArg = append(*typesArg, "int")
+	paths, err := filepath.Glob(filepath.Join(dir, "*.tmpl"))
+	kingpin.FatalIfError(err, "")
+	extensions := []string{}
+	for _, path := range paths {
+		text, err := ioutil.ReadFile(path)
+		kingpin.FatalIfError(err, "")
+		name := filepath.Base(path)
+		_, err = t.New(name).Parse(string(text))
+		kingpin.FatalIfError(err, "invalid template %s", path)
+		extensions = append(extensions, name)
 	}
+	return t, extensions
+}
+
+// format source through goimports, or cat in debug mode.
+func format(source []byte) ([]byte, error) {
 	cmd := exec.Command("goimports")
 	if *debugFlag {
 		cmd = exec.Command("cat")
 	}
-	outputFile := os.Stdout
-	if *outputFlag != "" {
-		dir := filepath.Dir(*outputFlag)
-		var err error
-		outputFile, err = ioutil.TempFile(dir, ".gorx-")
-		kingpin.FatalIfError(err, "")
-		defer os.Remove(outputFile.Name())
+	out := &bytes.Buffer{}
+	cmd.Stdin = bytes.NewReader(source)
+	cmd.Stdout = out
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// writeFile atomically replaces path with data. An empty path writes to stdout.
+func writeFile(path string, data []byte) error {
+	if path == "" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+	w, err := ioutil.TempFile(filepath.Dir(path), ".gorx-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(w.Name())
+	_, err = w.Write(data)
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(w.Name(), path)
+}
+
+// chanOf returns the type of a bidirectional channel of t.
+func chanOf(t string) string {
+	// "chan <-chan T" would parse as "chan<- chan T".
+	if strings.HasPrefix(t, "<-") {
+		return "chan (" + t + ")"
+	}
+	return "chan " + t
+}
+
+// joinArgs joins arguments into a go:generate directive, quoting any that
+// would otherwise be split.
+func joinArgs(args []string) string {
+	out := make([]string, len(args))
+	for i, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\"") {
+			arg = strconv.Quote(arg)
+		}
+		out[i] = arg
+	}
+	return strings.Join(out, " ")
+}
+
+// splitArgs splits a go:generate directive into arguments. As with go
+// generate, arguments may be double-quoted Go strings.
+func splitArgs(line string) ([]string, error) {
+	args := []string{}
+	for {
+		line = strings.TrimLeft(line, " \t")
+		if line == "" {
+			return args, nil
+		}
+		end := strings.IndexAny(line, " \t")
+		if line[0] == '"' {
+			end = 1
+			for ; end < len(line) && line[end] != '"'; end++ {
+				if line[end] == '\\' {
+					end++
+				}
+			}
+			end++
+			if end > len(line) {
+				return nil, fmt.Errorf("unterminated quoted string in %q", line)
+			}
+		}
+		if end < 0 {
+			end = len(line)
+		}
+		arg := line[:end]
+		if arg[0] == '"' {
+			var err error
+			if arg, err = strconv.Unquote(arg); err != nil {
+				return nil, err
+			}
+		}
+		args = append(args, arg)
+		line = line[end:]
+	}
+}
+
+// readDirective returns the arguments of the gorx //go:generate directive in path.
+func readDirective(path string) ([]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if !strings.HasPrefix(line, "//go:generate ") {
+			continue
+		}
+		args, err := splitArgs(strings.TrimPrefix(line, "//go:generate "))
+		if err != nil {
+			return nil, err
+		}
+		if len(args) > 0 && args[0] == "gorx" {
+			return args[1:], nil
+		}
+	}
+	return nil, fmt.Errorf("%s: no //go:generate gorx directive found", path)
+}
+
+// diff returns a unified diff between the file at path and data.
+func diff(path string, data []byte) ([]byte, error) {
+	f, err := ioutil.TempFile("", "gorx-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, err
+	}
+	out, err := exec.Command("diff", "-u", path, f.Name()).CombinedOutput()
+	if len(out) > 0 {
+		// diff exits with 1 when the files differ.
+		err = nil
+	}
+	return out, err
+}
+
+// check regenerates the files described by the go:generate directive in path
+// and writes a diff to stdout for each file that differs from what is on
+// disk. Relative paths in the directive are resolved against the directory of
+// path, as go generate does. Returns false if any file is stale.
+func check(path string) bool {
+	args, err := readDirective(path)
+	kingpin.FatalIfError(err, "")
+	_, err = kingpin.CommandLine.Parse(args)
+	kingpin.FatalIfError(err, "%s: invalid directive", path)
+	dir := filepath.Dir(path)
+	templateDir := *templateDirFlag
+	if templateDir != "" && !filepath.IsAbs(templateDir) {
+		templateDir = filepath.Join(dir, templateDir)
+	}
+	context := newContext(args)
+	info := CheckTypes(dir, context.Package, context.Imports, context.Types)
+	t, extensions := parseTemplates(templateDir, context, info)
+	outputDir := ""
+	if *outputDirFlag != "" {
+		outputDir = dir
 	}
-	cmd.Stdout = outputFile
-	stdin, err := cmd.StdinPipe()
+	ok := true
+	files, err := context.Files(path, outputDir, extensions)
 	kingpin.FatalIfError(err, "")
-	kingpin.FatalIfError(cmd.Start(), "")
-	err = t.Execute(stdin, &Context{
-		Generate:      append([]string{"gorx"}, os.Args[1:]...),
+	for _, f := range files {
+		source, err := context.Render(t, f)
+		kingpin.FatalIfError(err, "")
+		source, err = format(source)
+		kingpin.FatalIfError(err, "%s", f.Path)
+		existing, err := ioutil.ReadFile(f.Path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "gorx: %s\n", err)
+			ok = false
+			continue
+		}
+		if bytes.Equal(existing, source) {
+			continue
+		}
+		ok = false
+		out, err := diff(f.Path, source)
+		kingpin.FatalIfError(err, "diff %s", f.Path)
+		os.Stdout.Write(out)
+	}
+	return ok
+}
+
+// newContext creates a Context from the parsed command line args.
+func newContext(args []string) *Context {
+	if *packageArg == "" {
+		kingpin.Fatalf("required argument 'package' not provided")
+	}
+	if *outputFlag != "" && *outputDirFlag != "" {
+		kingpin.Fatalf("--output and --output-dir are mutually exclusive")
+	}
+	// We always include int and bool. As an aside, this kind of verbosity
+	// is my biggest peeve with Go. 10 lines compared to basically any other
+	// language:
+	//
+	// 		if *typesArg.Contains("int") { ... }
+	//
+	// Super annoying.
+	for _, required := range []string{"int", "bool"} {
+		found := false
+		for _, s := range *typesArg {
+			if s == required {
+				found = true
+				break
+			}
+		}
+		if !found {
+			*typesArg = append(*typesArg, required)
+		}
+	}
+	context := &Context{
+		Generate:      append([]string{"gorx"}, args...),
 		Package:       *packageArg,
-		Types:         *typesArg,
 		Imports:       *importsFlag,
 		MaxReplaySize: *maxReplayFlag,
-	})
+		JSON:          *jsonFlag,
+		CSV:           *csvFlag,
+		Names:         map[string]string{},
+		ValuesFuncs:   *valuesFuncFlag,
+		derived:       map[string]string{},
+	}
+	for _, t := range *typesArg {
+		kingpin.FatalIfError(context.addType(t), "")
+	}
+	for name := range context.ValuesFuncs {
+		found := false
+		for _, t := range context.Types {
+			if context.TypeName(t) == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			kingpin.Fatalf("--values-func=%s: no type is named %s", name, name)
+		}
+	}
+	return context
+}
+
+// addType adds a type argument, optionally of the form NAME=TYPE, and the
+// types derived from it. It is an error for any of these to have the same
+// name as a type that was already added.
+func (c *Context) addType(arg string) error {
+	t := arg
+	if eq := strings.Index(t, "="); eq > 0 && token.IsIdentifier(t[:eq]) {
+		t = t[eq+1:]
+		c.Names[t] = arg[:eq]
+	}
+	if _, err := parser.ParseExpr(t); err != nil {
+		return fmt.Errorf("invalid type %q: %s", t, err)
+	}
+	types := map[string]string{}
+	for _, other := range c.Types {
+		for _, n := range append([]string{other}, c.Derived(other)...) {
+			types[c.TypeName(n)] = n
+		}
+	}
+	names := append([]string{t}, c.Derived(t)...)
+	for _, n := range names {
+		name := c.TypeName(n)
+		if other, ok := types[name]; ok {
+			return fmt.Errorf("types %q and %q are both named %s, use NAME=TYPE to rename one", other, n, name)
+		}
+		types[name] = n
+	}
+	for _, d := range names[1:] {
+		c.derived[d] = t
+	}
+	c.Types = append(c.Types, t)
+	return nil
+}
+
+func main() {
+	kingpin.Parse()
+	if *checkFlag != "" {
+		if !check(*checkFlag) {
+			os.Exit(1)
+		}
+		return
+	}
+	context := newContext(os.Args[1:])
+	dir := "."
+	if *outputDirFlag != "" {
+		kingpin.FatalIfError(os.MkdirAll(*outputDirFlag, 0755), "")
+		dir = *outputDirFlag
+	} else if *outputFlag != "" {
+		dir = filepath.Dir(*outputFlag)
+	}
+	info := CheckTypes(dir, context.Package, context.Imports, context.Types)
+	t, extensions := parseTemplates(*templateDirFlag, context, info)
+	files, err := context.Files(*outputFlag, *outputDirFlag, extensions)
 	kingpin.FatalIfError(err, "")
-	stdin.Close()
-	kingpin.FatalIfError(cmd.Wait(), "")
-	outputFile.Close()
-	if *outputFlag != "" {
-		kingpin.FatalIfError(os.Rename(outputFile.Name(), *outputFlag), "")
+	for _, f := range files {
+		source, err := context.Render(t, f)
+		kingpin.FatalIfError(err, "")
+		source, err = format(source)
+		kingpin.FatalIfError(err, "%s", f.Path)
+		kingpin.FatalIfError(writeFile(f.Path, source), "")
 	}
 }
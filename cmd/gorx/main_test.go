@@ -0,0 +1,287 @@
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoinSplitArgs(t *testing.T) {
+	tests := []struct {
+		args   []string
+		joined string
+	}{
+		{[]string{"-o", "rx.go", "rx", "string"}, `-o rx.go rx string`},
+		{[]string{"rx", "Task=func() error"}, `rx "Task=func() error"`},
+		{[]string{"rx", "<-chan string"}, `rx "<-chan string"`},
+		{[]string{"rx", "*http.Response", "map[string]int"}, `rx *http.Response map[string]int`},
+		{[]string{"rx", `say "hi"`}, `rx "say \"hi\""`},
+		{[]string{"rx", "a\tb"}, `rx "a\tb"`},
+		{[]string{"rx", ""}, `rx ""`},
+	}
+	for _, test := range tests {
+		joined := joinArgs(test.args)
+		assert.Equal(t, test.joined, joined)
+		args, err := splitArgs(joined)
+		assert.NoError(t, err)
+		assert.Equal(t, test.args, args)
+	}
+}
+
+func TestSplitArgs(t *testing.T) {
+	args, err := splitArgs("  -o rx.go\trx   \"func()\" ")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"-o", "rx.go", "rx", "func()"}, args)
+
+	_, err = splitArgs(`rx "func() error`)
+	assert.Error(t, err)
+}
+
+func TestContextFiles(t *testing.T) {
+	context := &Context{
+		Types: []string{"*http.Response", "[]byte", "func() error"},
+		Names: map[string]string{"func() error": "Task"},
+	}
+	files, err := context.Files("", "rx", []string{"dedupe.tmpl"})
+	assert.NoError(t, err)
+	paths := []string{}
+	for _, f := range files {
+		paths = append(paths, f.Path)
+	}
+	assert.Equal(t, []string{
+		"rx/rx_core.go",
+		"rx/rx_response.go",
+		"rx/rx_byteslice.go",
+		"rx/rx_task.go",
+		"rx/rx_dedupe.go",
+	}, paths)
+	assert.True(t, files[0].Core)
+	assert.Empty(t, files[0].Emit)
+	assert.Equal(t, []string{"[]byte", "ByteSliceNotification", "TimestampedByteSlice", "IntervalByteSlice"}, files[2].Emit)
+	assert.Equal(t, []string{"dedupe.tmpl"}, files[4].Extensions)
+
+	files, err = context.Files("rx.go", "", []string{"dedupe.tmpl"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(files))
+	assert.Equal(t, "rx.go", files[0].Path)
+	assert.True(t, files[0].Core)
+	assert.Equal(t, 12, len(files[0].Emit))
+	assert.Equal(t, []string{"dedupe.tmpl"}, files[0].Extensions)
+}
+
+func TestContextFilesCollide(t *testing.T) {
+	context := &Context{Types: []string{"Core"}}
+	_, err := context.Files("", "rx", nil)
+	assert.Error(t, err)
+
+	context = &Context{Types: []string{"int"}}
+	_, err = context.Files("", "rx", []string{"int.tmpl"})
+	assert.Error(t, err)
+	_, err = context.Files("rx.go", "", []string{"int.tmpl"})
+	assert.NoError(t, err)
+}
+
+func TestValuesFunc(t *testing.T) {
+	context := &Context{ValuesFuncs: map[string]string{"Signal": "FromSignalValues"}}
+	assert.Equal(t, "FromInts", context.ValuesFunc("Int"))
+	assert.Equal(t, "FromSignalValues", context.ValuesFunc("Signal"))
+}
+
+func TestOutputDirTypeChecks(t *testing.T) {
+	context := &Context{
+		Package: "od",
+		Types:   []string{"int", "bool", "[]byte"},
+		derived: map[string]string{},
+	}
+	for _, typ := range context.Types {
+		for _, d := range context.Derived(typ) {
+			context.derived[d] = typ
+		}
+	}
+	tmpl, _ := parseTemplates("", context, TypeInfo{})
+	fset := token.NewFileSet()
+	files := []*ast.File{}
+	generated, err := context.Files("", "od", nil)
+	assert.NoError(t, err)
+	for _, f := range generated {
+		source, err := context.Render(tmpl, f)
+		assert.NoError(t, err)
+		file, err := parser.ParseFile(fset, f.Path, source, 0)
+		assert.NoError(t, err)
+		files = append(files, file)
+	}
+	errs := []error{}
+	config := &types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(err error) { errs = append(errs, err) },
+	}
+	config.Check("od", fset, files, nil)
+	assert.Empty(t, errs)
+}
+
+func TestSupportsJSON(t *testing.T) {
+	exprs := []string{"int", "[]string", "complex64", "interface{}", "func()", "chan int", "os.Signal", "time.Duration", "*os.File"}
+	expected := []bool{true, true, false, false, false, false, false, true, true}
+	resolved := CheckTypes(t.TempDir(), "rx", []string{"os"}, exprs)
+	for i, expr := range exprs {
+		assert.Equal(t, expected[i], resolved.SupportsJSON(expr), expr)
+		if expr != "os.Signal" {
+			assert.Equal(t, expected[i], TypeInfo{}.SupportsJSON(expr), expr)
+		}
+	}
+}
+
+func TestCheckTypes(t *testing.T) {
+	dir := t.TempDir()
+	err := ioutil.WriteFile(filepath.Join(dir, "temps.go"), []byte(`package temps
+
+import "time"
+
+type Celsius float64
+
+type Version struct{ Major, Minor int }
+
+func (v Version) Before(o Version) bool {
+	return v.Major < o.Major || (v.Major == o.Major && v.Minor < o.Minor)
+}
+
+type Stamp struct{ time.Time }
+`), 0600)
+	assert.NoError(t, err)
+	// Previously generated files are ignored, otherwise Celsius would be
+	// redeclared.
+	err = ioutil.WriteFile(filepath.Join(dir, "rx.go"), []byte("package temps\n\n// "+generatedMarker+"\n\ntype Celsius string\n"), 0600)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		expr       string
+		numeric    bool
+		ordered    bool
+		comparable bool
+		less       string
+	}{
+		{"Celsius", true, true, true, "a < b"},
+		{"time.Duration", true, true, true, "a < b"},
+		{"string", false, true, true, "a < b"},
+		{"time.Time", false, true, true, "a.Before(b)"},
+		{"Version", false, true, true, "a.Before(b)"},
+		// Before must take the type itself.
+		{"*Version", false, false, true, "a < b"},
+		{"Stamp", false, false, true, "a < b"},
+		{"[]int", false, false, false, "a < b"},
+		{"interface{}", false, false, true, "a < b"},
+	}
+	exprs := []string{"Missing"}
+	for _, test := range tests {
+		exprs = append(exprs, test.expr)
+	}
+	info := CheckTypes(dir, "temps", nil, exprs)
+	assert.NotContains(t, info, "Missing")
+	for _, test := range tests {
+		assert.Contains(t, info, test.expr)
+		assert.Equal(t, test.numeric, info.IsNumeric(test.expr), test.expr)
+		assert.Equal(t, test.ordered, info.IsOrdered(test.expr), test.expr)
+		assert.Equal(t, test.comparable, info.IsComparable(test.expr), test.expr)
+		assert.Equal(t, test.less, info.Less(test.expr, "a", "b"), test.expr)
+	}
+}
+
+func TestTypeInfoFallbacks(t *testing.T) {
+	tests := []struct {
+		expr       string
+		numeric    bool
+		ordered    bool
+		comparable bool
+		iface      bool
+	}{
+		{"int", true, true, true, false},
+		{"float64", true, true, true, false},
+		{"string", false, true, true, false},
+		// Named types can not be resolved without type checking.
+		{"Celsius", false, false, true, false},
+		{"time.Time", false, false, true, false},
+		{"[2]int", false, false, true, false},
+		{"[]int", false, false, false, false},
+		{"map[string]int", false, false, false, false},
+		{"func()", false, false, false, false},
+		{"interface{}", false, false, true, true},
+		{"error", false, false, true, true},
+		{"any", false, false, true, true},
+		{"[", false, false, false, false},
+	}
+	info := TypeInfo{}
+	for _, test := range tests {
+		assert.Equal(t, test.numeric, info.IsNumeric(test.expr), test.expr)
+		assert.Equal(t, test.ordered, info.IsOrdered(test.expr), test.expr)
+		assert.Equal(t, test.comparable, info.IsComparable(test.expr), test.expr)
+		assert.Equal(t, test.iface, info.IsInterface(test.expr), test.expr)
+		assert.Equal(t, "a < b", info.Less(test.expr, "a", "b"), test.expr)
+	}
+}
+
+func TestTypeName(t *testing.T) {
+	tests := []struct {
+		expr string
+		name string
+	}{
+		{"int", "Int"},
+		{"*http.Response", "Response"},
+		{"[]byte", "ByteSlice"},
+		{"map[string]int", "StringIntMap"},
+		{"chan int", "IntChan"},
+		{"<-chan string", "StringRecvChan"},
+		{"chan<- string", "StringSendChan"},
+		{"func()", "Func"},
+		{"func(int) error", "Func"},
+		{"interface{}", "Interface"},
+		{"struct{}", "Struct"},
+		{"(int)", "Int"},
+	}
+	context := &Context{}
+	for _, test := range tests {
+		assert.Equal(t, test.name, context.TypeName(test.expr), test.expr)
+	}
+}
+
+func TestAddType(t *testing.T) {
+	context := &Context{Names: map[string]string{}, derived: map[string]string{}}
+	assert.NoError(t, context.addType("int"))
+	assert.NoError(t, context.addType("Task=func() error"))
+	assert.NoError(t, context.addType("Job=func(context.Context) error"))
+	// Only identifiers are treated as names.
+	assert.NoError(t, context.addType("map[string]int"))
+	assert.Equal(t, []string{"int", "func() error", "func(context.Context) error", "map[string]int"}, context.Types)
+	assert.Equal(t, "Task", context.TypeName("func() error"))
+	assert.Equal(t, "Job", context.TypeName("func(context.Context) error"))
+	assert.Equal(t, "TaskNotification", context.TypeName("TaskNotification"))
+	assert.Equal(t, "func() error", context.derived["TimestampedTask"])
+	assert.True(t, context.IsDerived("IntervalJob"))
+
+	assert.NoError(t, context.addType("func()"))
+	assert.Error(t, context.addType("func(int)"))
+	assert.Error(t, context.addType("Int=int64"))
+	// Collides with the derived IntNotification.
+	assert.Error(t, context.addType("IntNotification"))
+	assert.Error(t, context.addType("chan int["))
+	assert.NoError(t, context.addType("Handler=func(int)"))
+}
+
+func TestChanOf(t *testing.T) {
+	assert.Equal(t, "chan int", chanOf("int"))
+	assert.Equal(t, "chan chan<- int", chanOf("chan<- int"))
+	assert.Equal(t, "chan (<-chan int)", chanOf("<-chan int"))
+	for _, typ := range []string{"int", "chan<- int", "<-chan int"} {
+		expr, err := parser.ParseExpr(chanOf(typ))
+		assert.NoError(t, err)
+		ch, ok := expr.(*ast.ChanType)
+		assert.True(t, ok, typ)
+		assert.Equal(t, ast.SEND|ast.RECV, ch.Dir, typ)
+	}
+}
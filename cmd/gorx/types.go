@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"path/filepath"
+)
+
+// generatedMarker identifies files previously generated by gorx. These are
+// excluded when type checking, as they are about to be replaced.
+const generatedMarker = "This file was generated by github.com/alecthomas/gorx/cmd/gorx"
+
+// TypeInfo maps type expressions to their resolved types.
+type TypeInfo map[string]types.Type
+
+// CheckTypes resolves exprs as if they were declared in package pkg, located
+// in dir, with the given imports.
+//
+// Type errors are ignored, as the package will often not compile until it has
+// been generated. Expressions that can not be resolved are omitted.
+func CheckTypes(dir, pkg string, imports, exprs []string) TypeInfo {
+	fset := token.NewFileSet()
+	files := []*ast.File{}
+	if bp, err := build.ImportDir(dir, 0); err == nil {
+		for _, name := range bp.GoFiles {
+			path := filepath.Join(dir, name)
+			data, err := ioutil.ReadFile(path)
+			if err != nil || bytes.Contains(data, []byte(generatedMarker)) {
+				continue
+			}
+			if f, err := parser.ParseFile(fset, path, data, 0); err == nil && f.Name.Name == pkg {
+				files = append(files, f)
+			}
+		}
+	}
+	src := &bytes.Buffer{}
+	fmt.Fprintf(src, "package %s\n\nimport (\n\t\"time\"\n", pkg)
+	for _, imp := range imports {
+		fmt.Fprintf(src, "\t%q\n", imp)
+	}
+	fmt.Fprintf(src, ")\n\n")
+	for i, expr := range exprs {
+		fmt.Fprintf(src, "var gorxType%d %s\n", i, expr)
+	}
+	f, err := parser.ParseFile(fset, "gorx_types.go", src.Bytes(), 0)
+	if err != nil {
+		return TypeInfo{}
+	}
+	files = append(files, f)
+	config := &types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(error) {},
+	}
+	checked, _ := config.Check(pkg, fset, files, nil)
+	info := TypeInfo{}
+	for i, expr := range exprs {
+		obj := checked.Scope().Lookup(fmt.Sprintf("gorxType%d", i))
+		if obj != nil && obj.Type() != types.Typ[types.Invalid] {
+			info[expr] = obj.Type()
+		}
+	}
+	return info
+}
+
+// IsNumeric returns true if the underlying type of t supports arithmetic.
+func (i TypeInfo) IsNumeric(t string) bool {
+	typ, ok := i[t]
+	if !ok {
+		// Fall back to matching builtin names.
+		switch t {
+		case "byte", "uint", "int", "uint8", "int8", "uint16", "int16", "uint32",
+			"int32", "uint64", "int64", "float32", "float64":
+			return true
+		}
+		return false
+	}
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsNumeric != 0
+}
+
+// IsInterface returns true if t is an interface type.
+func (i TypeInfo) IsInterface(t string) bool {
+	typ, ok := i[t]
+	if !ok {
+		expr, err := parser.ParseExpr(t)
+		if err != nil {
+			return false
+		}
+		_, ok := expr.(*ast.InterfaceType)
+		return ok || t == "error" || t == "any"
+	}
+	return types.IsInterface(typ)
+}
+
+// SupportsJSON returns true if values of t can be round-tripped through
+// encoding/json. Interface types can be encoded but not decoded, while func,
+// chan and complex types can not be encoded at all.
+func (i TypeInfo) SupportsJSON(t string) bool {
+	typ, ok := i[t]
+	if !ok {
+		expr, err := parser.ParseExpr(t)
+		if err != nil {
+			return false
+		}
+		switch expr.(type) {
+		case *ast.InterfaceType, *ast.FuncType, *ast.ChanType:
+			return false
+		}
+		switch t {
+		case "error", "any", "complex64", "complex128":
+			return false
+		}
+		return true
+	}
+	switch typ := typ.Underlying().(type) {
+	case *types.Interface, *types.Signature, *types.Chan:
+		return false
+	case *types.Basic:
+		return typ.Info()&types.IsComplex == 0 && typ.Kind() != types.UnsafePointer
+	}
+	return true
+}
+
+// IsComparable returns true if values of t can be compared with ==.
+func (i TypeInfo) IsComparable(t string) bool {
+	typ, ok := i[t]
+	if !ok {
+		expr, err := parser.ParseExpr(t)
+		if err != nil {
+			return false
+		}
+		switch expr := expr.(type) {
+		case *ast.ArrayType:
+			return expr.Len != nil
+		case *ast.MapType, *ast.FuncType:
+			return false
+		}
+		return true
+	}
+	return types.Comparable(typ)
+}
+
+// IsOrdered returns true if values of t can be ordered, either with < or via
+// a "Before(t) bool" method such as time.Time has.
+func (i TypeInfo) IsOrdered(t string) bool {
+	typ, ok := i[t]
+	if !ok {
+		return t == "string" || i.IsNumeric(t)
+	}
+	if basic, ok := typ.Underlying().(*types.Basic); ok && basic.Info()&types.IsOrdered != 0 {
+		return true
+	}
+	return hasBefore(typ)
+}
+
+// Less returns an expression comparing a < b for values of type t.
+func (i TypeInfo) Less(t, a, b string) string {
+	if typ, ok := i[t]; ok && hasBefore(typ) {
+		if _, ok := typ.Underlying().(*types.Basic); !ok {
+			return fmt.Sprintf("%s.Before(%s)", a, b)
+		}
+	}
+	return fmt.Sprintf("%s < %s", a, b)
+}
+
+// hasBefore returns true if typ has a method "Before(typ) bool".
+func hasBefore(typ types.Type) bool {
+	obj, _, _ := types.LookupFieldOrMethod(typ, true, nil, "Before")
+	method, ok := obj.(*types.Func)
+	if !ok {
+		return false
+	}
+	sig := method.Type().(*types.Signature)
+	if sig.Params().Len() != 1 || sig.Results().Len() != 1 {
+		return false
+	}
+	return types.Identical(sig.Params().At(0).Type(), typ) &&
+		types.Identical(sig.Results().At(0).Type(), types.Typ[types.Bool])
+}
@@ -0,0 +1,14 @@
+package gorx
+
+import "io"
+
+// FromCSV emits each record of the CSV in r.
+func FromCSV(r io.Reader, options CSVOptions) *StringSliceStream {
+	return DecodeStringSliceCSV(r, options, func(record []string) ([]string, error) { return record, nil })
+}
+
+// ToCSV writes each record in the stream to w as CSV, blocking until the
+// stream completes.
+func (s *StringSliceStream) ToCSV(w io.Writer) error {
+	return s.EncodeCSV(w, func(record []string) []string { return record })
+}
@@ -1,6 +1,6 @@
 package main
 
-//go:generate go run ../../cmd/gorx/main.go -o rx/rx.go --import=net/http rx *http.Response string
+//go:generate go run ../../cmd/gorx -o rx/rx.go --import=net/http rx *http.Response string
 
 import (
 	"errors"
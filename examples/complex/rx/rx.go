@@ -1,10 +1,18 @@
+// Package rx implements ReactiveX extensions for Go.
 package rx
 
+//go:generate gorx -o rx/rx.go --import=net/http rx *http.Response string
+
 // NOTE: This file was generated by github.com/alecthomas/gorx/cmd/gorx. Do not modify.
 
 import (
 	"errors"
-	"io"
+	"iter"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -18,21 +26,29 @@ var ErrTimeout = errors.New("timeout")
 // MaxReplaySize is the maximum size of a replay buffer. Can be modified.
 var MaxReplaySize = 16384
 
+// Now is the clock used by Timestamp and TimeInterval. Can be modified.
+var Now = time.Now
+
 // A Subscription to an observable.
 type Subscription interface {
-	// Unsubscribe from the subscription.
-	io.Closer
-	// Closed returns true if this subscription has been unsubscribed.
-	Closed() bool
+	// Dispose unsubscribes from the subscription.
+	Dispose()
+	// Disposed returns true if this subscription has been unsubscribed.
+	Disposed() bool
+}
+
+// SubscriptionEvents provides lifecycle event callbacks for a Subscription.
+type SubscriptionEvents interface {
+	OnUnsubscribe(func())
 }
 
 // A Subscription that is already closed.
 type closedSubscription struct{}
 
-func (closedSubscription) Close() error { return nil }
-func (closedSubscription) Closed() bool { return true }
+func (closedSubscription) Dispose()       {}
+func (closedSubscription) Disposed() bool { return true }
 
-// ClosedSubscription always returns true for Closed()
+// ClosedSubscription always returns true for Disposed()
 var ClosedSubscription Subscription = closedSubscription{}
 
 // A LinkedSubscription is a link to a (possible) future Subscription.
@@ -54,29 +70,73 @@ func (l *LinkedSubscription) Link(subscription Subscription) {
 	}
 	l.linked = subscription
 	if l.unsubscribed {
-		l.linked.Close()
+		l.linked.Dispose()
 	}
 }
 
-func (l *LinkedSubscription) Close() error {
+func (l *LinkedSubscription) Dispose() {
 	l.lock.Lock()
 	defer l.lock.Unlock()
 	l.unsubscribed = true
 	if l.linked != nil {
-		return l.linked.Close()
+		l.linked.Dispose()
 	}
-	return nil
 }
 
-func (l *LinkedSubscription) Closed() bool {
+func (l *LinkedSubscription) Disposed() bool {
 	l.lock.Lock()
 	defer l.lock.Unlock()
 	if l.linked != nil {
-		return l.linked.Closed()
+		return l.linked.Disposed()
 	}
 	return l.unsubscribed
 }
 
+// A SerialSubscription holds a replaceable Subscription. Disposing it disposes
+// the current Subscription and any set afterwards.
+type SerialSubscription struct {
+	lock     sync.Mutex
+	disposed bool
+	current  Subscription
+}
+
+func NewSerialSubscription() *SerialSubscription {
+	return &SerialSubscription{}
+}
+
+// Set replaces the current Subscription, disposing the previous one.
+func (s *SerialSubscription) Set(subscription Subscription) {
+	s.lock.Lock()
+	if s.disposed {
+		s.lock.Unlock()
+		subscription.Dispose()
+		return
+	}
+	previous := s.current
+	s.current = subscription
+	s.lock.Unlock()
+	if previous != nil {
+		previous.Dispose()
+	}
+}
+
+func (s *SerialSubscription) Dispose() {
+	s.lock.Lock()
+	s.disposed = true
+	current := s.current
+	s.current = nil
+	s.lock.Unlock()
+	if current != nil {
+		current.Dispose()
+	}
+}
+
+func (s *SerialSubscription) Disposed() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.disposed
+}
+
 // ChannelSubscription is implemented with a channel which is closed when
 // unsubscribed.
 type ChannelSubscription chan struct{}
@@ -85,13 +145,13 @@ func NewChannelSubscription() ChannelSubscription {
 	return make(ChannelSubscription)
 }
 
-func (c ChannelSubscription) Close() error {
-	defer recover()
+func (c ChannelSubscription) Dispose() {
+	// Disposing more than once is a no-op.
+	defer func() { recover() }()
 	close(c)
-	return nil
 }
 
-func (c ChannelSubscription) Closed() bool {
+func (c ChannelSubscription) Disposed() bool {
 	select {
 	case _, ok := <-c:
 		return !ok
@@ -100,6 +160,13 @@ func (c ChannelSubscription) Closed() bool {
 	}
 }
 
+func (c ChannelSubscription) OnUnsubscribe(handler func()) {
+	go func() {
+		<-c
+		handler()
+	}()
+}
+
 // GenericSubscription is implemented with atomic operations.
 type GenericSubscription int32
 
@@ -107,15 +174,27 @@ func NewGenericSubscription() Subscription {
 	return new(GenericSubscription)
 }
 
-func (t *GenericSubscription) Close() error {
+func (t *GenericSubscription) Dispose() {
 	atomic.StoreInt32((*int32)(t), 1)
-	return nil
 }
 
-func (t *GenericSubscription) Closed() bool {
+func (t *GenericSubscription) Disposed() bool {
 	return atomic.LoadInt32((*int32)(t)) == 1
 }
 
+type CallbackSubscription func()
+
+func (c *CallbackSubscription) Dispose() {
+	if *c != nil {
+		(*c)()
+		*c = nil
+	}
+}
+
+func (c *CallbackSubscription) Disposed() bool {
+	return *c == nil
+}
+
 // TerminationObserver contains functions for observing termination of a stream.
 type TerminationObserver interface {
 	Error(error)
@@ -140,6 +219,12 @@ func (f *GenericObserverFunc) Next(next interface{}) { f.f(next, nil, false) }
 func (f *GenericObserverFunc) Error(err error)       { f.f(nil, err, false) }
 func (f *GenericObserverFunc) Complete()             { f.f(nil, nil, true) }
 
+// GenericObservable is implemented by streams of every type, allowing a
+// stream of any type to be used as a signal, eg. for TakeUntil.
+type GenericObservable interface {
+	SubscribeGeneric(GenericObserver) Subscription
+}
+
 type GenericObservableFilter func(next interface{}, err error, complete bool, observer GenericObserver)
 
 // Turtles all the way down!
@@ -180,6 +265,41 @@ func distinctFilter() GenericObservableFilterFactory {
 	}
 }
 
+// distinctDeepEqualFilter is distinctFilter for types whose values may not be
+// comparable, such as slices or interfaces. Those values are compared with
+// reflect.DeepEqual.
+func distinctDeepEqualFilter() GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		seen := map[interface{}]struct{}{}
+		// Values that are not comparable (slices, maps, funcs) can't be map keys.
+		var unhashable []interface{}
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if !reflect.ValueOf(next).Comparable() {
+					for _, v := range unhashable {
+						if reflect.DeepEqual(v, next) {
+							return
+						}
+					}
+					unhashable = append(unhashable, next)
+					observer.Next(next)
+					return
+				}
+				if _, ok := seen[next]; ok {
+					return
+				}
+				seen[next] = struct{}{}
+				observer.Next(next)
+			}
+		}
+	}
+}
+
 func elementAtFilter(n int) GenericObservableFilterFactory {
 	return func(GenericObserver) GenericObservableFilter {
 		i := 0
@@ -381,7 +501,7 @@ func oneFilter() GenericObservableFilterFactory {
 			case err != nil:
 				observer.Error(err)
 			case complete:
-				if count == 2 {
+				if count != 1 {
 					observer.Error(errors.New("expected one value"))
 				} else {
 					observer.Next(value)
@@ -399,6 +519,30 @@ func oneFilter() GenericObservableFilterFactory {
 	}
 }
 
+func sortedFilter(less func(a, b interface{}) bool) GenericObservableFilterFactory {
+	return func(GenericObserver) GenericObservableFilter {
+		values := []interface{}{}
+		flush := func(observer GenericObserver) {
+			sort.SliceStable(values, func(i, j int) bool { return less(values[i], values[j]) })
+			for _, v := range values {
+				observer.Next(v)
+			}
+		}
+		return func(next interface{}, err error, complete bool, observer GenericObserver) {
+			switch {
+			case err != nil:
+				flush(observer)
+				observer.Error(err)
+			case complete:
+				flush(observer)
+				observer.Complete()
+			default:
+				values = append(values, next)
+			}
+		}
+	}
+}
+
 func sampleFilter(window time.Duration) GenericObservableFilterFactory {
 	return func(observer GenericObserver) GenericObservableFilter {
 		mutex := &sync.Mutex{}
@@ -481,6 +625,127 @@ func debounceFilter(duration time.Duration) GenericObservableFilterFactory {
 	}
 }
 
+// deepEqual is reflect.DeepEqual. Files generated for each type use it so
+// that only the core file needs to import reflect.
+func deepEqual(a, b interface{}) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+type delayedEntry struct {
+	next     interface{}
+	complete bool
+	due      time.Time
+}
+
+// delayQueue emits values, and completion, to an observer once they are due,
+// preserving order. Errors are delivered immediately.
+type delayQueue struct {
+	mutex    sync.Mutex
+	observer GenericObserver
+	wake     chan bool
+	stop     chan bool
+	queue    []delayedEntry
+	done     bool
+	last     time.Time
+}
+
+func newDelayQueue(observer GenericObserver) *delayQueue {
+	q := &delayQueue{
+		observer: observer,
+		wake:     make(chan bool, 1),
+		stop:     make(chan bool),
+	}
+	go q.run()
+	return q
+}
+
+func (q *delayQueue) run() {
+	for {
+		q.mutex.Lock()
+		for len(q.queue) == 0 && !q.done {
+			q.mutex.Unlock()
+			select {
+			case <-q.wake:
+			case <-q.stop:
+				return
+			}
+			q.mutex.Lock()
+		}
+		if q.done {
+			q.mutex.Unlock()
+			return
+		}
+		entry := q.queue[0]
+		q.mutex.Unlock()
+		timer := time.NewTimer(entry.due.Sub(time.Now()))
+		select {
+		case <-timer.C:
+		case <-q.stop:
+			timer.Stop()
+			return
+		}
+		q.mutex.Lock()
+		if q.done {
+			q.mutex.Unlock()
+			return
+		}
+		q.queue = q.queue[1:]
+		if entry.complete {
+			q.done = true
+			q.observer.Complete()
+		} else {
+			q.observer.Next(entry.next)
+		}
+		q.mutex.Unlock()
+	}
+}
+
+func (q *delayQueue) enqueue(entry delayedEntry, delay time.Duration) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if q.done {
+		return
+	}
+	// Never schedule before an earlier entry, so order is preserved.
+	entry.due = time.Now().Add(delay)
+	if entry.due.Before(q.last) {
+		entry.due = q.last
+	}
+	q.last = entry.due
+	q.queue = append(q.queue, entry)
+	select {
+	case q.wake <- true:
+	default:
+	}
+}
+
+func (q *delayQueue) error(err error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if !q.done {
+		q.observer.Error(err)
+	}
+	q.terminate()
+}
+
+// dispose discards any pending entries and stops the queue.
+func (q *delayQueue) dispose() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.terminate()
+}
+
+// terminate must be called with mutex held.
+func (q *delayQueue) terminate() {
+	q.done = true
+	q.queue = nil
+	select {
+	case <-q.stop:
+	default:
+		close(q.stop)
+	}
+}
+
 type timedEntry struct {
 	v interface{}
 	t time.Time
@@ -533,17 +798,58 @@ func replayFilter(size int, duration time.Duration) GenericObservableFilterFacto
 	}
 }
 
+// A RetryPolicy decides whether, and after how long, to resubscribe to a
+// stream after attempt consecutive errors. attempt starts at 1, and is reset
+// whenever the stream emits a value.
+type RetryPolicy func(err error, attempt int) (time.Duration, bool)
+
+// ExponentialBackoff returns a RetryPolicy that always retries. The delay
+// starts at initial and is multiplied by multiplier after each attempt, up to
+// max. Each delay is then randomly adjusted by up to +/- jitter (0.0-1.0) of
+// itself.
+func ExponentialBackoff(initial, max time.Duration, multiplier, jitter float64) RetryPolicy {
+	return func(err error, attempt int) (time.Duration, bool) {
+		delay := float64(initial) * math.Pow(multiplier, float64(attempt-1))
+		if delay > float64(max) {
+			delay = float64(max)
+		}
+		delay += delay * jitter * (rand.Float64()*2 - 1)
+		return time.Duration(delay), true
+	}
+}
+
+// NotificationKind is the kind of event a materialized notification represents.
+type NotificationKind int
+
+const (
+	NotificationNext NotificationKind = iota
+	NotificationError
+	NotificationComplete
+)
+
+func (n NotificationKind) String() string {
+	switch n {
+	case NotificationNext:
+		return "Next"
+	case NotificationError:
+		return "Error"
+	case NotificationComplete:
+		return "Complete"
+	}
+	return "NotificationKind(" + strconv.Itoa(int(n)) + ")"
+}
+
 func Range(start, count int) *IntStream {
 	end := start + count
 	return CreateInt(func(observer IntObserver, subscription Subscription) {
 		for i := start; i < end; i++ {
-			if subscription.Closed() {
+			if subscription.Disposed() {
 				return
 			}
 			observer.Next(i)
 		}
 		observer.Complete()
-		subscription.Close()
+		subscription.Dispose()
 	})
 }
 
@@ -552,11 +858,40 @@ func Interval(interval time.Duration) *IntStream {
 		i := 0
 		for {
 			time.Sleep(interval)
-			if subscription.Closed() {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(i)
+			i++
+		}
+	})
+}
+
+// Timer emits 0 after delay, then completes.
+func Timer(delay time.Duration) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		time.Sleep(delay)
+		if subscription.Disposed() {
+			return
+		}
+		observer.Next(0)
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+// TimerPeriodic emits 0 after delay, then an incrementing count every period.
+func TimerPeriodic(delay, period time.Duration) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		time.Sleep(delay)
+		i := 0
+		for {
+			if subscription.Disposed() {
 				return
 			}
 			observer.Next(i)
 			i++
+			time.Sleep(period)
 		}
 	})
 }
@@ -620,7 +955,7 @@ func CreateResponse(f func(observer ResponseObserver, subscription Subscription)
 func RepeatResponse(value *http.Response, count int) *ResponseStream {
 	return CreateResponse(func(observer ResponseObserver, subscription Subscription) {
 		for i := 0; i < count; i++ {
-			if subscription.Closed() {
+			if subscription.Disposed() {
 				return
 			}
 			observer.Next(value)
@@ -645,6 +980,16 @@ func StartResponse(f func() (*http.Response, error)) *ResponseStream {
 	})
 }
 
+type deferResponseObservable func() ResponseObservable
+
+func (f deferResponseObservable) Subscribe(observer ResponseObserver) Subscription {
+	return f().Subscribe(observer)
+}
+
+// DeferResponse calls f to create a fresh observable for each subscription.
+func DeferResponse(f func() ResponseObservable) *ResponseStream {
+	return FromResponseObservable(deferResponseObservable(f))
+}
 func PassthroughResponse(next *http.Response, err error, complete bool, observer ResponseObserver) {
 	switch {
 	case err != nil:
@@ -695,17 +1040,16 @@ func ThrowResponse(err error) *ResponseStream {
 		observer.Error(err)
 	})
 }
-
 func FromResponseArray(array []*http.Response) *ResponseStream {
 	return CreateResponse(func(observer ResponseObserver, subscription Subscription) {
 		for _, v := range array {
-			if subscription.Closed() {
+			if subscription.Disposed() {
 				return
 			}
 			observer.Next(v)
 		}
 		observer.Complete()
-		subscription.Close()
+		subscription.Dispose()
 	})
 }
 
@@ -713,6 +1057,20 @@ func FromResponses(array ...*http.Response) *ResponseStream {
 	return FromResponseArray(array)
 }
 
+// FromResponseSeq emits each value yielded by seq, iterating it once per
+// subscription.
+func FromResponseSeq(seq iter.Seq[*http.Response]) *ResponseStream {
+	return CreateResponse(func(observer ResponseObserver, subscription Subscription) {
+		for v := range seq {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
 func JustResponse(element *http.Response) *ResponseStream {
 	return FromResponseArray([]*http.Response{element})
 }
@@ -731,10 +1089,55 @@ func MergeResponseDelayError(observables ...ResponseObservable) *ResponseStream
 	return (&ResponseStream{observables[0]}).MergeDelayError(observables[1:]...)
 }
 
+type ambResponseObservable []ResponseObservable
+
+func (a ambResponseObservable) Subscribe(observer ResponseObserver) Subscription {
+	lock := sync.Mutex{}
+	winner := -1
+	subscriptions := make([]*LinkedSubscription, len(a))
+	for i := range subscriptions {
+		subscriptions[i] = NewLinkedSubscription()
+	}
+	for i, observable := range a {
+		i := i
+		subscriptions[i].Link(observable.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+			lock.Lock()
+			if winner == -1 {
+				winner = i
+				for j, subscription := range subscriptions {
+					if j != i {
+						subscription.Dispose()
+					}
+				}
+			}
+			won := winner == i
+			lock.Unlock()
+			if won {
+				PassthroughResponse(next, err, complete, observer)
+			}
+		})))
+	}
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		for _, s := range subscriptions {
+			s.Dispose()
+		}
+	})
+	return subscription
+}
+
+// AmbResponse subscribes to all observables and mirrors whichever emits
+// first, disposing the others.
+func AmbResponse(observables ...ResponseObservable) *ResponseStream {
+	if len(observables) == 0 {
+		return EmptyResponse()
+	}
+	return FromResponseObservable(ambResponseObservable(observables))
+}
 func FromResponseChannel(ch <-chan *http.Response) *ResponseStream {
 	return CreateResponse(func(observer ResponseObserver, subscription Subscription) {
 		for v := range ch {
-			if subscription.Closed() {
+			if subscription.Disposed() {
 				return
 			}
 			observer.Next(v)
@@ -763,7 +1166,13 @@ func (s *ResponseStream) SubscribeNext(f func(v *http.Response)) Subscription {
 	})
 }
 
-// Distinct removes duplicate elements in the stream.
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *ResponseStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsResponseObserver(observer))
+}
+
+// Distinct removes duplicate elements in the stream. Values that can not be
+// map keys, such as slices, are compared with reflect.DeepEqual.
 func (s *ResponseStream) Distinct() *ResponseStream {
 	return FromResponseObservable(distinctFilter().Response(s))
 }
@@ -808,6 +1217,67 @@ func (s *ResponseStream) TakeLast(n int) *ResponseStream {
 	return FromResponseObservable(takeLastFilter(n).Response(s))
 }
 
+type takeWhileResponse struct {
+	parent ResponseObservable
+	f      func(*http.Response) bool
+}
+
+func (t *takeWhileResponse) Subscribe(observer ResponseObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	parent.Link(t.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Complete()
+		case t.f(next):
+			observer.Next(next)
+		default:
+			done = true
+			observer.Complete()
+			parent.Dispose()
+		}
+	})))
+	return parent
+}
+
+// TakeWhile returns elements of the stream until f returns false, then
+// completes and disposes the stream.
+func (s *ResponseStream) TakeWhile(f func(*http.Response) bool) *ResponseStream {
+	return &ResponseStream{&takeWhileResponse{s, f}}
+}
+
+// SkipWhile skips elements of the stream until f returns false.
+func (s *ResponseStream) SkipWhile(f func(*http.Response) bool) *ResponseStream {
+	return FromResponseObservable(MapResponse2ResponseObservable(s, func(ResponseObserver) MappingResponse2ResponseFunc {
+		skipping := true
+		return func(next *http.Response, err error, complete bool, observer ResponseObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if skipping && !f(next) {
+					skipping = false
+				}
+				if !skipping {
+					observer.Next(next)
+				}
+			}
+		}
+	}))
+}
+
 // IgnoreElements ignores elements of the stream and emits only the completion events.
 func (s *ResponseStream) IgnoreElements() *ResponseStream {
 	return FromResponseObservable(ignoreElementsFilter().Response(s))
@@ -825,6 +1295,45 @@ func (s *ResponseStream) Debounce(duration time.Duration) *ResponseStream {
 	return FromResponseObservable(debounceFilter(duration).Response(s))
 }
 
+type delayResponse struct {
+	parent     ResponseObservable
+	delay      func(*http.Response) time.Duration
+	completion time.Duration
+}
+
+func (d *delayResponse) Subscribe(observer ResponseObserver) Subscription {
+	queue := newDelayQueue(ResponseObserverAsGenericObserver(observer))
+	parent := d.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+		switch {
+		case err != nil:
+			queue.error(err)
+		case complete:
+			queue.enqueue(delayedEntry{complete: true}, d.completion)
+		default:
+			queue.enqueue(delayedEntry{next: next}, d.delay(next))
+		}
+	}))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		queue.dispose()
+	})
+	return subscription
+}
+
+// Delay shifts each value, and completion, forward in time by duration. Errors
+// are not delayed. Values that are still pending are discarded on disposal.
+func (s *ResponseStream) Delay(duration time.Duration) *ResponseStream {
+	return &ResponseStream{&delayResponse{s, func(*http.Response) time.Duration { return duration }, duration}}
+}
+
+// DelayWhen shifts each value forward in time by the duration returned by f.
+// Values are never reordered, so a value is emitted no earlier than the value
+// before it. Completion is emitted after the last value. Errors are not delayed.
+func (s *ResponseStream) DelayWhen(f func(*http.Response) time.Duration) *ResponseStream {
+	return &ResponseStream{&delayResponse{s, f, 0}}
+}
+
 // Wait for completion of the stream and return any error.
 func (s *ResponseStream) Wait() error {
 	errch := make(chan error, 1)
@@ -861,14 +1370,14 @@ func (c *concatResponseSubscriber) Next(next *http.Response) {
 func (c *concatResponseSubscriber) Error(err error) {
 	c.observer.Error(err)
 	c.observable = len(c.observables)
-	c.Close()
+	c.Dispose()
 }
 
 func (c *concatResponseSubscriber) Complete() {
 	c.observable++
 	if c.observable >= len(c.observables) {
 		c.observer.Complete()
-		c.Close()
+		c.Dispose()
 		return
 	}
 	c.observables[c.observable].Subscribe(c)
@@ -896,31 +1405,95 @@ func (s *ResponseStream) Concat(observables ...ResponseObservable) *ResponseStre
 	return &ResponseStream{&concatResponseObservable{append([]ResponseObservable{s}, observables...)}}
 }
 
-type mergeResponseObservable struct {
-	delayError  bool
-	observables []ResponseObservable
+// StartWith emits values before the values of the stream.
+func (s *ResponseStream) StartWith(values ...*http.Response) *ResponseStream {
+	return FromResponseArray(values).Concat(s)
 }
 
-func (m *mergeResponseObservable) Subscribe(observer ResponseObserver) Subscription {
-	subscription := NewGenericSubscription()
-	lock := sync.Mutex{}
-	completed := 0
-	var firstError error
-	relay := func(next *http.Response, err error, complete bool) {
-		lock.Lock()
-		defer lock.Unlock()
-		if completed >= len(m.observables) {
-			return
-		}
+// EndWith emits values after the stream completes.
+func (s *ResponseStream) EndWith(values ...*http.Response) *ResponseStream {
+	return s.Concat(FromResponseArray(values))
+}
 
-		switch {
-		case err != nil:
-			if m.delayError {
-				firstError = err
-				completed++
-			} else {
+// DefaultIfEmpty emits value if the stream completes without emitting any values.
+func (s *ResponseStream) DefaultIfEmpty(value *http.Response) *ResponseStream {
+	return FromResponseObservable(MapResponse2ResponseObservable(s, func(ResponseObserver) MappingResponse2ResponseFunc {
+		empty := true
+		return func(next *http.Response, err error, complete bool, observer ResponseObserver) {
+			switch {
+			case err != nil:
 				observer.Error(err)
-				completed = len(m.observables)
+			case complete:
+				if empty {
+					observer.Next(value)
+				}
+				observer.Complete()
+			default:
+				empty = false
+				observer.Next(next)
+			}
+		}
+	}))
+}
+
+type switchIfEmptyResponseObservable struct {
+	parent ResponseObservable
+	other  ResponseObservable
+}
+
+func (e *switchIfEmptyResponseObservable) Subscribe(observer ResponseObserver) Subscription {
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
+	empty := true
+	link.Link(e.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			if !empty {
+				observer.Complete()
+			} else if !subscription.Disposed() {
+				subscription.Set(e.other.Subscribe(observer))
+			}
+		default:
+			empty = false
+			observer.Next(next)
+		}
+	})))
+	return subscription
+}
+
+// SwitchIfEmpty switches to other if the stream completes without emitting any values.
+func (s *ResponseStream) SwitchIfEmpty(other ResponseObservable) *ResponseStream {
+	return &ResponseStream{&switchIfEmptyResponseObservable{s, other}}
+}
+
+type mergeResponseObservable struct {
+	delayError  bool
+	observables []ResponseObservable
+}
+
+func (m *mergeResponseObservable) Subscribe(observer ResponseObserver) Subscription {
+	subscription := NewGenericSubscription()
+	lock := sync.Mutex{}
+	completed := 0
+	var firstError error
+	relay := func(next *http.Response, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if completed >= len(m.observables) {
+			return
+		}
+
+		switch {
+		case err != nil:
+			if m.delayError {
+				firstError = err
+				completed++
+			} else {
+				observer.Error(err)
+				completed = len(m.observables)
 			}
 
 		case complete:
@@ -960,60 +1533,132 @@ func (s *ResponseStream) MergeDelayError(other ...ResponseObservable) *ResponseS
 	return &ResponseStream{&mergeResponseObservable{true, append(other, s)}}
 }
 
+// Amb mirrors whichever of this stream and others emits first, disposing the rest.
+func (s *ResponseStream) Amb(others ...ResponseObservable) *ResponseStream {
+	return AmbResponse(append([]ResponseObservable{s}, others...)...)
+}
+
 type catchResponseObservable struct {
 	parent ResponseObservable
-	catch  ResponseObservable
+	catch  func(err error) ResponseObservable
+	// Also switch to the fallback when the parent completes. err will be nil.
+	resume bool
 }
 
 func (r *catchResponseObservable) Subscribe(observer ResponseObserver) Subscription {
-	subscription := NewGenericSubscription()
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
 	run := func(next *http.Response, err error, complete bool) {
 		switch {
-		case err != nil:
-			r.catch.Subscribe(observer)
+		case err != nil || (complete && r.resume):
+			if !subscription.Disposed() {
+				subscription.Set(r.catch(err).Subscribe(observer))
+			}
 		case complete:
 			observer.Complete()
 		default:
 			observer.Next(next)
 		}
 	}
-	r.parent.Subscribe(ResponseObserverFunc(run))
+	link.Link(r.parent.Subscribe(ResponseObserverFunc(run)))
 	return subscription
 }
 
+// Catch switches to the catch observable if the stream errors.
 func (s *ResponseStream) Catch(catch ResponseObservable) *ResponseStream {
-	return &ResponseStream{&catchResponseObservable{s, catch}}
+	return s.CatchFunc(func(error) ResponseObservable { return catch })
 }
 
-type retryResponseObservable struct {
-	observable ResponseObservable
+// CatchFunc switches to the observable returned by f(err) if the stream errors.
+func (s *ResponseStream) CatchFunc(f func(err error) ResponseObservable) *ResponseStream {
+	return &ResponseStream{&catchResponseObservable{parent: s, catch: f}}
 }
 
-type retryResponseObserver struct {
-	observable ResponseObservable
-	observer   ResponseObserver
+// OnErrorReturn emits the single value returned by f(err) and completes if the stream errors.
+func (s *ResponseStream) OnErrorReturn(f func(err error) *http.Response) *ResponseStream {
+	return s.CatchFunc(func(err error) ResponseObservable { return JustResponse(f(err)) })
 }
 
-func (r *retryResponseObserver) retry(next *http.Response, err error, complete bool) {
-	switch {
-	case err != nil:
-		r.observable.Subscribe(ResponseObserverFunc(r.retry))
-	case complete:
-		r.observer.Complete()
-	default:
-		r.observer.Next(next)
-	}
+// OnErrorResumeNext switches to next when the stream terminates, whether it
+// completes or errors.
+func (s *ResponseStream) OnErrorResumeNext(next ResponseObservable) *ResponseStream {
+	return &ResponseStream{&catchResponseObservable{
+		parent: s,
+		catch:  func(error) ResponseObservable { return next },
+		resume: true,
+	}}
+}
+
+type retryResponseObservable struct {
+	observable ResponseObservable
+	policy     RetryPolicy
 }
 
 func (r *retryResponseObservable) Subscribe(observer ResponseObserver) Subscription {
-	subscription := NewGenericSubscription()
-	ro := &retryResponseObserver{r.observable, observer}
-	r.observable.Subscribe(ResponseObserverFunc(ro.retry))
+	subscription := NewSerialSubscription()
+	attempt := 0
+	var subscribe func()
+	subscribe = func() {
+		// Set the link before subscribing, in case the observable fails
+		// and is resubscribed before Subscribe returns.
+		link := NewLinkedSubscription()
+		subscription.Set(link)
+		link.Link(r.observable.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+			switch {
+			case err != nil:
+				if subscription.Disposed() {
+					return
+				}
+				attempt++
+				delay, ok := r.policy(err, attempt)
+				switch {
+				case !ok:
+					observer.Error(err)
+				case delay > 0:
+					time.AfterFunc(delay, func() {
+						if !subscription.Disposed() {
+							subscribe()
+						}
+					})
+				default:
+					subscribe()
+				}
+			case complete:
+				observer.Complete()
+			default:
+				attempt = 0
+				observer.Next(next)
+			}
+		})))
+	}
+	subscribe()
 	return subscription
 }
 
+// Retry resubscribes to the stream immediately, and indefinitely, on error.
 func (s *ResponseStream) Retry() *ResponseStream {
-	return &ResponseStream{&retryResponseObservable{s}}
+	return s.RetryWhen(func(error, int) (time.Duration, bool) { return 0, true })
+}
+
+// RetryN resubscribes to the stream on error, at most n times in a row without
+// the stream emitting a value in between.
+func (s *ResponseStream) RetryN(n int) *ResponseStream {
+	return s.RetryWhen(func(err error, attempt int) (time.Duration, bool) { return 0, attempt <= n })
+}
+
+// RetryWhen calls policy on each error to decide whether, and after how long,
+// to resubscribe to the stream. attempt counts consecutive errors, starting at
+// 1 and reset whenever the stream emits a value.
+func (s *ResponseStream) RetryWhen(policy func(err error, attempt int) (time.Duration, bool)) *ResponseStream {
+	return &ResponseStream{&retryResponseObservable{s, policy}}
+}
+
+// RetryWithBackoff resubscribes to the stream on error, indefinitely, with
+// an ExponentialBackoff delay. The delay is reset to initial whenever the
+// stream emits a value.
+func (s *ResponseStream) RetryWithBackoff(initial, max time.Duration, multiplier, jitter float64) *ResponseStream {
+	return s.RetryWhen(ExponentialBackoff(initial, max, multiplier, jitter))
 }
 
 // Do applies a function for each value passing through the stream.
@@ -1043,7 +1688,6 @@ func (s *ResponseStream) DoOnComplete(f func()) *ResponseStream {
 		PassthroughResponse(next, err, complete, observer)
 	}))
 }
-
 func (s *ResponseStream) Reduce(initial *http.Response, reducer func(*http.Response, *http.Response) *http.Response) *ResponseStream {
 	value := initial
 	return FromResponseObservable(MapResponse2ResponseObserveDirect(s, func(next *http.Response, err error, complete bool, observer ResponseObserver) {
@@ -1087,10 +1731,10 @@ func (t *timeoutResponse) Subscribe(observer ResponseObserver) Subscription {
 		select {
 		case <-time.After(t.timeout):
 			observer.Error(ErrTimeout)
-			_ = cancel.Close()
-			_ = subscription.Close()
+			cancel.Dispose()
+			subscription.Dispose()
 		case <-subscription:
-			_ = cancel.Close()
+			cancel.Dispose()
 		}
 	}()
 	return subscription
@@ -1098,7 +1742,192 @@ func (t *timeoutResponse) Subscribe(observer ResponseObserver) Subscription {
 
 func (s *ResponseStream) Timeout(timeout time.Duration) *ResponseStream {
 	return &ResponseStream{&timeoutResponse{s, timeout}}
+}
+
+type delaySubscriptionResponse struct {
+	parent ResponseObservable
+	delay  time.Duration
+}
+
+func (d *delaySubscriptionResponse) Subscribe(observer ResponseObserver) Subscription {
+	subscription := NewLinkedSubscription()
+	time.AfterFunc(d.delay, func() {
+		if !subscription.Disposed() {
+			subscription.Link(d.parent.Subscribe(observer))
+		}
+	})
+	return subscription
+}
+
+// DelaySubscription waits for delay before subscribing to the stream.
+func (s *ResponseStream) DelaySubscription(delay time.Duration) *ResponseStream {
+	return &ResponseStream{&delaySubscriptionResponse{s, delay}}
+}
+
+type takeUntilResponse struct {
+	parent ResponseObservable
+	signal GenericObservable
+}
+
+func (t *takeUntilResponse) Subscribe(observer ResponseObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			// A signal that completes without a value never ends the stream.
+			break
+		default:
+			terminate(observer.Complete)
+		}
+	})))
+	parent.Link(t.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// TakeUntil returns elements of the stream until signal, which may be a
+// stream of any type, emits a value. The stream then completes.
+func (s *ResponseStream) TakeUntil(signal GenericObservable) *ResponseStream {
+	return &ResponseStream{&takeUntilResponse{s, signal}}
+}
+
+type skipUntilResponse struct {
+	parent ResponseObservable
+	signal GenericObservable
+}
+
+func (t *skipUntilResponse) Subscribe(observer ResponseObserver) Subscription {
+	lock := sync.Mutex{}
+	open := false
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			break
+		default:
+			lock.Lock()
+			open = true
+			lock.Unlock()
+			signal.Dispose()
+		}
+	})))
+	parent.Link(t.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if open && !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// SkipUntil skips elements of the stream until signal, which may be a stream
+// of any type, emits a value.
+func (s *ResponseStream) SkipUntil(signal GenericObservable) *ResponseStream {
+	return &ResponseStream{&skipUntilResponse{s, signal}}
+}
 
+type forkedResponseStream struct {
+	lock      sync.Mutex
+	parent    ResponseObservable
+	observers []ResponseObserver
+}
+
+func (f *forkedResponseStream) Subscribe(observer ResponseObserver) Subscription {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	i := len(f.observers)
+	f.observers = append(f.observers, observer)
+	sub := new(CallbackSubscription)
+	*sub = CallbackSubscription(func() {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		f.observers[i] = nil
+	})
+	return sub
+}
+
+// Fork replicates each event from the parent to every subscriber of the fork.
+func (s *ResponseStream) Fork() *ResponseStream {
+	f := &forkedResponseStream{parent: s}
+	go s.Subscribe(ResponseObserverFunc(func(n *http.Response, err error, complete bool) {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		for _, o := range f.observers {
+			if o == nil {
+				continue
+			}
+			switch {
+			case err != nil:
+				o.Error(err)
+			case complete:
+				o.Complete()
+			default:
+				o.Next(n)
+			}
+		}
+	}))
+	return &ResponseStream{f}
 }
 
 // ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
@@ -1181,6 +2010,55 @@ func (s *ResponseStream) ToChannel() <-chan *http.Response {
 	return ch
 }
 
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *ResponseStream) Seq() iter.Seq[*http.Response] {
+	return func(yield func(*http.Response) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *ResponseStream) Seq2() iter.Seq2[*http.Response, error] {
+	return func(yield func(*http.Response, error) bool) {
+		type notification struct {
+			next     *http.Response
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next *http.Response, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroResponse, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
 // Count returns an IntStream with the count of elements in this stream.
 func (s *ResponseStream) Count() *IntStream {
 	count := 0
@@ -1198,104 +2076,336 @@ func (s *ResponseStream) Count() *IntStream {
 	}))
 }
 
-type MappingResponse2ResponseFunc func(next *http.Response, err error, complete bool, observer ResponseObserver)
-type MappingResponse2ResponseFuncFactory func(observer ResponseObserver) MappingResponse2ResponseFunc
-
-type MappingResponse2ResponseObservable struct {
-	parent ResponseObservable
-	mapper MappingResponse2ResponseFuncFactory
-}
-
-func (f *MappingResponse2ResponseObservable) Subscribe(observer ResponseObserver) Subscription {
-	mapper := f.mapper(observer)
-	return f.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
-		mapper(next, err, complete, observer)
-	}))
-}
-
-func MapResponse2ResponseObservable(parent ResponseObservable, mapper MappingResponse2ResponseFuncFactory) ResponseObservable {
-	return &MappingResponse2ResponseObservable{
-		parent: parent,
-		mapper: mapper,
-	}
-}
-
-func MapResponse2ResponseObserveDirect(parent ResponseObservable, mapper MappingResponse2ResponseFunc) ResponseObservable {
-	return MapResponse2ResponseObservable(parent, func(ResponseObserver) MappingResponse2ResponseFunc {
-		return mapper
-	})
-}
-
-func MapResponse2ResponseObserveNext(parent ResponseObservable, mapper func(*http.Response) *http.Response) ResponseObservable {
-	return MapResponse2ResponseObservable(parent, func(ResponseObserver) MappingResponse2ResponseFunc {
-		return func(next *http.Response, err error, complete bool, observer ResponseObserver) {
-			var mapped *http.Response
-			if err == nil && !complete {
-				mapped = mapper(next)
-			}
-			PassthroughResponse(mapped, err, complete, observer)
-		}
-	},
-	)
+func equalResponse(a, b *http.Response) bool {
+	return a == b
 }
 
-type flatMapResponse2Response struct {
+type decideResponse struct {
 	parent ResponseObservable
-	mapper func(*http.Response) ResponseObservable
+	// decide returns the result and true once the answer is known.
+	decide func(next *http.Response) (bool, bool)
+	// otherwise is the result if the stream completes first.
+	otherwise bool
 }
 
-func (f *flatMapResponse2Response) Subscribe(observer ResponseObserver) Subscription {
-	subscription := NewGenericSubscription()
-	wg := sync.WaitGroup{}
-	f.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+func (d *decideResponse) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	subscription := NewLinkedSubscription()
+	subscription.Link(d.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
 		switch {
 		case err != nil:
-			wg.Wait()
+			done = true
 			observer.Error(err)
 		case complete:
-			wg.Wait()
+			done = true
+			observer.Next(d.otherwise)
 			observer.Complete()
 		default:
-			wg.Add(1)
-			observable := f.mapper(next)
-			stream := (&ResponseStream{observable}).
-				DoOnComplete(func() { wg.Done() }).
-				DoOnError(func(error) { wg.Done() })
-			stream = &ResponseStream{ignoreCompletionFilter().Response(stream)}
-			stream.Subscribe(observer)
+			if result, ok := d.decide(next); ok {
+				done = true
+				observer.Next(result)
+				observer.Complete()
+				subscription.Dispose()
+			}
 		}
-	}))
+	})))
 	return subscription
 }
 
-// Map maps values in this stream to another value.
-func (s *ResponseStream) Map(f func(*http.Response) *http.Response) *ResponseStream {
-	return FromResponseObservable(MapResponse2ResponseObserveNext(s, f))
+// All emits true if f returns true for every value in the stream, or false
+// as soon as it does not.
+func (s *ResponseStream) All(f func(*http.Response) bool) *BoolStream {
+	return FromBoolObservable(&decideResponse{s, func(next *http.Response) (bool, bool) { return false, !f(next) }, true})
 }
 
-func (s *ResponseStream) FlatMap(f func(*http.Response) ResponseObservable) *ResponseStream {
-	return &ResponseStream{&flatMapResponse2Response{s, f}}
+// Any emits true as soon as f returns true for a value in the stream, or
+// false if it never does.
+func (s *ResponseStream) Any(f func(*http.Response) bool) *BoolStream {
+	return FromBoolObservable(&decideResponse{s, func(next *http.Response) (bool, bool) { return true, f(next) }, false})
 }
 
-type MappingResponse2StringFunc func(next *http.Response, err error, complete bool, observer StringObserver)
-type MappingResponse2StringFuncFactory func(observer StringObserver) MappingResponse2StringFunc
+// Contains emits true as soon as value is seen in the stream, or false if it
+// never is.
+func (s *ResponseStream) Contains(value *http.Response) *BoolStream {
+	return s.Any(func(next *http.Response) bool { return equalResponse(next, value) })
+}
 
-type MappingResponse2StringObservable struct {
-	parent ResponseObservable
-	mapper MappingResponse2StringFuncFactory
+// IsEmpty emits true if the stream completes without a value, or false as
+// soon as it emits one.
+func (s *ResponseStream) IsEmpty() *BoolStream {
+	return FromBoolObservable(&decideResponse{s, func(*http.Response) (bool, bool) { return false, true }, true})
 }
 
-func (f *MappingResponse2StringObservable) Subscribe(observer StringObserver) Subscription {
-	mapper := f.mapper(observer)
-	return f.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
-		mapper(next, err, complete, observer)
-	}))
+type sequenceEqualResponse struct {
+	parent ResponseObservable
+	other  ResponseObservable
 }
 
-func MapResponse2StringObservable(parent ResponseObservable, mapper MappingResponse2StringFuncFactory) StringObservable {
-	return &MappingResponse2StringObservable{
-		parent: parent,
-		mapper: mapper,
+func (e *sequenceEqualResponse) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	// Values seen on one side that the other has not caught up with yet.
+	// Only one side can be ahead at a time.
+	pending := [2][]*http.Response{}
+	completed := [2]bool{}
+	subscriptions := [2]*LinkedSubscription{NewLinkedSubscription(), NewLinkedSubscription()}
+	dispose := func() {
+		subscriptions[0].Dispose()
+		subscriptions[1].Dispose()
+	}
+	finish := func(result bool) {
+		done = true
+		observer.Next(result)
+		observer.Complete()
+		dispose()
+	}
+	observe := func(i int) ResponseObserver {
+		j := 1 - i
+		return ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+			lock.Lock()
+			defer lock.Unlock()
+			if done {
+				return
+			}
+			switch {
+			case err != nil:
+				done = true
+				observer.Error(err)
+				dispose()
+			case complete:
+				completed[i] = true
+				if len(pending[j]) > 0 {
+					finish(false)
+				} else if completed[j] {
+					finish(true)
+				}
+			case len(pending[j]) > 0:
+				expected := pending[j][0]
+				pending[j] = pending[j][1:]
+				if !equalResponse(next, expected) {
+					finish(false)
+				}
+			case completed[j]:
+				finish(false)
+			default:
+				pending[i] = append(pending[i], next)
+			}
+		})
+	}
+	subscriptions[0].Link(e.parent.Subscribe(observe(0)))
+	subscriptions[1].Link(e.other.Subscribe(observe(1)))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(dispose)
+	return subscription
+}
+
+// SequenceEqual emits true if the stream and other emit equal values in the
+// same order and both complete, or false as soon as they differ.
+func (s *ResponseStream) SequenceEqual(other ResponseObservable) *BoolStream {
+	return FromBoolObservable(&sequenceEqualResponse{s, other})
+}
+
+// ResponseNotification is a materialized event from a ResponseStream.
+type ResponseNotification struct {
+	Kind  NotificationKind
+	Value *http.Response // Set if Kind is NotificationNext.
+	Err   error          // Set if Kind is NotificationError.
+}
+
+// Materialize emits every event of the stream, including termination, as a
+// ResponseNotification. The materialized stream completes after the
+// notification for the termination of this stream.
+func (s *ResponseStream) Materialize() *ResponseNotificationStream {
+	return FromResponseNotificationObservable(MapResponse2ResponseNotificationObserveDirect(s, func(next *http.Response, err error, complete bool, observer ResponseNotificationObserver) {
+		switch {
+		case err != nil:
+			observer.Next(ResponseNotification{Kind: NotificationError, Err: err})
+			observer.Complete()
+		case complete:
+			observer.Next(ResponseNotification{Kind: NotificationComplete})
+			observer.Complete()
+		default:
+			observer.Next(ResponseNotification{Kind: NotificationNext, Value: next})
+		}
+	}))
+}
+
+// Dematerialize converts materialized notifications back into the events they
+// represent. Notifications after the first termination are ignored.
+func (s *ResponseNotificationStream) Dematerialize() *ResponseStream {
+	return FromResponseObservable(MapResponseNotification2ResponseObservable(s, func(ResponseObserver) MappingResponseNotification2ResponseFunc {
+		terminated := false
+		return func(next ResponseNotification, err error, complete bool, observer ResponseObserver) {
+			if terminated {
+				return
+			}
+			switch {
+			case err != nil:
+				terminated = true
+				observer.Error(err)
+			case complete:
+				terminated = true
+				observer.Complete()
+			default:
+				switch next.Kind {
+				case NotificationNext:
+					observer.Next(next.Value)
+				case NotificationError:
+					terminated = true
+					observer.Error(next.Err)
+				case NotificationComplete:
+					terminated = true
+					observer.Complete()
+				}
+			}
+		}
+	}))
+}
+
+// TimestampedResponse is a value from a ResponseStream and the time it was emitted.
+type TimestampedResponse struct {
+	Value *http.Response
+	Time  time.Time
+}
+
+// Timestamp annotates each value in the stream with the time it was emitted.
+func (s *ResponseStream) Timestamp() *TimestampedResponseStream {
+	return FromTimestampedResponseObservable(MapResponse2TimestampedResponseObserveNext(s, func(next *http.Response) TimestampedResponse {
+		return TimestampedResponse{next, Now()}
+	}))
+}
+
+// IntervalResponse is a value from a ResponseStream and the time elapsed
+// since the previous value was emitted.
+type IntervalResponse struct {
+	Value    *http.Response
+	Interval time.Duration
+}
+
+// TimeInterval annotates each value in the stream with the time elapsed since
+// the previous value, or since subscription for the first value.
+func (s *ResponseStream) TimeInterval() *IntervalResponseStream {
+	return FromIntervalResponseObservable(MapResponse2IntervalResponseObservable(s, func(IntervalResponseObserver) MappingResponse2IntervalResponseFunc {
+		last := Now()
+		return func(next *http.Response, err error, complete bool, observer IntervalResponseObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				now := Now()
+				observer.Next(IntervalResponse{next, now.Sub(last)})
+				last = now
+			}
+		}
+	}))
+}
+
+type MappingResponse2ResponseFunc func(next *http.Response, err error, complete bool, observer ResponseObserver)
+type MappingResponse2ResponseFuncFactory func(observer ResponseObserver) MappingResponse2ResponseFunc
+
+type MappingResponse2ResponseObservable struct {
+	parent ResponseObservable
+	mapper MappingResponse2ResponseFuncFactory
+}
+
+func (f *MappingResponse2ResponseObservable) Subscribe(observer ResponseObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapResponse2ResponseObservable(parent ResponseObservable, mapper MappingResponse2ResponseFuncFactory) ResponseObservable {
+	return &MappingResponse2ResponseObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapResponse2ResponseObserveDirect(parent ResponseObservable, mapper MappingResponse2ResponseFunc) ResponseObservable {
+	return MapResponse2ResponseObservable(parent, func(ResponseObserver) MappingResponse2ResponseFunc {
+		return mapper
+	})
+}
+
+func MapResponse2ResponseObserveNext(parent ResponseObservable, mapper func(*http.Response) *http.Response) ResponseObservable {
+	return MapResponse2ResponseObservable(parent, func(ResponseObserver) MappingResponse2ResponseFunc {
+		return func(next *http.Response, err error, complete bool, observer ResponseObserver) {
+			var mapped *http.Response
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughResponse(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapResponse2Response struct {
+	parent ResponseObservable
+	mapper func(*http.Response) ResponseObservable
+}
+
+func (f *flatMapResponse2Response) Subscribe(observer ResponseObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&ResponseStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &ResponseStream{ignoreCompletionFilter().Response(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// Map maps values in this stream to another value.
+func (s *ResponseStream) Map(f func(*http.Response) *http.Response) *ResponseStream {
+	return FromResponseObservable(MapResponse2ResponseObserveNext(s, f))
+}
+
+func (s *ResponseStream) FlatMap(f func(*http.Response) ResponseObservable) *ResponseStream {
+	return &ResponseStream{&flatMapResponse2Response{s, f}}
+}
+
+type MappingResponse2StringFunc func(next *http.Response, err error, complete bool, observer StringObserver)
+type MappingResponse2StringFuncFactory func(observer StringObserver) MappingResponse2StringFunc
+
+type MappingResponse2StringObservable struct {
+	parent ResponseObservable
+	mapper MappingResponse2StringFuncFactory
+}
+
+func (f *MappingResponse2StringObservable) Subscribe(observer StringObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapResponse2StringObservable(parent ResponseObservable, mapper MappingResponse2StringFuncFactory) StringObservable {
+	return &MappingResponse2StringObservable{
+		parent: parent,
+		mapper: mapper,
 	}
 }
 
@@ -1435,91 +2545,278 @@ func (s *ResponseStream) FlatMapInt(f func(*http.Response) IntObservable) *IntSt
 	return &IntStream{&flatMapResponse2Int{s, f}}
 }
 
-type StringObserver interface {
-	Next(string)
-	TerminationObserver
+type MappingResponse2BoolFunc func(next *http.Response, err error, complete bool, observer BoolObserver)
+type MappingResponse2BoolFuncFactory func(observer BoolObserver) MappingResponse2BoolFunc
+
+type MappingResponse2BoolObservable struct {
+	parent ResponseObservable
+	mapper MappingResponse2BoolFuncFactory
 }
 
-// A StringSubscriber represents a subscribed StringObserver.
-type StringSubscriber interface {
-	Subscription
-	StringObserver
+func (f *MappingResponse2BoolObservable) Subscribe(observer BoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
 }
 
-type implStringSubscriber struct {
-	Subscription
-	StringObserver
+func MapResponse2BoolObservable(parent ResponseObservable, mapper MappingResponse2BoolFuncFactory) BoolObservable {
+	return &MappingResponse2BoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
 }
 
-func StringObserverAsGenericObserver(observer StringObserver) GenericObserver {
-	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
-		switch {
-		case err != nil:
-			observer.Error(err)
-		case complete:
-			observer.Complete()
-		default:
-			observer.Next(next.(string))
-		}
+func MapResponse2BoolObserveDirect(parent ResponseObservable, mapper MappingResponse2BoolFunc) BoolObservable {
+	return MapResponse2BoolObservable(parent, func(BoolObserver) MappingResponse2BoolFunc {
+		return mapper
 	})
 }
 
-func GenericObserverAsStringObserver(observer GenericObserver) StringObserver {
-	return StringObserverFunc(func(next string, err error, complete bool) {
+func MapResponse2BoolObserveNext(parent ResponseObservable, mapper func(*http.Response) bool) BoolObservable {
+	return MapResponse2BoolObservable(parent, func(BoolObserver) MappingResponse2BoolFunc {
+		return func(next *http.Response, err error, complete bool, observer BoolObserver) {
+			var mapped bool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapResponse2Bool struct {
+	parent ResponseObservable
+	mapper func(*http.Response) BoolObservable
+}
+
+func (f *flatMapResponse2Bool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
 		switch {
 		case err != nil:
+			wg.Wait()
 			observer.Error(err)
 		case complete:
+			wg.Wait()
 			observer.Complete()
 		default:
-			observer.Next(next)
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&BoolStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &BoolStream{ignoreCompletionFilter().Bool(stream)}
+			stream.Subscribe(observer)
 		}
-	})
+	}))
+	return subscription
 }
 
-type StringObservableFactory func(observer StringObserver, subscription Subscription)
+// MapBool maps this stream to an BoolStream via f.
+func (s *ResponseStream) MapBool(f func(*http.Response) bool) *BoolStream {
+	return FromBoolObservable(MapResponse2BoolObserveNext(s, f))
+}
 
-func (f StringObservableFactory) Subscribe(observer StringObserver) Subscription {
-	subscription := NewGenericSubscription()
-	go f(observer, subscription)
-	return subscription
+func (s *ResponseStream) FlatMapBool(f func(*http.Response) BoolObservable) *BoolStream {
+	return &BoolStream{&flatMapResponse2Bool{s, f}}
 }
 
-// CreateString calls f(observer, subscription) to produce values for a stream.
-func CreateString(f func(observer StringObserver, subscription Subscription)) *StringStream {
-	return FromStringObservable(StringObservableFactory(f))
+type MappingResponse2ResponseNotificationFunc func(next *http.Response, err error, complete bool, observer ResponseNotificationObserver)
+type MappingResponse2ResponseNotificationFuncFactory func(observer ResponseNotificationObserver) MappingResponse2ResponseNotificationFunc
+
+type MappingResponse2ResponseNotificationObservable struct {
+	parent ResponseObservable
+	mapper MappingResponse2ResponseNotificationFuncFactory
 }
 
-// Repeat value count times.
-func RepeatString(value string, count int) *StringStream {
-	return CreateString(func(observer StringObserver, subscription Subscription) {
-		for i := 0; i < count; i++ {
-			if subscription.Closed() {
-				return
-			}
-			observer.Next(value)
-		}
-		observer.Complete()
+func (f *MappingResponse2ResponseNotificationObservable) Subscribe(observer ResponseNotificationObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapResponse2ResponseNotificationObservable(parent ResponseObservable, mapper MappingResponse2ResponseNotificationFuncFactory) ResponseNotificationObservable {
+	return &MappingResponse2ResponseNotificationObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapResponse2ResponseNotificationObserveDirect(parent ResponseObservable, mapper MappingResponse2ResponseNotificationFunc) ResponseNotificationObservable {
+	return MapResponse2ResponseNotificationObservable(parent, func(ResponseNotificationObserver) MappingResponse2ResponseNotificationFunc {
+		return mapper
 	})
 }
 
-// StartString is designed to be used with functions that return a
-// (string, error) tuple.
-//
-// If the error is non-nil the returned StringStream will be that error,
-// otherwise it will be a single-value stream of string.
-func StartString(f func() (string, error)) *StringStream {
-	return CreateString(func(observer StringObserver, subscription Subscription) {
-		if v, err := f(); err != nil {
+func MapResponse2ResponseNotificationObserveNext(parent ResponseObservable, mapper func(*http.Response) ResponseNotification) ResponseNotificationObservable {
+	return MapResponse2ResponseNotificationObservable(parent, func(ResponseNotificationObserver) MappingResponse2ResponseNotificationFunc {
+		return func(next *http.Response, err error, complete bool, observer ResponseNotificationObserver) {
+			var mapped ResponseNotification
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughResponseNotification(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapResponseNotification maps this stream to an ResponseNotificationStream via f.
+func (s *ResponseStream) MapResponseNotification(f func(*http.Response) ResponseNotification) *ResponseNotificationStream {
+	return FromResponseNotificationObservable(MapResponse2ResponseNotificationObserveNext(s, f))
+}
+
+type MappingResponse2TimestampedResponseFunc func(next *http.Response, err error, complete bool, observer TimestampedResponseObserver)
+type MappingResponse2TimestampedResponseFuncFactory func(observer TimestampedResponseObserver) MappingResponse2TimestampedResponseFunc
+
+type MappingResponse2TimestampedResponseObservable struct {
+	parent ResponseObservable
+	mapper MappingResponse2TimestampedResponseFuncFactory
+}
+
+func (f *MappingResponse2TimestampedResponseObservable) Subscribe(observer TimestampedResponseObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapResponse2TimestampedResponseObservable(parent ResponseObservable, mapper MappingResponse2TimestampedResponseFuncFactory) TimestampedResponseObservable {
+	return &MappingResponse2TimestampedResponseObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapResponse2TimestampedResponseObserveDirect(parent ResponseObservable, mapper MappingResponse2TimestampedResponseFunc) TimestampedResponseObservable {
+	return MapResponse2TimestampedResponseObservable(parent, func(TimestampedResponseObserver) MappingResponse2TimestampedResponseFunc {
+		return mapper
+	})
+}
+
+func MapResponse2TimestampedResponseObserveNext(parent ResponseObservable, mapper func(*http.Response) TimestampedResponse) TimestampedResponseObservable {
+	return MapResponse2TimestampedResponseObservable(parent, func(TimestampedResponseObserver) MappingResponse2TimestampedResponseFunc {
+		return func(next *http.Response, err error, complete bool, observer TimestampedResponseObserver) {
+			var mapped TimestampedResponse
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughTimestampedResponse(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapTimestampedResponse maps this stream to an TimestampedResponseStream via f.
+func (s *ResponseStream) MapTimestampedResponse(f func(*http.Response) TimestampedResponse) *TimestampedResponseStream {
+	return FromTimestampedResponseObservable(MapResponse2TimestampedResponseObserveNext(s, f))
+}
+
+type MappingResponse2IntervalResponseFunc func(next *http.Response, err error, complete bool, observer IntervalResponseObserver)
+type MappingResponse2IntervalResponseFuncFactory func(observer IntervalResponseObserver) MappingResponse2IntervalResponseFunc
+
+type MappingResponse2IntervalResponseObservable struct {
+	parent ResponseObservable
+	mapper MappingResponse2IntervalResponseFuncFactory
+}
+
+func (f *MappingResponse2IntervalResponseObservable) Subscribe(observer IntervalResponseObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(ResponseObserverFunc(func(next *http.Response, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapResponse2IntervalResponseObservable(parent ResponseObservable, mapper MappingResponse2IntervalResponseFuncFactory) IntervalResponseObservable {
+	return &MappingResponse2IntervalResponseObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapResponse2IntervalResponseObserveDirect(parent ResponseObservable, mapper MappingResponse2IntervalResponseFunc) IntervalResponseObservable {
+	return MapResponse2IntervalResponseObservable(parent, func(IntervalResponseObserver) MappingResponse2IntervalResponseFunc {
+		return mapper
+	})
+}
+
+func MapResponse2IntervalResponseObserveNext(parent ResponseObservable, mapper func(*http.Response) IntervalResponse) IntervalResponseObservable {
+	return MapResponse2IntervalResponseObservable(parent, func(IntervalResponseObserver) MappingResponse2IntervalResponseFunc {
+		return func(next *http.Response, err error, complete bool, observer IntervalResponseObserver) {
+			var mapped IntervalResponse
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntervalResponse(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapIntervalResponse maps this stream to an IntervalResponseStream via f.
+func (s *ResponseStream) MapIntervalResponse(f func(*http.Response) IntervalResponse) *IntervalResponseStream {
+	return FromIntervalResponseObservable(MapResponse2IntervalResponseObserveNext(s, f))
+}
+
+type ResponseNotificationObserver interface {
+	Next(ResponseNotification)
+	TerminationObserver
+}
+
+// A ResponseNotificationSubscriber represents a subscribed ResponseNotificationObserver.
+type ResponseNotificationSubscriber interface {
+	Subscription
+	ResponseNotificationObserver
+}
+
+type implResponseNotificationSubscriber struct {
+	Subscription
+	ResponseNotificationObserver
+}
+
+func ResponseNotificationObserverAsGenericObserver(observer ResponseNotificationObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
 			observer.Error(err)
-		} else {
-			observer.Next(v)
+		case complete:
 			observer.Complete()
+		default:
+			observer.Next(next.(ResponseNotification))
 		}
 	})
 }
 
-func PassthroughString(next string, err error, complete bool, observer StringObserver) {
+func GenericObserverAsResponseNotificationObserver(observer GenericObserver) ResponseNotificationObserver {
+	return ResponseNotificationObserverFunc(func(next ResponseNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type ResponseNotificationObservableFactory func(observer ResponseNotificationObserver, subscription Subscription)
+
+func (f ResponseNotificationObservableFactory) Subscribe(observer ResponseNotificationObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateResponseNotification calls f(observer, subscription) to produce values for a stream.
+func CreateResponseNotification(f func(observer ResponseNotificationObserver, subscription Subscription)) *ResponseNotificationStream {
+	return FromResponseNotificationObservable(ResponseNotificationObservableFactory(f))
+}
+func PassthroughResponseNotification(next ResponseNotification, err error, complete bool, observer ResponseNotificationObserver) {
 	switch {
 	case err != nil:
 		observer.Error(err)
@@ -1530,85 +2827,51 @@ func PassthroughString(next string, err error, complete bool, observer StringObs
 	}
 }
 
-var zeroString = *new(string)
+var zeroResponseNotification = *new(ResponseNotification)
 
-type StringObserverFunc func(string, error, bool)
+type ResponseNotificationObserverFunc func(ResponseNotification, error, bool)
 
-func (f StringObserverFunc) Next(next string) { f(next, nil, false) }
-func (f StringObserverFunc) Error(err error)  { f(zeroString, err, false) }
-func (f StringObserverFunc) Complete()        { f(zeroString, nil, true) }
+func (f ResponseNotificationObserverFunc) Next(next ResponseNotification) { f(next, nil, false) }
+func (f ResponseNotificationObserverFunc) Error(err error)                { f(zeroResponseNotification, err, false) }
+func (f ResponseNotificationObserverFunc) Complete()                      { f(zeroResponseNotification, nil, true) }
 
-type StringObservable interface {
-	Subscribe(StringObserver) Subscription
+type ResponseNotificationObservable interface {
+	Subscribe(ResponseNotificationObserver) Subscription
 }
 
-// Convert a GenericObservableFilter to a StringObservable
-func (f GenericObservableFilterFactory) String(parent StringObservable) StringObservable {
-	return MapString2StringObservable(parent, func(observer StringObserver) MappingString2StringFunc {
-		gobserver := StringObserverAsGenericObserver(observer)
+// Convert a GenericObservableFilter to a ResponseNotificationObservable
+func (f GenericObservableFilterFactory) ResponseNotification(parent ResponseNotificationObservable) ResponseNotificationObservable {
+	return MapResponseNotification2ResponseNotificationObservable(parent, func(observer ResponseNotificationObserver) MappingResponseNotification2ResponseNotificationFunc {
+		gobserver := ResponseNotificationObserverAsGenericObserver(observer)
 		filter := f(gobserver)
-		return func(next string, err error, complete bool, observer StringObserver) {
+		return func(next ResponseNotification, err error, complete bool, observer ResponseNotificationObserver) {
 			filter(next, err, complete, gobserver)
 		}
 	},
 	)
 }
 
-func NeverString() *StringStream {
-	return CreateString(func(observer StringObserver, subscription Subscription) {})
-}
-
-func EmptyString() *StringStream {
-	return CreateString(func(observer StringObserver, subscription Subscription) {
-		observer.Complete()
-	})
-}
-
-func ThrowString(err error) *StringStream {
-	return CreateString(func(observer StringObserver, subscription Subscription) {
-		observer.Error(err)
-	})
-}
-
-func FromStringArray(array []string) *StringStream {
-	return CreateString(func(observer StringObserver, subscription Subscription) {
+func FromResponseNotificationArray(array []ResponseNotification) *ResponseNotificationStream {
+	return CreateResponseNotification(func(observer ResponseNotificationObserver, subscription Subscription) {
 		for _, v := range array {
-			if subscription.Closed() {
+			if subscription.Disposed() {
 				return
 			}
 			observer.Next(v)
 		}
 		observer.Complete()
-		subscription.Close()
+		subscription.Dispose()
 	})
 }
 
-func FromStrings(array ...string) *StringStream {
-	return FromStringArray(array)
-}
-
-func JustString(element string) *StringStream {
-	return FromStringArray([]string{element})
-}
-
-func MergeString(observables ...StringObservable) *StringStream {
-	if len(observables) == 0 {
-		return EmptyString()
-	}
-	return (&StringStream{observables[0]}).Merge(observables[1:]...)
-}
-
-func MergeStringDelayError(observables ...StringObservable) *StringStream {
-	if len(observables) == 0 {
-		return EmptyString()
-	}
-	return (&StringStream{observables[0]}).MergeDelayError(observables[1:]...)
+func FromResponseNotifications(array ...ResponseNotification) *ResponseNotificationStream {
+	return FromResponseNotificationArray(array)
 }
 
-func FromStringChannel(ch <-chan string) *StringStream {
-	return CreateString(func(observer StringObserver, subscription Subscription) {
+func FromResponseNotificationChannel(ch <-chan ResponseNotification) *ResponseNotificationStream {
+	return CreateResponseNotification(func(observer ResponseNotificationObserver, subscription Subscription) {
 		for v := range ch {
-			if subscription.Closed() {
+			if subscription.Disposed() {
 				return
 			}
 			observer.Next(v)
@@ -1617,369 +2880,568 @@ func FromStringChannel(ch <-chan string) *StringStream {
 	})
 }
 
-type StringStream struct {
-	StringObservable
+type ResponseNotificationStream struct {
+	ResponseNotificationObservable
 }
 
-func FromStringObservable(observable StringObservable) *StringStream {
-	return &StringStream{observable}
+func FromResponseNotificationObservable(observable ResponseNotificationObservable) *ResponseNotificationStream {
+	return &ResponseNotificationStream{observable}
 }
 
-func (s *StringStream) SubscribeFunc(f func(string, error, bool)) Subscription {
-	return s.Subscribe(StringObserverFunc(f))
+func (s *ResponseNotificationStream) SubscribeFunc(f func(ResponseNotification, error, bool)) Subscription {
+	return s.Subscribe(ResponseNotificationObserverFunc(f))
 }
 
-func (s *StringStream) SubscribeNext(f func(v string)) Subscription {
-	return s.SubscribeFunc(func(next string, err error, complete bool) {
+func (s *ResponseNotificationStream) SubscribeNext(f func(v ResponseNotification)) Subscription {
+	return s.SubscribeFunc(func(next ResponseNotification, err error, complete bool) {
 		if err == nil && !complete {
 			f(next)
 		}
 	})
 }
 
-// Distinct removes duplicate elements in the stream.
-func (s *StringStream) Distinct() *StringStream {
-	return FromStringObservable(distinctFilter().String(s))
-}
-
-// ElementAt yields the Nth element of the stream.
-func (s *StringStream) ElementAt(n int) *StringStream {
-	return FromStringObservable(elementAtFilter(n).String(s))
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *ResponseNotificationStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsResponseNotificationObserver(observer))
 }
 
 // Filter elements in the stream on a function.
-func (s *StringStream) Filter(f func(string) bool) *StringStream {
-	return FromStringObservable(filterFilter(func(v interface{}) bool { return f(v.(string)) }).String(s))
-}
-
-// Last returns just the first element of the stream.
-func (s *StringStream) First() *StringStream {
-	return FromStringObservable(firstFilter().String(s))
-}
-
-// Last returns just the last element of the stream.
-func (s *StringStream) Last() *StringStream {
-	return FromStringObservable(lastFilter().String(s))
-}
-
-// SkipLast skips the first N elements of the stream.
-func (s *StringStream) Skip(n int) *StringStream {
-	return FromStringObservable(skipFilter(n).String(s))
-}
-
-// SkipLast skips the last N elements of the stream.
-func (s *StringStream) SkipLast(n int) *StringStream {
-	return FromStringObservable(skipLastFilter(n).String(s))
-}
-
-// Take returns just the first N elements of the stream.
-func (s *StringStream) Take(n int) *StringStream {
-	return FromStringObservable(takeFilter(n).String(s))
+func (s *ResponseNotificationStream) Filter(f func(ResponseNotification) bool) *ResponseNotificationStream {
+	return FromResponseNotificationObservable(filterFilter(func(v interface{}) bool { return f(v.(ResponseNotification)) }).ResponseNotification(s))
 }
 
-// TakeLast returns just the last N elements of the stream.
-func (s *StringStream) TakeLast(n int) *StringStream {
-	return FromStringObservable(takeLastFilter(n).String(s))
+// Do applies a function for each value passing through the stream.
+func (s *ResponseNotificationStream) Do(f func(next ResponseNotification)) *ResponseNotificationStream {
+	return FromResponseNotificationObservable(MapResponseNotification2ResponseNotificationObserveNext(s, func(next ResponseNotification) ResponseNotification {
+		f(next)
+		return next
+	}))
 }
 
-// IgnoreElements ignores elements of the stream and emits only the completion events.
-func (s *StringStream) IgnoreElements() *StringStream {
-	return FromStringObservable(ignoreElementsFilter().String(s))
+// DoOnError applies a function for any error on the stream.
+func (s *ResponseNotificationStream) DoOnError(f func(err error)) *ResponseNotificationStream {
+	return FromResponseNotificationObservable(MapResponseNotification2ResponseNotificationObserveDirect(s, func(next ResponseNotification, err error, complete bool, observer ResponseNotificationObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughResponseNotification(next, err, complete, observer)
+	}))
 }
 
-func (s *StringStream) Replay(size int, duration time.Duration) *StringStream {
-	return FromStringObservable(replayFilter(size, duration).String(s))
+// DoOnComplete applies a function when the stream completes.
+func (s *ResponseNotificationStream) DoOnComplete(f func()) *ResponseNotificationStream {
+	return FromResponseNotificationObservable(MapResponseNotification2ResponseNotificationObserveDirect(s, func(next ResponseNotification, err error, complete bool, observer ResponseNotificationObserver) {
+		if complete {
+			f()
+		}
+		PassthroughResponseNotification(next, err, complete, observer)
+	}))
 }
 
-func (s *StringStream) Sample(duration time.Duration) *StringStream {
-	return FromStringObservable(sampleFilter(duration).String(s))
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *ResponseNotificationStream) ToOneWithError() (ResponseNotification, error) {
+	valuech := make(chan ResponseNotification, 1)
+	errch := make(chan error, 1)
+	FromResponseNotificationObservable(oneFilter().ResponseNotification(s)).SubscribeFunc(func(next ResponseNotification, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroResponseNotification, err
+	}
 }
 
-func (s *StringStream) Debounce(duration time.Duration) *StringStream {
-	return FromStringObservable(debounceFilter(duration).String(s))
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *ResponseNotificationStream) ToOne() ResponseNotification {
+	value, _ := s.ToOneWithError()
+	return value
 }
 
-// Wait for completion of the stream and return any error.
-func (s *StringStream) Wait() error {
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *ResponseNotificationStream) ToArrayWithError() ([]ResponseNotification, error) {
+	array := []ResponseNotification{}
+	completech := make(chan bool, 1)
 	errch := make(chan error, 1)
-	s.SubscribeFunc(func(next string, err error, complete bool) {
+	s.SubscribeFunc(func(next ResponseNotification, err error, complete bool) {
 		switch {
 		case err != nil:
 			errch <- err
 		case complete:
-			errch <- nil
+			completech <- true
 		default:
+			array = append(array, next)
 		}
 	})
-	return <-errch
-}
-
-func MakeStringSubscriber(observer StringObserver) StringSubscriber {
-	if subscriber, ok := observer.(StringSubscriber); ok {
-		return subscriber
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
 	}
-	return &implStringSubscriber{NewGenericSubscription(), observer}
 }
 
-type concatStringSubscriber struct {
-	observable  int
-	observer    StringObserver
-	observables []StringObservable
-	Subscription
+// ToArray blocks and returns the values from the stream in an array.
+func (s *ResponseNotificationStream) ToArray() []ResponseNotification {
+	out, _ := s.ToArrayWithError()
+	return out
 }
 
-func (c *concatStringSubscriber) Next(next string) {
-	c.observer.Next(next)
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *ResponseNotificationStream) ToChannelWithError() (<-chan ResponseNotification, <-chan error) {
+	ch := make(chan ResponseNotification, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next ResponseNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
 }
 
-func (c *concatStringSubscriber) Error(err error) {
-	c.observer.Error(err)
-	c.observable = len(c.observables)
-	c.Close()
+func (s *ResponseNotificationStream) ToChannel() <-chan ResponseNotification {
+	ch, _ := s.ToChannelWithError()
+	return ch
 }
 
-func (c *concatStringSubscriber) Complete() {
-	c.observable++
-	if c.observable >= len(c.observables) {
-		c.observer.Complete()
-		c.Close()
-		return
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *ResponseNotificationStream) Seq() iter.Seq[ResponseNotification] {
+	return func(yield func(ResponseNotification) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
 	}
-	c.observables[c.observable].Subscribe(c)
 }
 
-type concatStringObservable struct {
-	observables []StringObservable
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *ResponseNotificationStream) Seq2() iter.Seq2[ResponseNotification, error] {
+	return func(yield func(ResponseNotification, error) bool) {
+		type notification struct {
+			next     ResponseNotification
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next ResponseNotification, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroResponseNotification, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
 }
 
-func (m *concatStringObservable) Subscribe(observer StringObserver) Subscription {
-	if len(m.observables) == 0 {
-		observer.Complete()
-		return ClosedSubscription
-	}
-	subscriber := &concatStringSubscriber{
-		observer:     observer,
-		Subscription: NewGenericSubscription(),
-		observables:  m.observables,
-	}
-	m.observables[0].Subscribe(subscriber)
-	return subscriber
+type mapResponseNotification2String struct {
+	parent ResponseNotificationObservable
+	f      func(ResponseNotification) string
 }
 
-func (s *StringStream) Concat(observables ...StringObservable) *StringStream {
-	return &StringStream{&concatStringObservable{append([]StringObservable{s}, observables...)}}
+func (m *mapResponseNotification2String) Subscribe(observer StringObserver) Subscription {
+	return m.parent.Subscribe(ResponseNotificationObserverFunc(func(next ResponseNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
 }
 
-type mergeStringObservable struct {
-	delayError  bool
-	observables []StringObservable
+// MapString maps this stream to an StringStream via f.
+func (s *ResponseNotificationStream) MapString(f func(ResponseNotification) string) *StringStream {
+	return &StringStream{&mapResponseNotification2String{s, f}}
 }
 
-func (m *mergeStringObservable) Subscribe(observer StringObserver) Subscription {
-	subscription := NewGenericSubscription()
-	lock := sync.Mutex{}
-	completed := 0
-	var firstError error
-	relay := func(next string, err error, complete bool) {
-		lock.Lock()
-		defer lock.Unlock()
-		if completed >= len(m.observables) {
-			return
-		}
+type mapResponseNotification2Int struct {
+	parent ResponseNotificationObservable
+	f      func(ResponseNotification) int
+}
 
+func (m *mapResponseNotification2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(ResponseNotificationObserverFunc(func(next ResponseNotification, err error, complete bool) {
 		switch {
 		case err != nil:
-			if m.delayError {
-				firstError = err
-				completed++
-			} else {
-				observer.Error(err)
-				completed = len(m.observables)
-			}
-
+			observer.Error(err)
 		case complete:
-			completed++
-			if completed == len(m.observables) {
-				if firstError != nil {
-					observer.Error(firstError)
-				} else {
-					observer.Complete()
-				}
-			}
+			observer.Complete()
 		default:
-			observer.Next(next)
+			observer.Next(m.f(next))
 		}
-	}
-	for _, observable := range m.observables {
-		observable.Subscribe(StringObserverFunc(relay))
-	}
-	return subscription
-}
-
-// Merge an arbitrary number of observables with this one.
-// An error from any of the observables will terminate the merged stream.
-func (s *StringStream) Merge(other ...StringObservable) *StringStream {
-	if len(other) == 0 {
-		return s
-	}
-	return &StringStream{&mergeStringObservable{false, append(other, s)}}
+	}))
 }
 
-// Merge an arbitrary number of observables with this one.
-// Any error will be deferred until all observables terminate.
-func (s *StringStream) MergeDelayError(other ...StringObservable) *StringStream {
-	if len(other) == 0 {
-		return s
-	}
-	return &StringStream{&mergeStringObservable{true, append(other, s)}}
+// MapInt maps this stream to an IntStream via f.
+func (s *ResponseNotificationStream) MapInt(f func(ResponseNotification) int) *IntStream {
+	return &IntStream{&mapResponseNotification2Int{s, f}}
 }
 
-type catchStringObservable struct {
-	parent StringObservable
-	catch  StringObservable
+type mapResponseNotification2Bool struct {
+	parent ResponseNotificationObservable
+	f      func(ResponseNotification) bool
 }
 
-func (r *catchStringObservable) Subscribe(observer StringObserver) Subscription {
-	subscription := NewGenericSubscription()
-	run := func(next string, err error, complete bool) {
+func (m *mapResponseNotification2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(ResponseNotificationObserverFunc(func(next ResponseNotification, err error, complete bool) {
 		switch {
 		case err != nil:
-			r.catch.Subscribe(observer)
+			observer.Error(err)
 		case complete:
 			observer.Complete()
 		default:
-			observer.Next(next)
+			observer.Next(m.f(next))
 		}
-	}
-	r.parent.Subscribe(StringObserverFunc(run))
-	return subscription
+	}))
 }
 
-func (s *StringStream) Catch(catch StringObservable) *StringStream {
-	return &StringStream{&catchStringObservable{s, catch}}
+// MapBool maps this stream to an BoolStream via f.
+func (s *ResponseNotificationStream) MapBool(f func(ResponseNotification) bool) *BoolStream {
+	return &BoolStream{&mapResponseNotification2Bool{s, f}}
 }
 
-type retryStringObservable struct {
-	observable StringObservable
+type MappingResponseNotification2ResponseNotificationFunc func(next ResponseNotification, err error, complete bool, observer ResponseNotificationObserver)
+type MappingResponseNotification2ResponseNotificationFuncFactory func(observer ResponseNotificationObserver) MappingResponseNotification2ResponseNotificationFunc
+
+type MappingResponseNotification2ResponseNotificationObservable struct {
+	parent ResponseNotificationObservable
+	mapper MappingResponseNotification2ResponseNotificationFuncFactory
 }
 
-type retryStringObserver struct {
-	observable StringObservable
-	observer   StringObserver
+func (f *MappingResponseNotification2ResponseNotificationObservable) Subscribe(observer ResponseNotificationObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(ResponseNotificationObserverFunc(func(next ResponseNotification, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
 }
 
-func (r *retryStringObserver) retry(next string, err error, complete bool) {
-	switch {
-	case err != nil:
-		r.observable.Subscribe(StringObserverFunc(r.retry))
-	case complete:
-		r.observer.Complete()
-	default:
-		r.observer.Next(next)
+func MapResponseNotification2ResponseNotificationObservable(parent ResponseNotificationObservable, mapper MappingResponseNotification2ResponseNotificationFuncFactory) ResponseNotificationObservable {
+	return &MappingResponseNotification2ResponseNotificationObservable{
+		parent: parent,
+		mapper: mapper,
 	}
 }
 
-func (r *retryStringObservable) Subscribe(observer StringObserver) Subscription {
-	subscription := NewGenericSubscription()
-	ro := &retryStringObserver{r.observable, observer}
-	r.observable.Subscribe(StringObserverFunc(ro.retry))
-	return subscription
+func MapResponseNotification2ResponseNotificationObserveDirect(parent ResponseNotificationObservable, mapper MappingResponseNotification2ResponseNotificationFunc) ResponseNotificationObservable {
+	return MapResponseNotification2ResponseNotificationObservable(parent, func(ResponseNotificationObserver) MappingResponseNotification2ResponseNotificationFunc {
+		return mapper
+	})
 }
 
-func (s *StringStream) Retry() *StringStream {
-	return &StringStream{&retryStringObservable{s}}
+func MapResponseNotification2ResponseNotificationObserveNext(parent ResponseNotificationObservable, mapper func(ResponseNotification) ResponseNotification) ResponseNotificationObservable {
+	return MapResponseNotification2ResponseNotificationObservable(parent, func(ResponseNotificationObserver) MappingResponseNotification2ResponseNotificationFunc {
+		return func(next ResponseNotification, err error, complete bool, observer ResponseNotificationObserver) {
+			var mapped ResponseNotification
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughResponseNotification(mapped, err, complete, observer)
+		}
+	},
+	)
 }
 
-// Do applies a function for each value passing through the stream.
-func (s *StringStream) Do(f func(next string)) *StringStream {
-	return FromStringObservable(MapString2StringObserveNext(s, func(next string) string {
-		f(next)
-		return next
-	}))
+// Map maps values in this stream to another value.
+func (s *ResponseNotificationStream) Map(f func(ResponseNotification) ResponseNotification) *ResponseNotificationStream {
+	return FromResponseNotificationObservable(MapResponseNotification2ResponseNotificationObserveNext(s, f))
 }
 
-// DoOnError applies a function for any error on the stream.
-func (s *StringStream) DoOnError(f func(err error)) *StringStream {
-	return FromStringObservable(MapString2StringObserveDirect(s, func(next string, err error, complete bool, observer StringObserver) {
-		if err != nil {
-			f(err)
-		}
-		PassthroughString(next, err, complete, observer)
+type MappingResponseNotification2ResponseFunc func(next ResponseNotification, err error, complete bool, observer ResponseObserver)
+type MappingResponseNotification2ResponseFuncFactory func(observer ResponseObserver) MappingResponseNotification2ResponseFunc
+
+type MappingResponseNotification2ResponseObservable struct {
+	parent ResponseNotificationObservable
+	mapper MappingResponseNotification2ResponseFuncFactory
+}
+
+func (f *MappingResponseNotification2ResponseObservable) Subscribe(observer ResponseObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(ResponseNotificationObserverFunc(func(next ResponseNotification, err error, complete bool) {
+		mapper(next, err, complete, observer)
 	}))
 }
 
-// DoOnComplete applies a function when the stream completes.
-func (s *StringStream) DoOnComplete(f func()) *StringStream {
-	return FromStringObservable(MapString2StringObserveDirect(s, func(next string, err error, complete bool, observer StringObserver) {
-		if complete {
-			f()
+func MapResponseNotification2ResponseObservable(parent ResponseNotificationObservable, mapper MappingResponseNotification2ResponseFuncFactory) ResponseObservable {
+	return &MappingResponseNotification2ResponseObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapResponseNotification2ResponseObserveDirect(parent ResponseNotificationObservable, mapper MappingResponseNotification2ResponseFunc) ResponseObservable {
+	return MapResponseNotification2ResponseObservable(parent, func(ResponseObserver) MappingResponseNotification2ResponseFunc {
+		return mapper
+	})
+}
+
+func MapResponseNotification2ResponseObserveNext(parent ResponseNotificationObservable, mapper func(ResponseNotification) *http.Response) ResponseObservable {
+	return MapResponseNotification2ResponseObservable(parent, func(ResponseObserver) MappingResponseNotification2ResponseFunc {
+		return func(next ResponseNotification, err error, complete bool, observer ResponseObserver) {
+			var mapped *http.Response
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughResponse(mapped, err, complete, observer)
 		}
-		PassthroughString(next, err, complete, observer)
-	}))
+	},
+	)
 }
 
-func (s *StringStream) Reduce(initial string, reducer func(string, string) string) *StringStream {
-	value := initial
-	return FromStringObservable(MapString2StringObserveDirect(s, func(next string, err error, complete bool, observer StringObserver) {
+type flatMapResponseNotification2Response struct {
+	parent ResponseNotificationObservable
+	mapper func(ResponseNotification) ResponseObservable
+}
+
+func (f *flatMapResponseNotification2Response) Subscribe(observer ResponseObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(ResponseNotificationObserverFunc(func(next ResponseNotification, err error, complete bool) {
 		switch {
 		case err != nil:
-			observer.Next(value)
+			wg.Wait()
 			observer.Error(err)
 		case complete:
-			observer.Next(value)
+			wg.Wait()
 			observer.Complete()
 		default:
-			value = reducer(value, next)
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&ResponseStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &ResponseStream{ignoreCompletionFilter().Response(stream)}
+			stream.Subscribe(observer)
 		}
 	}))
+	return subscription
 }
 
-func (s *StringStream) Scan(initial string, f func(string, string) string) *StringStream {
-	value := initial
-	return FromStringObservable(MapString2StringObserveDirect(s, func(next string, err error, complete bool, observer StringObserver) {
+// MapResponse maps this stream to an ResponseStream via f.
+func (s *ResponseNotificationStream) MapResponse(f func(ResponseNotification) *http.Response) *ResponseStream {
+	return FromResponseObservable(MapResponseNotification2ResponseObserveNext(s, f))
+}
+
+func (s *ResponseNotificationStream) FlatMapResponse(f func(ResponseNotification) ResponseObservable) *ResponseStream {
+	return &ResponseStream{&flatMapResponseNotification2Response{s, f}}
+}
+
+type TimestampedResponseObserver interface {
+	Next(TimestampedResponse)
+	TerminationObserver
+}
+
+// A TimestampedResponseSubscriber represents a subscribed TimestampedResponseObserver.
+type TimestampedResponseSubscriber interface {
+	Subscription
+	TimestampedResponseObserver
+}
+
+type implTimestampedResponseSubscriber struct {
+	Subscription
+	TimestampedResponseObserver
+}
+
+func TimestampedResponseObserverAsGenericObserver(observer TimestampedResponseObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
 		switch {
 		case err != nil:
 			observer.Error(err)
 		case complete:
 			observer.Complete()
 		default:
-			value = f(value, next)
-			observer.Next(value)
+			observer.Next(next.(TimestampedResponse))
 		}
-	}))
+	})
 }
 
-type timeoutString struct {
-	parent  StringObservable
-	timeout time.Duration
+func GenericObserverAsTimestampedResponseObserver(observer GenericObserver) TimestampedResponseObserver {
+	return TimestampedResponseObserverFunc(func(next TimestampedResponse, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
 }
 
-func (t *timeoutString) Subscribe(observer StringObserver) Subscription {
-	subscription := NewChannelSubscription()
-	cancel := t.parent.Subscribe(observer)
-	go func() {
-		select {
-		case <-time.After(t.timeout):
-			observer.Error(ErrTimeout)
-			_ = cancel.Close()
-			_ = subscription.Close()
-		case <-subscription:
-			_ = cancel.Close()
-		}
-	}()
+type TimestampedResponseObservableFactory func(observer TimestampedResponseObserver, subscription Subscription)
+
+func (f TimestampedResponseObservableFactory) Subscribe(observer TimestampedResponseObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
 	return subscription
 }
 
-func (s *StringStream) Timeout(timeout time.Duration) *StringStream {
-	return &StringStream{&timeoutString{s, timeout}}
+// CreateTimestampedResponse calls f(observer, subscription) to produce values for a stream.
+func CreateTimestampedResponse(f func(observer TimestampedResponseObserver, subscription Subscription)) *TimestampedResponseStream {
+	return FromTimestampedResponseObservable(TimestampedResponseObservableFactory(f))
+}
+func PassthroughTimestampedResponse(next TimestampedResponse, err error, complete bool, observer TimestampedResponseObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroTimestampedResponse = *new(TimestampedResponse)
+
+type TimestampedResponseObserverFunc func(TimestampedResponse, error, bool)
+
+func (f TimestampedResponseObserverFunc) Next(next TimestampedResponse) { f(next, nil, false) }
+func (f TimestampedResponseObserverFunc) Error(err error)               { f(zeroTimestampedResponse, err, false) }
+func (f TimestampedResponseObserverFunc) Complete()                     { f(zeroTimestampedResponse, nil, true) }
+
+type TimestampedResponseObservable interface {
+	Subscribe(TimestampedResponseObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a TimestampedResponseObservable
+func (f GenericObservableFilterFactory) TimestampedResponse(parent TimestampedResponseObservable) TimestampedResponseObservable {
+	return MapTimestampedResponse2TimestampedResponseObservable(parent, func(observer TimestampedResponseObserver) MappingTimestampedResponse2TimestampedResponseFunc {
+		gobserver := TimestampedResponseObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next TimestampedResponse, err error, complete bool, observer TimestampedResponseObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromTimestampedResponseArray(array []TimestampedResponse) *TimestampedResponseStream {
+	return CreateTimestampedResponse(func(observer TimestampedResponseObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromTimestampedResponses(array ...TimestampedResponse) *TimestampedResponseStream {
+	return FromTimestampedResponseArray(array)
+}
+
+func FromTimestampedResponseChannel(ch <-chan TimestampedResponse) *TimestampedResponseStream {
+	return CreateTimestampedResponse(func(observer TimestampedResponseObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type TimestampedResponseStream struct {
+	TimestampedResponseObservable
+}
+
+func FromTimestampedResponseObservable(observable TimestampedResponseObservable) *TimestampedResponseStream {
+	return &TimestampedResponseStream{observable}
+}
+
+func (s *TimestampedResponseStream) SubscribeFunc(f func(TimestampedResponse, error, bool)) Subscription {
+	return s.Subscribe(TimestampedResponseObserverFunc(f))
+}
+
+func (s *TimestampedResponseStream) SubscribeNext(f func(v TimestampedResponse)) Subscription {
+	return s.SubscribeFunc(func(next TimestampedResponse, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *TimestampedResponseStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsTimestampedResponseObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *TimestampedResponseStream) Filter(f func(TimestampedResponse) bool) *TimestampedResponseStream {
+	return FromTimestampedResponseObservable(filterFilter(func(v interface{}) bool { return f(v.(TimestampedResponse)) }).TimestampedResponse(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *TimestampedResponseStream) Do(f func(next TimestampedResponse)) *TimestampedResponseStream {
+	return FromTimestampedResponseObservable(MapTimestampedResponse2TimestampedResponseObserveNext(s, func(next TimestampedResponse) TimestampedResponse {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *TimestampedResponseStream) DoOnError(f func(err error)) *TimestampedResponseStream {
+	return FromTimestampedResponseObservable(MapTimestampedResponse2TimestampedResponseObserveDirect(s, func(next TimestampedResponse, err error, complete bool, observer TimestampedResponseObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughTimestampedResponse(next, err, complete, observer)
+	}))
+}
 
+// DoOnComplete applies a function when the stream completes.
+func (s *TimestampedResponseStream) DoOnComplete(f func()) *TimestampedResponseStream {
+	return FromTimestampedResponseObservable(MapTimestampedResponse2TimestampedResponseObserveDirect(s, func(next TimestampedResponse, err error, complete bool, observer TimestampedResponseObserver) {
+		if complete {
+			f()
+		}
+		PassthroughTimestampedResponse(next, err, complete, observer)
+	}))
 }
 
 // ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
-func (s *StringStream) ToOneWithError() (string, error) {
-	valuech := make(chan string, 1)
+func (s *TimestampedResponseStream) ToOneWithError() (TimestampedResponse, error) {
+	valuech := make(chan TimestampedResponse, 1)
 	errch := make(chan error, 1)
-	FromStringObservable(oneFilter().String(s)).SubscribeFunc(func(next string, err error, complete bool) {
+	FromTimestampedResponseObservable(oneFilter().TimestampedResponse(s)).SubscribeFunc(func(next TimestampedResponse, err error, complete bool) {
 		if err != nil {
 			errch <- err
 		} else if !complete {
@@ -1990,24 +3452,24 @@ func (s *StringStream) ToOneWithError() (string, error) {
 	case value := <-valuech:
 		return value, nil
 	case err := <-errch:
-		return zeroString, err
+		return zeroTimestampedResponse, err
 	}
 }
 
 // ToOne blocks and returns the only value emitted by the stream, or the zero
 // value if an error occurs.
-func (s *StringStream) ToOne() string {
+func (s *TimestampedResponseStream) ToOne() TimestampedResponse {
 	value, _ := s.ToOneWithError()
 	return value
 }
 
 // ToArrayWithError collects all values from the stream into an array,
 // returning it and any error.
-func (s *StringStream) ToArrayWithError() ([]string, error) {
-	array := []string{}
+func (s *TimestampedResponseStream) ToArrayWithError() ([]TimestampedResponse, error) {
+	array := []TimestampedResponse{}
 	completech := make(chan bool, 1)
 	errch := make(chan error, 1)
-	s.SubscribeFunc(func(next string, err error, complete bool) {
+	s.SubscribeFunc(func(next TimestampedResponse, err error, complete bool) {
 		switch {
 		case err != nil:
 			errch <- err
@@ -2026,16 +3488,16 @@ func (s *StringStream) ToArrayWithError() ([]string, error) {
 }
 
 // ToArray blocks and returns the values from the stream in an array.
-func (s *StringStream) ToArray() []string {
+func (s *TimestampedResponseStream) ToArray() []TimestampedResponse {
 	out, _ := s.ToArrayWithError()
 	return out
 }
 
 // ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
-func (s *StringStream) ToChannelWithError() (<-chan string, <-chan error) {
-	ch := make(chan string, 1)
+func (s *TimestampedResponseStream) ToChannelWithError() (<-chan TimestampedResponse, <-chan error) {
+	ch := make(chan TimestampedResponse, 1)
 	errch := make(chan error, 1)
-	s.SubscribeFunc(func(next string, err error, complete bool) {
+	s.SubscribeFunc(func(next TimestampedResponse, err error, complete bool) {
 		switch {
 		case err != nil:
 			errch <- err
@@ -2050,157 +3512,225 @@ func (s *StringStream) ToChannelWithError() (<-chan string, <-chan error) {
 	return ch, errch
 }
 
-func (s *StringStream) ToChannel() <-chan string {
+func (s *TimestampedResponseStream) ToChannel() <-chan TimestampedResponse {
 	ch, _ := s.ToChannelWithError()
 	return ch
 }
 
-// Count returns an IntStream with the count of elements in this stream.
-func (s *StringStream) Count() *IntStream {
-	count := 0
-	return FromIntObservable(MapString2IntObserveDirect(s, func(next string, err error, complete bool, observer IntObserver) {
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *TimestampedResponseStream) Seq() iter.Seq[TimestampedResponse] {
+	return func(yield func(TimestampedResponse) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *TimestampedResponseStream) Seq2() iter.Seq2[TimestampedResponse, error] {
+	return func(yield func(TimestampedResponse, error) bool) {
+		type notification struct {
+			next     TimestampedResponse
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next TimestampedResponse, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroTimestampedResponse, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapTimestampedResponse2String struct {
+	parent TimestampedResponseObservable
+	f      func(TimestampedResponse) string
+}
+
+func (m *mapTimestampedResponse2String) Subscribe(observer StringObserver) Subscription {
+	return m.parent.Subscribe(TimestampedResponseObserverFunc(func(next TimestampedResponse, err error, complete bool) {
 		switch {
 		case err != nil:
-			observer.Next(count)
 			observer.Error(err)
 		case complete:
-			observer.Next(count)
 			observer.Complete()
 		default:
-			count++
+			observer.Next(m.f(next))
 		}
 	}))
 }
 
-type MappingString2ResponseFunc func(next string, err error, complete bool, observer ResponseObserver)
-type MappingString2ResponseFuncFactory func(observer ResponseObserver) MappingString2ResponseFunc
+// MapString maps this stream to an StringStream via f.
+func (s *TimestampedResponseStream) MapString(f func(TimestampedResponse) string) *StringStream {
+	return &StringStream{&mapTimestampedResponse2String{s, f}}
+}
 
-type MappingString2ResponseObservable struct {
-	parent StringObservable
-	mapper MappingString2ResponseFuncFactory
+type mapTimestampedResponse2Int struct {
+	parent TimestampedResponseObservable
+	f      func(TimestampedResponse) int
 }
 
-func (f *MappingString2ResponseObservable) Subscribe(observer ResponseObserver) Subscription {
+func (m *mapTimestampedResponse2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(TimestampedResponseObserverFunc(func(next TimestampedResponse, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *TimestampedResponseStream) MapInt(f func(TimestampedResponse) int) *IntStream {
+	return &IntStream{&mapTimestampedResponse2Int{s, f}}
+}
+
+type mapTimestampedResponse2Bool struct {
+	parent TimestampedResponseObservable
+	f      func(TimestampedResponse) bool
+}
+
+func (m *mapTimestampedResponse2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(TimestampedResponseObserverFunc(func(next TimestampedResponse, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *TimestampedResponseStream) MapBool(f func(TimestampedResponse) bool) *BoolStream {
+	return &BoolStream{&mapTimestampedResponse2Bool{s, f}}
+}
+
+type MappingTimestampedResponse2TimestampedResponseFunc func(next TimestampedResponse, err error, complete bool, observer TimestampedResponseObserver)
+type MappingTimestampedResponse2TimestampedResponseFuncFactory func(observer TimestampedResponseObserver) MappingTimestampedResponse2TimestampedResponseFunc
+
+type MappingTimestampedResponse2TimestampedResponseObservable struct {
+	parent TimestampedResponseObservable
+	mapper MappingTimestampedResponse2TimestampedResponseFuncFactory
+}
+
+func (f *MappingTimestampedResponse2TimestampedResponseObservable) Subscribe(observer TimestampedResponseObserver) Subscription {
 	mapper := f.mapper(observer)
-	return f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+	return f.parent.Subscribe(TimestampedResponseObserverFunc(func(next TimestampedResponse, err error, complete bool) {
 		mapper(next, err, complete, observer)
 	}))
 }
 
-func MapString2ResponseObservable(parent StringObservable, mapper MappingString2ResponseFuncFactory) ResponseObservable {
-	return &MappingString2ResponseObservable{
+func MapTimestampedResponse2TimestampedResponseObservable(parent TimestampedResponseObservable, mapper MappingTimestampedResponse2TimestampedResponseFuncFactory) TimestampedResponseObservable {
+	return &MappingTimestampedResponse2TimestampedResponseObservable{
 		parent: parent,
 		mapper: mapper,
 	}
 }
 
-func MapString2ResponseObserveDirect(parent StringObservable, mapper MappingString2ResponseFunc) ResponseObservable {
-	return MapString2ResponseObservable(parent, func(ResponseObserver) MappingString2ResponseFunc {
+func MapTimestampedResponse2TimestampedResponseObserveDirect(parent TimestampedResponseObservable, mapper MappingTimestampedResponse2TimestampedResponseFunc) TimestampedResponseObservable {
+	return MapTimestampedResponse2TimestampedResponseObservable(parent, func(TimestampedResponseObserver) MappingTimestampedResponse2TimestampedResponseFunc {
 		return mapper
 	})
 }
 
-func MapString2ResponseObserveNext(parent StringObservable, mapper func(string) *http.Response) ResponseObservable {
-	return MapString2ResponseObservable(parent, func(ResponseObserver) MappingString2ResponseFunc {
-		return func(next string, err error, complete bool, observer ResponseObserver) {
-			var mapped *http.Response
+func MapTimestampedResponse2TimestampedResponseObserveNext(parent TimestampedResponseObservable, mapper func(TimestampedResponse) TimestampedResponse) TimestampedResponseObservable {
+	return MapTimestampedResponse2TimestampedResponseObservable(parent, func(TimestampedResponseObserver) MappingTimestampedResponse2TimestampedResponseFunc {
+		return func(next TimestampedResponse, err error, complete bool, observer TimestampedResponseObserver) {
+			var mapped TimestampedResponse
 			if err == nil && !complete {
 				mapped = mapper(next)
 			}
-			PassthroughResponse(mapped, err, complete, observer)
+			PassthroughTimestampedResponse(mapped, err, complete, observer)
 		}
 	},
 	)
 }
 
-type flatMapString2Response struct {
-	parent StringObservable
-	mapper func(string) ResponseObservable
-}
-
-func (f *flatMapString2Response) Subscribe(observer ResponseObserver) Subscription {
-	subscription := NewGenericSubscription()
-	wg := sync.WaitGroup{}
-	f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
-		switch {
-		case err != nil:
-			wg.Wait()
-			observer.Error(err)
-		case complete:
-			wg.Wait()
-			observer.Complete()
-		default:
-			wg.Add(1)
-			observable := f.mapper(next)
-			stream := (&ResponseStream{observable}).
-				DoOnComplete(func() { wg.Done() }).
-				DoOnError(func(error) { wg.Done() })
-			stream = &ResponseStream{ignoreCompletionFilter().Response(stream)}
-			stream.Subscribe(observer)
-		}
-	}))
-	return subscription
-}
-
-// MapResponse maps this stream to an ResponseStream via f.
-func (s *StringStream) MapResponse(f func(string) *http.Response) *ResponseStream {
-	return FromResponseObservable(MapString2ResponseObserveNext(s, f))
-}
-
-func (s *StringStream) FlatMapResponse(f func(string) ResponseObservable) *ResponseStream {
-	return &ResponseStream{&flatMapString2Response{s, f}}
+// Map maps values in this stream to another value.
+func (s *TimestampedResponseStream) Map(f func(TimestampedResponse) TimestampedResponse) *TimestampedResponseStream {
+	return FromTimestampedResponseObservable(MapTimestampedResponse2TimestampedResponseObserveNext(s, f))
 }
 
-type MappingString2StringFunc func(next string, err error, complete bool, observer StringObserver)
-type MappingString2StringFuncFactory func(observer StringObserver) MappingString2StringFunc
+type MappingTimestampedResponse2ResponseFunc func(next TimestampedResponse, err error, complete bool, observer ResponseObserver)
+type MappingTimestampedResponse2ResponseFuncFactory func(observer ResponseObserver) MappingTimestampedResponse2ResponseFunc
 
-type MappingString2StringObservable struct {
-	parent StringObservable
-	mapper MappingString2StringFuncFactory
+type MappingTimestampedResponse2ResponseObservable struct {
+	parent TimestampedResponseObservable
+	mapper MappingTimestampedResponse2ResponseFuncFactory
 }
 
-func (f *MappingString2StringObservable) Subscribe(observer StringObserver) Subscription {
+func (f *MappingTimestampedResponse2ResponseObservable) Subscribe(observer ResponseObserver) Subscription {
 	mapper := f.mapper(observer)
-	return f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+	return f.parent.Subscribe(TimestampedResponseObserverFunc(func(next TimestampedResponse, err error, complete bool) {
 		mapper(next, err, complete, observer)
 	}))
 }
 
-func MapString2StringObservable(parent StringObservable, mapper MappingString2StringFuncFactory) StringObservable {
-	return &MappingString2StringObservable{
+func MapTimestampedResponse2ResponseObservable(parent TimestampedResponseObservable, mapper MappingTimestampedResponse2ResponseFuncFactory) ResponseObservable {
+	return &MappingTimestampedResponse2ResponseObservable{
 		parent: parent,
 		mapper: mapper,
 	}
 }
 
-func MapString2StringObserveDirect(parent StringObservable, mapper MappingString2StringFunc) StringObservable {
-	return MapString2StringObservable(parent, func(StringObserver) MappingString2StringFunc {
+func MapTimestampedResponse2ResponseObserveDirect(parent TimestampedResponseObservable, mapper MappingTimestampedResponse2ResponseFunc) ResponseObservable {
+	return MapTimestampedResponse2ResponseObservable(parent, func(ResponseObserver) MappingTimestampedResponse2ResponseFunc {
 		return mapper
 	})
 }
 
-func MapString2StringObserveNext(parent StringObservable, mapper func(string) string) StringObservable {
-	return MapString2StringObservable(parent, func(StringObserver) MappingString2StringFunc {
-		return func(next string, err error, complete bool, observer StringObserver) {
-			var mapped string
+func MapTimestampedResponse2ResponseObserveNext(parent TimestampedResponseObservable, mapper func(TimestampedResponse) *http.Response) ResponseObservable {
+	return MapTimestampedResponse2ResponseObservable(parent, func(ResponseObserver) MappingTimestampedResponse2ResponseFunc {
+		return func(next TimestampedResponse, err error, complete bool, observer ResponseObserver) {
+			var mapped *http.Response
 			if err == nil && !complete {
 				mapped = mapper(next)
 			}
-			PassthroughString(mapped, err, complete, observer)
+			PassthroughResponse(mapped, err, complete, observer)
 		}
 	},
 	)
 }
 
-type flatMapString2String struct {
-	parent StringObservable
-	mapper func(string) StringObservable
+type flatMapTimestampedResponse2Response struct {
+	parent TimestampedResponseObservable
+	mapper func(TimestampedResponse) ResponseObservable
 }
 
-func (f *flatMapString2String) Subscribe(observer StringObserver) Subscription {
+func (f *flatMapTimestampedResponse2Response) Subscribe(observer ResponseObserver) Subscription {
 	subscription := NewGenericSubscription()
 	wg := sync.WaitGroup{}
-	f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+	f.parent.Subscribe(TimestampedResponseObserverFunc(func(next TimestampedResponse, err error, complete bool) {
 		switch {
 		case err != nil:
 			wg.Wait()
@@ -2211,121 +3741,42 @@ func (f *flatMapString2String) Subscribe(observer StringObserver) Subscription {
 		default:
 			wg.Add(1)
 			observable := f.mapper(next)
-			stream := (&StringStream{observable}).
-				DoOnComplete(func() { wg.Done() }).
-				DoOnError(func(error) { wg.Done() })
-			stream = &StringStream{ignoreCompletionFilter().String(stream)}
-			stream.Subscribe(observer)
-		}
-	}))
-	return subscription
-}
-
-// Map maps values in this stream to another value.
-func (s *StringStream) Map(f func(string) string) *StringStream {
-	return FromStringObservable(MapString2StringObserveNext(s, f))
-}
-
-func (s *StringStream) FlatMap(f func(string) StringObservable) *StringStream {
-	return &StringStream{&flatMapString2String{s, f}}
-}
-
-type MappingString2IntFunc func(next string, err error, complete bool, observer IntObserver)
-type MappingString2IntFuncFactory func(observer IntObserver) MappingString2IntFunc
-
-type MappingString2IntObservable struct {
-	parent StringObservable
-	mapper MappingString2IntFuncFactory
-}
-
-func (f *MappingString2IntObservable) Subscribe(observer IntObserver) Subscription {
-	mapper := f.mapper(observer)
-	return f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
-		mapper(next, err, complete, observer)
-	}))
-}
-
-func MapString2IntObservable(parent StringObservable, mapper MappingString2IntFuncFactory) IntObservable {
-	return &MappingString2IntObservable{
-		parent: parent,
-		mapper: mapper,
-	}
-}
-
-func MapString2IntObserveDirect(parent StringObservable, mapper MappingString2IntFunc) IntObservable {
-	return MapString2IntObservable(parent, func(IntObserver) MappingString2IntFunc {
-		return mapper
-	})
-}
-
-func MapString2IntObserveNext(parent StringObservable, mapper func(string) int) IntObservable {
-	return MapString2IntObservable(parent, func(IntObserver) MappingString2IntFunc {
-		return func(next string, err error, complete bool, observer IntObserver) {
-			var mapped int
-			if err == nil && !complete {
-				mapped = mapper(next)
-			}
-			PassthroughInt(mapped, err, complete, observer)
-		}
-	},
-	)
-}
-
-type flatMapString2Int struct {
-	parent StringObservable
-	mapper func(string) IntObservable
-}
-
-func (f *flatMapString2Int) Subscribe(observer IntObserver) Subscription {
-	subscription := NewGenericSubscription()
-	wg := sync.WaitGroup{}
-	f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
-		switch {
-		case err != nil:
-			wg.Wait()
-			observer.Error(err)
-		case complete:
-			wg.Wait()
-			observer.Complete()
-		default:
-			wg.Add(1)
-			observable := f.mapper(next)
-			stream := (&IntStream{observable}).
+			stream := (&ResponseStream{observable}).
 				DoOnComplete(func() { wg.Done() }).
 				DoOnError(func(error) { wg.Done() })
-			stream = &IntStream{ignoreCompletionFilter().Int(stream)}
+			stream = &ResponseStream{ignoreCompletionFilter().Response(stream)}
 			stream.Subscribe(observer)
 		}
 	}))
 	return subscription
 }
 
-// MapInt maps this stream to an IntStream via f.
-func (s *StringStream) MapInt(f func(string) int) *IntStream {
-	return FromIntObservable(MapString2IntObserveNext(s, f))
+// MapResponse maps this stream to an ResponseStream via f.
+func (s *TimestampedResponseStream) MapResponse(f func(TimestampedResponse) *http.Response) *ResponseStream {
+	return FromResponseObservable(MapTimestampedResponse2ResponseObserveNext(s, f))
 }
 
-func (s *StringStream) FlatMapInt(f func(string) IntObservable) *IntStream {
-	return &IntStream{&flatMapString2Int{s, f}}
+func (s *TimestampedResponseStream) FlatMapResponse(f func(TimestampedResponse) ResponseObservable) *ResponseStream {
+	return &ResponseStream{&flatMapTimestampedResponse2Response{s, f}}
 }
 
-type IntObserver interface {
-	Next(int)
+type IntervalResponseObserver interface {
+	Next(IntervalResponse)
 	TerminationObserver
 }
 
-// A IntSubscriber represents a subscribed IntObserver.
-type IntSubscriber interface {
+// A IntervalResponseSubscriber represents a subscribed IntervalResponseObserver.
+type IntervalResponseSubscriber interface {
 	Subscription
-	IntObserver
+	IntervalResponseObserver
 }
 
-type implIntSubscriber struct {
+type implIntervalResponseSubscriber struct {
 	Subscription
-	IntObserver
+	IntervalResponseObserver
 }
 
-func IntObserverAsGenericObserver(observer IntObserver) GenericObserver {
+func IntervalResponseObserverAsGenericObserver(observer IntervalResponseObserver) GenericObserver {
 	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
 		switch {
 		case err != nil:
@@ -2333,13 +3784,13 @@ func IntObserverAsGenericObserver(observer IntObserver) GenericObserver {
 		case complete:
 			observer.Complete()
 		default:
-			observer.Next(next.(int))
+			observer.Next(next.(IntervalResponse))
 		}
 	})
 }
 
-func GenericObserverAsIntObserver(observer GenericObserver) IntObserver {
-	return IntObserverFunc(func(next int, err error, complete bool) {
+func GenericObserverAsIntervalResponseObserver(observer GenericObserver) IntervalResponseObserver {
+	return IntervalResponseObserverFunc(func(next IntervalResponse, err error, complete bool) {
 		switch {
 		case err != nil:
 			observer.Error(err)
@@ -2351,49 +3802,19 @@ func GenericObserverAsIntObserver(observer GenericObserver) IntObserver {
 	})
 }
 
-type IntObservableFactory func(observer IntObserver, subscription Subscription)
+type IntervalResponseObservableFactory func(observer IntervalResponseObserver, subscription Subscription)
 
-func (f IntObservableFactory) Subscribe(observer IntObserver) Subscription {
+func (f IntervalResponseObservableFactory) Subscribe(observer IntervalResponseObserver) Subscription {
 	subscription := NewGenericSubscription()
 	go f(observer, subscription)
 	return subscription
 }
 
-// CreateInt calls f(observer, subscription) to produce values for a stream.
-func CreateInt(f func(observer IntObserver, subscription Subscription)) *IntStream {
-	return FromIntObservable(IntObservableFactory(f))
-}
-
-// Repeat value count times.
-func RepeatInt(value int, count int) *IntStream {
-	return CreateInt(func(observer IntObserver, subscription Subscription) {
-		for i := 0; i < count; i++ {
-			if subscription.Closed() {
-				return
-			}
-			observer.Next(value)
-		}
-		observer.Complete()
-	})
-}
-
-// StartInt is designed to be used with functions that return a
-// (int, error) tuple.
-//
-// If the error is non-nil the returned IntStream will be that error,
-// otherwise it will be a single-value stream of int.
-func StartInt(f func() (int, error)) *IntStream {
-	return CreateInt(func(observer IntObserver, subscription Subscription) {
-		if v, err := f(); err != nil {
-			observer.Error(err)
-		} else {
-			observer.Next(v)
-			observer.Complete()
-		}
-	})
+// CreateIntervalResponse calls f(observer, subscription) to produce values for a stream.
+func CreateIntervalResponse(f func(observer IntervalResponseObserver, subscription Subscription)) *IntervalResponseStream {
+	return FromIntervalResponseObservable(IntervalResponseObservableFactory(f))
 }
-
-func PassthroughInt(next int, err error, complete bool, observer IntObserver) {
+func PassthroughIntervalResponse(next IntervalResponse, err error, complete bool, observer IntervalResponseObserver) {
 	switch {
 	case err != nil:
 		observer.Error(err)
@@ -2404,85 +3825,51 @@ func PassthroughInt(next int, err error, complete bool, observer IntObserver) {
 	}
 }
 
-var zeroInt = *new(int)
+var zeroIntervalResponse = *new(IntervalResponse)
 
-type IntObserverFunc func(int, error, bool)
+type IntervalResponseObserverFunc func(IntervalResponse, error, bool)
 
-func (f IntObserverFunc) Next(next int)   { f(next, nil, false) }
-func (f IntObserverFunc) Error(err error) { f(zeroInt, err, false) }
-func (f IntObserverFunc) Complete()       { f(zeroInt, nil, true) }
+func (f IntervalResponseObserverFunc) Next(next IntervalResponse) { f(next, nil, false) }
+func (f IntervalResponseObserverFunc) Error(err error)            { f(zeroIntervalResponse, err, false) }
+func (f IntervalResponseObserverFunc) Complete()                  { f(zeroIntervalResponse, nil, true) }
 
-type IntObservable interface {
-	Subscribe(IntObserver) Subscription
+type IntervalResponseObservable interface {
+	Subscribe(IntervalResponseObserver) Subscription
 }
 
-// Convert a GenericObservableFilter to a IntObservable
-func (f GenericObservableFilterFactory) Int(parent IntObservable) IntObservable {
-	return MapInt2IntObservable(parent, func(observer IntObserver) MappingInt2IntFunc {
-		gobserver := IntObserverAsGenericObserver(observer)
+// Convert a GenericObservableFilter to a IntervalResponseObservable
+func (f GenericObservableFilterFactory) IntervalResponse(parent IntervalResponseObservable) IntervalResponseObservable {
+	return MapIntervalResponse2IntervalResponseObservable(parent, func(observer IntervalResponseObserver) MappingIntervalResponse2IntervalResponseFunc {
+		gobserver := IntervalResponseObserverAsGenericObserver(observer)
 		filter := f(gobserver)
-		return func(next int, err error, complete bool, observer IntObserver) {
+		return func(next IntervalResponse, err error, complete bool, observer IntervalResponseObserver) {
 			filter(next, err, complete, gobserver)
 		}
 	},
 	)
 }
 
-func NeverInt() *IntStream {
-	return CreateInt(func(observer IntObserver, subscription Subscription) {})
-}
-
-func EmptyInt() *IntStream {
-	return CreateInt(func(observer IntObserver, subscription Subscription) {
-		observer.Complete()
-	})
-}
-
-func ThrowInt(err error) *IntStream {
-	return CreateInt(func(observer IntObserver, subscription Subscription) {
-		observer.Error(err)
-	})
-}
-
-func FromIntArray(array []int) *IntStream {
-	return CreateInt(func(observer IntObserver, subscription Subscription) {
+func FromIntervalResponseArray(array []IntervalResponse) *IntervalResponseStream {
+	return CreateIntervalResponse(func(observer IntervalResponseObserver, subscription Subscription) {
 		for _, v := range array {
-			if subscription.Closed() {
+			if subscription.Disposed() {
 				return
 			}
 			observer.Next(v)
 		}
 		observer.Complete()
-		subscription.Close()
+		subscription.Dispose()
 	})
 }
 
-func FromInts(array ...int) *IntStream {
-	return FromIntArray(array)
-}
-
-func JustInt(element int) *IntStream {
-	return FromIntArray([]int{element})
-}
-
-func MergeInt(observables ...IntObservable) *IntStream {
-	if len(observables) == 0 {
-		return EmptyInt()
-	}
-	return (&IntStream{observables[0]}).Merge(observables[1:]...)
-}
-
-func MergeIntDelayError(observables ...IntObservable) *IntStream {
-	if len(observables) == 0 {
-		return EmptyInt()
-	}
-	return (&IntStream{observables[0]}).MergeDelayError(observables[1:]...)
+func FromIntervalResponses(array ...IntervalResponse) *IntervalResponseStream {
+	return FromIntervalResponseArray(array)
 }
 
-func FromIntChannel(ch <-chan int) *IntStream {
-	return CreateInt(func(observer IntObserver, subscription Subscription) {
+func FromIntervalResponseChannel(ch <-chan IntervalResponse) *IntervalResponseStream {
+	return CreateIntervalResponse(func(observer IntervalResponseObserver, subscription Subscription) {
 		for v := range ch {
-			if subscription.Closed() {
+			if subscription.Disposed() {
 				return
 			}
 			observer.Next(v)
@@ -2491,369 +3878,10320 @@ func FromIntChannel(ch <-chan int) *IntStream {
 	})
 }
 
-type IntStream struct {
-	IntObservable
+type IntervalResponseStream struct {
+	IntervalResponseObservable
 }
 
-func FromIntObservable(observable IntObservable) *IntStream {
-	return &IntStream{observable}
+func FromIntervalResponseObservable(observable IntervalResponseObservable) *IntervalResponseStream {
+	return &IntervalResponseStream{observable}
 }
 
-func (s *IntStream) SubscribeFunc(f func(int, error, bool)) Subscription {
-	return s.Subscribe(IntObserverFunc(f))
+func (s *IntervalResponseStream) SubscribeFunc(f func(IntervalResponse, error, bool)) Subscription {
+	return s.Subscribe(IntervalResponseObserverFunc(f))
 }
 
-func (s *IntStream) SubscribeNext(f func(v int)) Subscription {
-	return s.SubscribeFunc(func(next int, err error, complete bool) {
+func (s *IntervalResponseStream) SubscribeNext(f func(v IntervalResponse)) Subscription {
+	return s.SubscribeFunc(func(next IntervalResponse, err error, complete bool) {
 		if err == nil && !complete {
 			f(next)
 		}
 	})
 }
 
-// Distinct removes duplicate elements in the stream.
-func (s *IntStream) Distinct() *IntStream {
-	return FromIntObservable(distinctFilter().Int(s))
-}
-
-// ElementAt yields the Nth element of the stream.
-func (s *IntStream) ElementAt(n int) *IntStream {
-	return FromIntObservable(elementAtFilter(n).Int(s))
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *IntervalResponseStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsIntervalResponseObserver(observer))
 }
 
 // Filter elements in the stream on a function.
-func (s *IntStream) Filter(f func(int) bool) *IntStream {
-	return FromIntObservable(filterFilter(func(v interface{}) bool { return f(v.(int)) }).Int(s))
-}
-
-// Last returns just the first element of the stream.
-func (s *IntStream) First() *IntStream {
-	return FromIntObservable(firstFilter().Int(s))
+func (s *IntervalResponseStream) Filter(f func(IntervalResponse) bool) *IntervalResponseStream {
+	return FromIntervalResponseObservable(filterFilter(func(v interface{}) bool { return f(v.(IntervalResponse)) }).IntervalResponse(s))
 }
 
-// Last returns just the last element of the stream.
-func (s *IntStream) Last() *IntStream {
-	return FromIntObservable(lastFilter().Int(s))
+// Do applies a function for each value passing through the stream.
+func (s *IntervalResponseStream) Do(f func(next IntervalResponse)) *IntervalResponseStream {
+	return FromIntervalResponseObservable(MapIntervalResponse2IntervalResponseObserveNext(s, func(next IntervalResponse) IntervalResponse {
+		f(next)
+		return next
+	}))
 }
 
-// SkipLast skips the first N elements of the stream.
-func (s *IntStream) Skip(n int) *IntStream {
-	return FromIntObservable(skipFilter(n).Int(s))
+// DoOnError applies a function for any error on the stream.
+func (s *IntervalResponseStream) DoOnError(f func(err error)) *IntervalResponseStream {
+	return FromIntervalResponseObservable(MapIntervalResponse2IntervalResponseObserveDirect(s, func(next IntervalResponse, err error, complete bool, observer IntervalResponseObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughIntervalResponse(next, err, complete, observer)
+	}))
 }
 
-// SkipLast skips the last N elements of the stream.
-func (s *IntStream) SkipLast(n int) *IntStream {
-	return FromIntObservable(skipLastFilter(n).Int(s))
+// DoOnComplete applies a function when the stream completes.
+func (s *IntervalResponseStream) DoOnComplete(f func()) *IntervalResponseStream {
+	return FromIntervalResponseObservable(MapIntervalResponse2IntervalResponseObserveDirect(s, func(next IntervalResponse, err error, complete bool, observer IntervalResponseObserver) {
+		if complete {
+			f()
+		}
+		PassthroughIntervalResponse(next, err, complete, observer)
+	}))
 }
 
-// Take returns just the first N elements of the stream.
-func (s *IntStream) Take(n int) *IntStream {
-	return FromIntObservable(takeFilter(n).Int(s))
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *IntervalResponseStream) ToOneWithError() (IntervalResponse, error) {
+	valuech := make(chan IntervalResponse, 1)
+	errch := make(chan error, 1)
+	FromIntervalResponseObservable(oneFilter().IntervalResponse(s)).SubscribeFunc(func(next IntervalResponse, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroIntervalResponse, err
+	}
 }
 
-// TakeLast returns just the last N elements of the stream.
-func (s *IntStream) TakeLast(n int) *IntStream {
-	return FromIntObservable(takeLastFilter(n).Int(s))
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *IntervalResponseStream) ToOne() IntervalResponse {
+	value, _ := s.ToOneWithError()
+	return value
 }
 
-// IgnoreElements ignores elements of the stream and emits only the completion events.
-func (s *IntStream) IgnoreElements() *IntStream {
-	return FromIntObservable(ignoreElementsFilter().Int(s))
-}
-
-func (s *IntStream) Replay(size int, duration time.Duration) *IntStream {
-	return FromIntObservable(replayFilter(size, duration).Int(s))
-}
-
-func (s *IntStream) Sample(duration time.Duration) *IntStream {
-	return FromIntObservable(sampleFilter(duration).Int(s))
-}
-
-func (s *IntStream) Debounce(duration time.Duration) *IntStream {
-	return FromIntObservable(debounceFilter(duration).Int(s))
-}
-
-// Wait for completion of the stream and return any error.
-func (s *IntStream) Wait() error {
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *IntervalResponseStream) ToArrayWithError() ([]IntervalResponse, error) {
+	array := []IntervalResponse{}
+	completech := make(chan bool, 1)
 	errch := make(chan error, 1)
-	s.SubscribeFunc(func(next int, err error, complete bool) {
+	s.SubscribeFunc(func(next IntervalResponse, err error, complete bool) {
 		switch {
 		case err != nil:
 			errch <- err
 		case complete:
-			errch <- nil
+			completech <- true
 		default:
+			array = append(array, next)
 		}
 	})
-	return <-errch
-}
-
-func MakeIntSubscriber(observer IntObserver) IntSubscriber {
-	if subscriber, ok := observer.(IntSubscriber); ok {
-		return subscriber
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
 	}
-	return &implIntSubscriber{NewGenericSubscription(), observer}
 }
 
-type concatIntSubscriber struct {
-	observable  int
-	observer    IntObserver
-	observables []IntObservable
-	Subscription
+// ToArray blocks and returns the values from the stream in an array.
+func (s *IntervalResponseStream) ToArray() []IntervalResponse {
+	out, _ := s.ToArrayWithError()
+	return out
 }
 
-func (c *concatIntSubscriber) Next(next int) {
-	c.observer.Next(next)
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *IntervalResponseStream) ToChannelWithError() (<-chan IntervalResponse, <-chan error) {
+	ch := make(chan IntervalResponse, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntervalResponse, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
 }
 
-func (c *concatIntSubscriber) Error(err error) {
-	c.observer.Error(err)
-	c.observable = len(c.observables)
-	c.Close()
+func (s *IntervalResponseStream) ToChannel() <-chan IntervalResponse {
+	ch, _ := s.ToChannelWithError()
+	return ch
 }
 
-func (c *concatIntSubscriber) Complete() {
-	c.observable++
-	if c.observable >= len(c.observables) {
-		c.observer.Complete()
-		c.Close()
-		return
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *IntervalResponseStream) Seq() iter.Seq[IntervalResponse] {
+	return func(yield func(IntervalResponse) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
 	}
-	c.observables[c.observable].Subscribe(c)
 }
 
-type concatIntObservable struct {
-	observables []IntObservable
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *IntervalResponseStream) Seq2() iter.Seq2[IntervalResponse, error] {
+	return func(yield func(IntervalResponse, error) bool) {
+		type notification struct {
+			next     IntervalResponse
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next IntervalResponse, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroIntervalResponse, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
 }
 
-func (m *concatIntObservable) Subscribe(observer IntObserver) Subscription {
-	if len(m.observables) == 0 {
-		observer.Complete()
-		return ClosedSubscription
-	}
-	subscriber := &concatIntSubscriber{
-		observer:     observer,
-		Subscription: NewGenericSubscription(),
-		observables:  m.observables,
-	}
-	m.observables[0].Subscribe(subscriber)
-	return subscriber
+type mapIntervalResponse2String struct {
+	parent IntervalResponseObservable
+	f      func(IntervalResponse) string
 }
 
-func (s *IntStream) Concat(observables ...IntObservable) *IntStream {
-	return &IntStream{&concatIntObservable{append([]IntObservable{s}, observables...)}}
+func (m *mapIntervalResponse2String) Subscribe(observer StringObserver) Subscription {
+	return m.parent.Subscribe(IntervalResponseObserverFunc(func(next IntervalResponse, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
 }
 
-type mergeIntObservable struct {
-	delayError  bool
-	observables []IntObservable
+// MapString maps this stream to an StringStream via f.
+func (s *IntervalResponseStream) MapString(f func(IntervalResponse) string) *StringStream {
+	return &StringStream{&mapIntervalResponse2String{s, f}}
 }
 
-func (m *mergeIntObservable) Subscribe(observer IntObserver) Subscription {
-	subscription := NewGenericSubscription()
-	lock := sync.Mutex{}
-	completed := 0
-	var firstError error
-	relay := func(next int, err error, complete bool) {
-		lock.Lock()
-		defer lock.Unlock()
-		if completed >= len(m.observables) {
-			return
-		}
+type mapIntervalResponse2Int struct {
+	parent IntervalResponseObservable
+	f      func(IntervalResponse) int
+}
 
+func (m *mapIntervalResponse2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(IntervalResponseObserverFunc(func(next IntervalResponse, err error, complete bool) {
 		switch {
 		case err != nil:
-			if m.delayError {
-				firstError = err
-				completed++
-			} else {
-				observer.Error(err)
-				completed = len(m.observables)
-			}
-
+			observer.Error(err)
 		case complete:
-			completed++
-			if completed == len(m.observables) {
-				if firstError != nil {
-					observer.Error(firstError)
-				} else {
-					observer.Complete()
-				}
-			}
+			observer.Complete()
 		default:
-			observer.Next(next)
+			observer.Next(m.f(next))
 		}
-	}
-	for _, observable := range m.observables {
-		observable.Subscribe(IntObserverFunc(relay))
-	}
-	return subscription
-}
-
-// Merge an arbitrary number of observables with this one.
-// An error from any of the observables will terminate the merged stream.
-func (s *IntStream) Merge(other ...IntObservable) *IntStream {
-	if len(other) == 0 {
-		return s
-	}
-	return &IntStream{&mergeIntObservable{false, append(other, s)}}
+	}))
 }
 
-// Merge an arbitrary number of observables with this one.
-// Any error will be deferred until all observables terminate.
-func (s *IntStream) MergeDelayError(other ...IntObservable) *IntStream {
-	if len(other) == 0 {
-		return s
-	}
-	return &IntStream{&mergeIntObservable{true, append(other, s)}}
+// MapInt maps this stream to an IntStream via f.
+func (s *IntervalResponseStream) MapInt(f func(IntervalResponse) int) *IntStream {
+	return &IntStream{&mapIntervalResponse2Int{s, f}}
 }
 
-type catchIntObservable struct {
-	parent IntObservable
-	catch  IntObservable
+type mapIntervalResponse2Bool struct {
+	parent IntervalResponseObservable
+	f      func(IntervalResponse) bool
 }
 
-func (r *catchIntObservable) Subscribe(observer IntObserver) Subscription {
-	subscription := NewGenericSubscription()
-	run := func(next int, err error, complete bool) {
+func (m *mapIntervalResponse2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(IntervalResponseObserverFunc(func(next IntervalResponse, err error, complete bool) {
 		switch {
 		case err != nil:
-			r.catch.Subscribe(observer)
+			observer.Error(err)
 		case complete:
 			observer.Complete()
 		default:
-			observer.Next(next)
+			observer.Next(m.f(next))
 		}
-	}
-	r.parent.Subscribe(IntObserverFunc(run))
-	return subscription
+	}))
 }
 
-func (s *IntStream) Catch(catch IntObservable) *IntStream {
-	return &IntStream{&catchIntObservable{s, catch}}
+// MapBool maps this stream to an BoolStream via f.
+func (s *IntervalResponseStream) MapBool(f func(IntervalResponse) bool) *BoolStream {
+	return &BoolStream{&mapIntervalResponse2Bool{s, f}}
 }
 
-type retryIntObservable struct {
-	observable IntObservable
+type MappingIntervalResponse2IntervalResponseFunc func(next IntervalResponse, err error, complete bool, observer IntervalResponseObserver)
+type MappingIntervalResponse2IntervalResponseFuncFactory func(observer IntervalResponseObserver) MappingIntervalResponse2IntervalResponseFunc
+
+type MappingIntervalResponse2IntervalResponseObservable struct {
+	parent IntervalResponseObservable
+	mapper MappingIntervalResponse2IntervalResponseFuncFactory
 }
 
-type retryIntObserver struct {
-	observable IntObservable
-	observer   IntObserver
+func (f *MappingIntervalResponse2IntervalResponseObservable) Subscribe(observer IntervalResponseObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntervalResponseObserverFunc(func(next IntervalResponse, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
 }
 
-func (r *retryIntObserver) retry(next int, err error, complete bool) {
-	switch {
-	case err != nil:
-		r.observable.Subscribe(IntObserverFunc(r.retry))
-	case complete:
-		r.observer.Complete()
-	default:
-		r.observer.Next(next)
+func MapIntervalResponse2IntervalResponseObservable(parent IntervalResponseObservable, mapper MappingIntervalResponse2IntervalResponseFuncFactory) IntervalResponseObservable {
+	return &MappingIntervalResponse2IntervalResponseObservable{
+		parent: parent,
+		mapper: mapper,
 	}
 }
 
-func (r *retryIntObservable) Subscribe(observer IntObserver) Subscription {
-	subscription := NewGenericSubscription()
-	ro := &retryIntObserver{r.observable, observer}
-	r.observable.Subscribe(IntObserverFunc(ro.retry))
-	return subscription
+func MapIntervalResponse2IntervalResponseObserveDirect(parent IntervalResponseObservable, mapper MappingIntervalResponse2IntervalResponseFunc) IntervalResponseObservable {
+	return MapIntervalResponse2IntervalResponseObservable(parent, func(IntervalResponseObserver) MappingIntervalResponse2IntervalResponseFunc {
+		return mapper
+	})
 }
 
-func (s *IntStream) Retry() *IntStream {
-	return &IntStream{&retryIntObservable{s}}
+func MapIntervalResponse2IntervalResponseObserveNext(parent IntervalResponseObservable, mapper func(IntervalResponse) IntervalResponse) IntervalResponseObservable {
+	return MapIntervalResponse2IntervalResponseObservable(parent, func(IntervalResponseObserver) MappingIntervalResponse2IntervalResponseFunc {
+		return func(next IntervalResponse, err error, complete bool, observer IntervalResponseObserver) {
+			var mapped IntervalResponse
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntervalResponse(mapped, err, complete, observer)
+		}
+	},
+	)
 }
 
-// Do applies a function for each value passing through the stream.
-func (s *IntStream) Do(f func(next int)) *IntStream {
-	return FromIntObservable(MapInt2IntObserveNext(s, func(next int) int {
-		f(next)
-		return next
-	}))
+// Map maps values in this stream to another value.
+func (s *IntervalResponseStream) Map(f func(IntervalResponse) IntervalResponse) *IntervalResponseStream {
+	return FromIntervalResponseObservable(MapIntervalResponse2IntervalResponseObserveNext(s, f))
 }
 
-// DoOnError applies a function for any error on the stream.
-func (s *IntStream) DoOnError(f func(err error)) *IntStream {
-	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
-		if err != nil {
-			f(err)
-		}
-		PassthroughInt(next, err, complete, observer)
+type MappingIntervalResponse2ResponseFunc func(next IntervalResponse, err error, complete bool, observer ResponseObserver)
+type MappingIntervalResponse2ResponseFuncFactory func(observer ResponseObserver) MappingIntervalResponse2ResponseFunc
+
+type MappingIntervalResponse2ResponseObservable struct {
+	parent IntervalResponseObservable
+	mapper MappingIntervalResponse2ResponseFuncFactory
+}
+
+func (f *MappingIntervalResponse2ResponseObservable) Subscribe(observer ResponseObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntervalResponseObserverFunc(func(next IntervalResponse, err error, complete bool) {
+		mapper(next, err, complete, observer)
 	}))
 }
 
-// DoOnComplete applies a function when the stream completes.
-func (s *IntStream) DoOnComplete(f func()) *IntStream {
-	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
-		if complete {
-			f()
+func MapIntervalResponse2ResponseObservable(parent IntervalResponseObservable, mapper MappingIntervalResponse2ResponseFuncFactory) ResponseObservable {
+	return &MappingIntervalResponse2ResponseObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntervalResponse2ResponseObserveDirect(parent IntervalResponseObservable, mapper MappingIntervalResponse2ResponseFunc) ResponseObservable {
+	return MapIntervalResponse2ResponseObservable(parent, func(ResponseObserver) MappingIntervalResponse2ResponseFunc {
+		return mapper
+	})
+}
+
+func MapIntervalResponse2ResponseObserveNext(parent IntervalResponseObservable, mapper func(IntervalResponse) *http.Response) ResponseObservable {
+	return MapIntervalResponse2ResponseObservable(parent, func(ResponseObserver) MappingIntervalResponse2ResponseFunc {
+		return func(next IntervalResponse, err error, complete bool, observer ResponseObserver) {
+			var mapped *http.Response
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughResponse(mapped, err, complete, observer)
 		}
-		PassthroughInt(next, err, complete, observer)
-	}))
+	},
+	)
 }
 
-func (s *IntStream) Reduce(initial int, reducer func(int, int) int) *IntStream {
-	value := initial
-	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+type flatMapIntervalResponse2Response struct {
+	parent IntervalResponseObservable
+	mapper func(IntervalResponse) ResponseObservable
+}
+
+func (f *flatMapIntervalResponse2Response) Subscribe(observer ResponseObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntervalResponseObserverFunc(func(next IntervalResponse, err error, complete bool) {
 		switch {
 		case err != nil:
-			observer.Next(value)
+			wg.Wait()
 			observer.Error(err)
 		case complete:
-			observer.Next(value)
+			wg.Wait()
 			observer.Complete()
 		default:
-			value = reducer(value, next)
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&ResponseStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &ResponseStream{ignoreCompletionFilter().Response(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapResponse maps this stream to an ResponseStream via f.
+func (s *IntervalResponseStream) MapResponse(f func(IntervalResponse) *http.Response) *ResponseStream {
+	return FromResponseObservable(MapIntervalResponse2ResponseObserveNext(s, f))
+}
+
+func (s *IntervalResponseStream) FlatMapResponse(f func(IntervalResponse) ResponseObservable) *ResponseStream {
+	return &ResponseStream{&flatMapIntervalResponse2Response{s, f}}
+}
+
+type StringObserver interface {
+	Next(string)
+	TerminationObserver
+}
+
+// A StringSubscriber represents a subscribed StringObserver.
+type StringSubscriber interface {
+	Subscription
+	StringObserver
+}
+
+type implStringSubscriber struct {
+	Subscription
+	StringObserver
+}
+
+func StringObserverAsGenericObserver(observer StringObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(string))
+		}
+	})
+}
+
+func GenericObserverAsStringObserver(observer GenericObserver) StringObserver {
+	return StringObserverFunc(func(next string, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type StringObservableFactory func(observer StringObserver, subscription Subscription)
+
+func (f StringObservableFactory) Subscribe(observer StringObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateString calls f(observer, subscription) to produce values for a stream.
+func CreateString(f func(observer StringObserver, subscription Subscription)) *StringStream {
+	return FromStringObservable(StringObservableFactory(f))
+}
+
+// Repeat value count times.
+func RepeatString(value string, count int) *StringStream {
+	return CreateString(func(observer StringObserver, subscription Subscription) {
+		for i := 0; i < count; i++ {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(value)
+		}
+		observer.Complete()
+	})
+}
+
+// StartString is designed to be used with functions that return a
+// (string, error) tuple.
+//
+// If the error is non-nil the returned StringStream will be that error,
+// otherwise it will be a single-value stream of string.
+func StartString(f func() (string, error)) *StringStream {
+	return CreateString(func(observer StringObserver, subscription Subscription) {
+		if v, err := f(); err != nil {
+			observer.Error(err)
+		} else {
+			observer.Next(v)
+			observer.Complete()
+		}
+	})
+}
+
+type deferStringObservable func() StringObservable
+
+func (f deferStringObservable) Subscribe(observer StringObserver) Subscription {
+	return f().Subscribe(observer)
+}
+
+// DeferString calls f to create a fresh observable for each subscription.
+func DeferString(f func() StringObservable) *StringStream {
+	return FromStringObservable(deferStringObservable(f))
+}
+func PassthroughString(next string, err error, complete bool, observer StringObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroString = *new(string)
+
+type StringObserverFunc func(string, error, bool)
+
+func (f StringObserverFunc) Next(next string) { f(next, nil, false) }
+func (f StringObserverFunc) Error(err error)  { f(zeroString, err, false) }
+func (f StringObserverFunc) Complete()        { f(zeroString, nil, true) }
+
+type StringObservable interface {
+	Subscribe(StringObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a StringObservable
+func (f GenericObservableFilterFactory) String(parent StringObservable) StringObservable {
+	return MapString2StringObservable(parent, func(observer StringObserver) MappingString2StringFunc {
+		gobserver := StringObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next string, err error, complete bool, observer StringObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func NeverString() *StringStream {
+	return CreateString(func(observer StringObserver, subscription Subscription) {})
+}
+
+func EmptyString() *StringStream {
+	return CreateString(func(observer StringObserver, subscription Subscription) {
+		observer.Complete()
+	})
+}
+
+func ThrowString(err error) *StringStream {
+	return CreateString(func(observer StringObserver, subscription Subscription) {
+		observer.Error(err)
+	})
+}
+func FromStringArray(array []string) *StringStream {
+	return CreateString(func(observer StringObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromStrings(array ...string) *StringStream {
+	return FromStringArray(array)
+}
+
+// FromStringSeq emits each value yielded by seq, iterating it once per
+// subscription.
+func FromStringSeq(seq iter.Seq[string]) *StringStream {
+	return CreateString(func(observer StringObserver, subscription Subscription) {
+		for v := range seq {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+func JustString(element string) *StringStream {
+	return FromStringArray([]string{element})
+}
+
+func MergeString(observables ...StringObservable) *StringStream {
+	if len(observables) == 0 {
+		return EmptyString()
+	}
+	return (&StringStream{observables[0]}).Merge(observables[1:]...)
+}
+
+func MergeStringDelayError(observables ...StringObservable) *StringStream {
+	if len(observables) == 0 {
+		return EmptyString()
+	}
+	return (&StringStream{observables[0]}).MergeDelayError(observables[1:]...)
+}
+
+type ambStringObservable []StringObservable
+
+func (a ambStringObservable) Subscribe(observer StringObserver) Subscription {
+	lock := sync.Mutex{}
+	winner := -1
+	subscriptions := make([]*LinkedSubscription, len(a))
+	for i := range subscriptions {
+		subscriptions[i] = NewLinkedSubscription()
+	}
+	for i, observable := range a {
+		i := i
+		subscriptions[i].Link(observable.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+			lock.Lock()
+			if winner == -1 {
+				winner = i
+				for j, subscription := range subscriptions {
+					if j != i {
+						subscription.Dispose()
+					}
+				}
+			}
+			won := winner == i
+			lock.Unlock()
+			if won {
+				PassthroughString(next, err, complete, observer)
+			}
+		})))
+	}
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		for _, s := range subscriptions {
+			s.Dispose()
+		}
+	})
+	return subscription
+}
+
+// AmbString subscribes to all observables and mirrors whichever emits
+// first, disposing the others.
+func AmbString(observables ...StringObservable) *StringStream {
+	if len(observables) == 0 {
+		return EmptyString()
+	}
+	return FromStringObservable(ambStringObservable(observables))
+}
+func FromStringChannel(ch <-chan string) *StringStream {
+	return CreateString(func(observer StringObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type StringStream struct {
+	StringObservable
+}
+
+func FromStringObservable(observable StringObservable) *StringStream {
+	return &StringStream{observable}
+}
+
+func (s *StringStream) SubscribeFunc(f func(string, error, bool)) Subscription {
+	return s.Subscribe(StringObserverFunc(f))
+}
+
+func (s *StringStream) SubscribeNext(f func(v string)) Subscription {
+	return s.SubscribeFunc(func(next string, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *StringStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsStringObserver(observer))
+}
+
+// Distinct removes duplicate elements in the stream. Values that can not be
+// map keys, such as slices, are compared with reflect.DeepEqual.
+func (s *StringStream) Distinct() *StringStream {
+	return FromStringObservable(distinctFilter().String(s))
+}
+
+// ElementAt yields the Nth element of the stream.
+func (s *StringStream) ElementAt(n int) *StringStream {
+	return FromStringObservable(elementAtFilter(n).String(s))
+}
+
+// Filter elements in the stream on a function.
+func (s *StringStream) Filter(f func(string) bool) *StringStream {
+	return FromStringObservable(filterFilter(func(v interface{}) bool { return f(v.(string)) }).String(s))
+}
+
+// Last returns just the first element of the stream.
+func (s *StringStream) First() *StringStream {
+	return FromStringObservable(firstFilter().String(s))
+}
+
+// Last returns just the last element of the stream.
+func (s *StringStream) Last() *StringStream {
+	return FromStringObservable(lastFilter().String(s))
+}
+
+// SkipLast skips the first N elements of the stream.
+func (s *StringStream) Skip(n int) *StringStream {
+	return FromStringObservable(skipFilter(n).String(s))
+}
+
+// SkipLast skips the last N elements of the stream.
+func (s *StringStream) SkipLast(n int) *StringStream {
+	return FromStringObservable(skipLastFilter(n).String(s))
+}
+
+// Take returns just the first N elements of the stream.
+func (s *StringStream) Take(n int) *StringStream {
+	return FromStringObservable(takeFilter(n).String(s))
+}
+
+// TakeLast returns just the last N elements of the stream.
+func (s *StringStream) TakeLast(n int) *StringStream {
+	return FromStringObservable(takeLastFilter(n).String(s))
+}
+
+type takeWhileString struct {
+	parent StringObservable
+	f      func(string) bool
+}
+
+func (t *takeWhileString) Subscribe(observer StringObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	parent.Link(t.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Complete()
+		case t.f(next):
+			observer.Next(next)
+		default:
+			done = true
+			observer.Complete()
+			parent.Dispose()
+		}
+	})))
+	return parent
+}
+
+// TakeWhile returns elements of the stream until f returns false, then
+// completes and disposes the stream.
+func (s *StringStream) TakeWhile(f func(string) bool) *StringStream {
+	return &StringStream{&takeWhileString{s, f}}
+}
+
+// SkipWhile skips elements of the stream until f returns false.
+func (s *StringStream) SkipWhile(f func(string) bool) *StringStream {
+	return FromStringObservable(MapString2StringObservable(s, func(StringObserver) MappingString2StringFunc {
+		skipping := true
+		return func(next string, err error, complete bool, observer StringObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if skipping && !f(next) {
+					skipping = false
+				}
+				if !skipping {
+					observer.Next(next)
+				}
+			}
+		}
+	}))
+}
+
+// IgnoreElements ignores elements of the stream and emits only the completion events.
+func (s *StringStream) IgnoreElements() *StringStream {
+	return FromStringObservable(ignoreElementsFilter().String(s))
+}
+
+func (s *StringStream) Replay(size int, duration time.Duration) *StringStream {
+	return FromStringObservable(replayFilter(size, duration).String(s))
+}
+
+func (s *StringStream) Sample(duration time.Duration) *StringStream {
+	return FromStringObservable(sampleFilter(duration).String(s))
+}
+
+func (s *StringStream) Debounce(duration time.Duration) *StringStream {
+	return FromStringObservable(debounceFilter(duration).String(s))
+}
+
+type delayString struct {
+	parent     StringObservable
+	delay      func(string) time.Duration
+	completion time.Duration
+}
+
+func (d *delayString) Subscribe(observer StringObserver) Subscription {
+	queue := newDelayQueue(StringObserverAsGenericObserver(observer))
+	parent := d.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		switch {
+		case err != nil:
+			queue.error(err)
+		case complete:
+			queue.enqueue(delayedEntry{complete: true}, d.completion)
+		default:
+			queue.enqueue(delayedEntry{next: next}, d.delay(next))
+		}
+	}))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		queue.dispose()
+	})
+	return subscription
+}
+
+// Delay shifts each value, and completion, forward in time by duration. Errors
+// are not delayed. Values that are still pending are discarded on disposal.
+func (s *StringStream) Delay(duration time.Duration) *StringStream {
+	return &StringStream{&delayString{s, func(string) time.Duration { return duration }, duration}}
+}
+
+// DelayWhen shifts each value forward in time by the duration returned by f.
+// Values are never reordered, so a value is emitted no earlier than the value
+// before it. Completion is emitted after the last value. Errors are not delayed.
+func (s *StringStream) DelayWhen(f func(string) time.Duration) *StringStream {
+	return &StringStream{&delayString{s, f, 0}}
+}
+
+// Wait for completion of the stream and return any error.
+func (s *StringStream) Wait() error {
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next string, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			errch <- nil
+		default:
+		}
+	})
+	return <-errch
+}
+
+func MakeStringSubscriber(observer StringObserver) StringSubscriber {
+	if subscriber, ok := observer.(StringSubscriber); ok {
+		return subscriber
+	}
+	return &implStringSubscriber{NewGenericSubscription(), observer}
+}
+
+type concatStringSubscriber struct {
+	observable  int
+	observer    StringObserver
+	observables []StringObservable
+	Subscription
+}
+
+func (c *concatStringSubscriber) Next(next string) {
+	c.observer.Next(next)
+}
+
+func (c *concatStringSubscriber) Error(err error) {
+	c.observer.Error(err)
+	c.observable = len(c.observables)
+	c.Dispose()
+}
+
+func (c *concatStringSubscriber) Complete() {
+	c.observable++
+	if c.observable >= len(c.observables) {
+		c.observer.Complete()
+		c.Dispose()
+		return
+	}
+	c.observables[c.observable].Subscribe(c)
+}
+
+type concatStringObservable struct {
+	observables []StringObservable
+}
+
+func (m *concatStringObservable) Subscribe(observer StringObserver) Subscription {
+	if len(m.observables) == 0 {
+		observer.Complete()
+		return ClosedSubscription
+	}
+	subscriber := &concatStringSubscriber{
+		observer:     observer,
+		Subscription: NewGenericSubscription(),
+		observables:  m.observables,
+	}
+	m.observables[0].Subscribe(subscriber)
+	return subscriber
+}
+
+func (s *StringStream) Concat(observables ...StringObservable) *StringStream {
+	return &StringStream{&concatStringObservable{append([]StringObservable{s}, observables...)}}
+}
+
+// StartWith emits values before the values of the stream.
+func (s *StringStream) StartWith(values ...string) *StringStream {
+	return FromStringArray(values).Concat(s)
+}
+
+// EndWith emits values after the stream completes.
+func (s *StringStream) EndWith(values ...string) *StringStream {
+	return s.Concat(FromStringArray(values))
+}
+
+// DefaultIfEmpty emits value if the stream completes without emitting any values.
+func (s *StringStream) DefaultIfEmpty(value string) *StringStream {
+	return FromStringObservable(MapString2StringObservable(s, func(StringObserver) MappingString2StringFunc {
+		empty := true
+		return func(next string, err error, complete bool, observer StringObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				if empty {
+					observer.Next(value)
+				}
+				observer.Complete()
+			default:
+				empty = false
+				observer.Next(next)
+			}
+		}
+	}))
+}
+
+type switchIfEmptyStringObservable struct {
+	parent StringObservable
+	other  StringObservable
+}
+
+func (e *switchIfEmptyStringObservable) Subscribe(observer StringObserver) Subscription {
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
+	empty := true
+	link.Link(e.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			if !empty {
+				observer.Complete()
+			} else if !subscription.Disposed() {
+				subscription.Set(e.other.Subscribe(observer))
+			}
+		default:
+			empty = false
+			observer.Next(next)
+		}
+	})))
+	return subscription
+}
+
+// SwitchIfEmpty switches to other if the stream completes without emitting any values.
+func (s *StringStream) SwitchIfEmpty(other StringObservable) *StringStream {
+	return &StringStream{&switchIfEmptyStringObservable{s, other}}
+}
+
+type mergeStringObservable struct {
+	delayError  bool
+	observables []StringObservable
+}
+
+func (m *mergeStringObservable) Subscribe(observer StringObserver) Subscription {
+	subscription := NewGenericSubscription()
+	lock := sync.Mutex{}
+	completed := 0
+	var firstError error
+	relay := func(next string, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if completed >= len(m.observables) {
+			return
+		}
+
+		switch {
+		case err != nil:
+			if m.delayError {
+				firstError = err
+				completed++
+			} else {
+				observer.Error(err)
+				completed = len(m.observables)
+			}
+
+		case complete:
+			completed++
+			if completed == len(m.observables) {
+				if firstError != nil {
+					observer.Error(firstError)
+				} else {
+					observer.Complete()
+				}
+			}
+		default:
+			observer.Next(next)
+		}
+	}
+	for _, observable := range m.observables {
+		observable.Subscribe(StringObserverFunc(relay))
+	}
+	return subscription
+}
+
+// Merge an arbitrary number of observables with this one.
+// An error from any of the observables will terminate the merged stream.
+func (s *StringStream) Merge(other ...StringObservable) *StringStream {
+	if len(other) == 0 {
+		return s
+	}
+	return &StringStream{&mergeStringObservable{false, append(other, s)}}
+}
+
+// Merge an arbitrary number of observables with this one.
+// Any error will be deferred until all observables terminate.
+func (s *StringStream) MergeDelayError(other ...StringObservable) *StringStream {
+	if len(other) == 0 {
+		return s
+	}
+	return &StringStream{&mergeStringObservable{true, append(other, s)}}
+}
+
+// Amb mirrors whichever of this stream and others emits first, disposing the rest.
+func (s *StringStream) Amb(others ...StringObservable) *StringStream {
+	return AmbString(append([]StringObservable{s}, others...)...)
+}
+
+type catchStringObservable struct {
+	parent StringObservable
+	catch  func(err error) StringObservable
+	// Also switch to the fallback when the parent completes. err will be nil.
+	resume bool
+}
+
+func (r *catchStringObservable) Subscribe(observer StringObserver) Subscription {
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
+	run := func(next string, err error, complete bool) {
+		switch {
+		case err != nil || (complete && r.resume):
+			if !subscription.Disposed() {
+				subscription.Set(r.catch(err).Subscribe(observer))
+			}
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	}
+	link.Link(r.parent.Subscribe(StringObserverFunc(run)))
+	return subscription
+}
+
+// Catch switches to the catch observable if the stream errors.
+func (s *StringStream) Catch(catch StringObservable) *StringStream {
+	return s.CatchFunc(func(error) StringObservable { return catch })
+}
+
+// CatchFunc switches to the observable returned by f(err) if the stream errors.
+func (s *StringStream) CatchFunc(f func(err error) StringObservable) *StringStream {
+	return &StringStream{&catchStringObservable{parent: s, catch: f}}
+}
+
+// OnErrorReturn emits the single value returned by f(err) and completes if the stream errors.
+func (s *StringStream) OnErrorReturn(f func(err error) string) *StringStream {
+	return s.CatchFunc(func(err error) StringObservable { return JustString(f(err)) })
+}
+
+// OnErrorResumeNext switches to next when the stream terminates, whether it
+// completes or errors.
+func (s *StringStream) OnErrorResumeNext(next StringObservable) *StringStream {
+	return &StringStream{&catchStringObservable{
+		parent: s,
+		catch:  func(error) StringObservable { return next },
+		resume: true,
+	}}
+}
+
+type retryStringObservable struct {
+	observable StringObservable
+	policy     RetryPolicy
+}
+
+func (r *retryStringObservable) Subscribe(observer StringObserver) Subscription {
+	subscription := NewSerialSubscription()
+	attempt := 0
+	var subscribe func()
+	subscribe = func() {
+		// Set the link before subscribing, in case the observable fails
+		// and is resubscribed before Subscribe returns.
+		link := NewLinkedSubscription()
+		subscription.Set(link)
+		link.Link(r.observable.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+			switch {
+			case err != nil:
+				if subscription.Disposed() {
+					return
+				}
+				attempt++
+				delay, ok := r.policy(err, attempt)
+				switch {
+				case !ok:
+					observer.Error(err)
+				case delay > 0:
+					time.AfterFunc(delay, func() {
+						if !subscription.Disposed() {
+							subscribe()
+						}
+					})
+				default:
+					subscribe()
+				}
+			case complete:
+				observer.Complete()
+			default:
+				attempt = 0
+				observer.Next(next)
+			}
+		})))
+	}
+	subscribe()
+	return subscription
+}
+
+// Retry resubscribes to the stream immediately, and indefinitely, on error.
+func (s *StringStream) Retry() *StringStream {
+	return s.RetryWhen(func(error, int) (time.Duration, bool) { return 0, true })
+}
+
+// RetryN resubscribes to the stream on error, at most n times in a row without
+// the stream emitting a value in between.
+func (s *StringStream) RetryN(n int) *StringStream {
+	return s.RetryWhen(func(err error, attempt int) (time.Duration, bool) { return 0, attempt <= n })
+}
+
+// RetryWhen calls policy on each error to decide whether, and after how long,
+// to resubscribe to the stream. attempt counts consecutive errors, starting at
+// 1 and reset whenever the stream emits a value.
+func (s *StringStream) RetryWhen(policy func(err error, attempt int) (time.Duration, bool)) *StringStream {
+	return &StringStream{&retryStringObservable{s, policy}}
+}
+
+// RetryWithBackoff resubscribes to the stream on error, indefinitely, with
+// an ExponentialBackoff delay. The delay is reset to initial whenever the
+// stream emits a value.
+func (s *StringStream) RetryWithBackoff(initial, max time.Duration, multiplier, jitter float64) *StringStream {
+	return s.RetryWhen(ExponentialBackoff(initial, max, multiplier, jitter))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *StringStream) Do(f func(next string)) *StringStream {
+	return FromStringObservable(MapString2StringObserveNext(s, func(next string) string {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *StringStream) DoOnError(f func(err error)) *StringStream {
+	return FromStringObservable(MapString2StringObserveDirect(s, func(next string, err error, complete bool, observer StringObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughString(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *StringStream) DoOnComplete(f func()) *StringStream {
+	return FromStringObservable(MapString2StringObserveDirect(s, func(next string, err error, complete bool, observer StringObserver) {
+		if complete {
+			f()
+		}
+		PassthroughString(next, err, complete, observer)
+	}))
+}
+func (s *StringStream) Reduce(initial string, reducer func(string, string) string) *StringStream {
+	value := initial
+	return FromStringObservable(MapString2StringObserveDirect(s, func(next string, err error, complete bool, observer StringObserver) {
+		switch {
+		case err != nil:
+			observer.Next(value)
+			observer.Error(err)
+		case complete:
+			observer.Next(value)
+			observer.Complete()
+		default:
+			value = reducer(value, next)
+		}
+	}))
+}
+
+func (s *StringStream) Scan(initial string, f func(string, string) string) *StringStream {
+	value := initial
+	return FromStringObservable(MapString2StringObserveDirect(s, func(next string, err error, complete bool, observer StringObserver) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			value = f(value, next)
+			observer.Next(value)
+		}
+	}))
+}
+
+type timeoutString struct {
+	parent  StringObservable
+	timeout time.Duration
+}
+
+func (t *timeoutString) Subscribe(observer StringObserver) Subscription {
+	subscription := NewChannelSubscription()
+	cancel := t.parent.Subscribe(observer)
+	go func() {
+		select {
+		case <-time.After(t.timeout):
+			observer.Error(ErrTimeout)
+			cancel.Dispose()
+			subscription.Dispose()
+		case <-subscription:
+			cancel.Dispose()
+		}
+	}()
+	return subscription
+}
+
+func (s *StringStream) Timeout(timeout time.Duration) *StringStream {
+	return &StringStream{&timeoutString{s, timeout}}
+}
+
+type delaySubscriptionString struct {
+	parent StringObservable
+	delay  time.Duration
+}
+
+func (d *delaySubscriptionString) Subscribe(observer StringObserver) Subscription {
+	subscription := NewLinkedSubscription()
+	time.AfterFunc(d.delay, func() {
+		if !subscription.Disposed() {
+			subscription.Link(d.parent.Subscribe(observer))
+		}
+	})
+	return subscription
+}
+
+// DelaySubscription waits for delay before subscribing to the stream.
+func (s *StringStream) DelaySubscription(delay time.Duration) *StringStream {
+	return &StringStream{&delaySubscriptionString{s, delay}}
+}
+
+type takeUntilString struct {
+	parent StringObservable
+	signal GenericObservable
+}
+
+func (t *takeUntilString) Subscribe(observer StringObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			// A signal that completes without a value never ends the stream.
+			break
+		default:
+			terminate(observer.Complete)
+		}
+	})))
+	parent.Link(t.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// TakeUntil returns elements of the stream until signal, which may be a
+// stream of any type, emits a value. The stream then completes.
+func (s *StringStream) TakeUntil(signal GenericObservable) *StringStream {
+	return &StringStream{&takeUntilString{s, signal}}
+}
+
+type skipUntilString struct {
+	parent StringObservable
+	signal GenericObservable
+}
+
+func (t *skipUntilString) Subscribe(observer StringObserver) Subscription {
+	lock := sync.Mutex{}
+	open := false
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			break
+		default:
+			lock.Lock()
+			open = true
+			lock.Unlock()
+			signal.Dispose()
+		}
+	})))
+	parent.Link(t.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if open && !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// SkipUntil skips elements of the stream until signal, which may be a stream
+// of any type, emits a value.
+func (s *StringStream) SkipUntil(signal GenericObservable) *StringStream {
+	return &StringStream{&skipUntilString{s, signal}}
+}
+
+type forkedStringStream struct {
+	lock      sync.Mutex
+	parent    StringObservable
+	observers []StringObserver
+}
+
+func (f *forkedStringStream) Subscribe(observer StringObserver) Subscription {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	i := len(f.observers)
+	f.observers = append(f.observers, observer)
+	sub := new(CallbackSubscription)
+	*sub = CallbackSubscription(func() {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		f.observers[i] = nil
+	})
+	return sub
+}
+
+// Fork replicates each event from the parent to every subscriber of the fork.
+func (s *StringStream) Fork() *StringStream {
+	f := &forkedStringStream{parent: s}
+	go s.Subscribe(StringObserverFunc(func(n string, err error, complete bool) {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		for _, o := range f.observers {
+			if o == nil {
+				continue
+			}
+			switch {
+			case err != nil:
+				o.Error(err)
+			case complete:
+				o.Complete()
+			default:
+				o.Next(n)
+			}
+		}
+	}))
+	return &StringStream{f}
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *StringStream) ToOneWithError() (string, error) {
+	valuech := make(chan string, 1)
+	errch := make(chan error, 1)
+	FromStringObservable(oneFilter().String(s)).SubscribeFunc(func(next string, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroString, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *StringStream) ToOne() string {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *StringStream) ToArrayWithError() ([]string, error) {
+	array := []string{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next string, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *StringStream) ToArray() []string {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *StringStream) ToChannelWithError() (<-chan string, <-chan error) {
+	ch := make(chan string, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next string, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *StringStream) ToChannel() <-chan string {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *StringStream) Seq() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *StringStream) Seq2() iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		type notification struct {
+			next     string
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next string, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroString, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+// Count returns an IntStream with the count of elements in this stream.
+func (s *StringStream) Count() *IntStream {
+	count := 0
+	return FromIntObservable(MapString2IntObserveDirect(s, func(next string, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			observer.Next(count)
+			observer.Error(err)
+		case complete:
+			observer.Next(count)
+			observer.Complete()
+		default:
+			count++
+		}
+	}))
+}
+
+func equalString(a, b string) bool {
+	return a == b
+}
+
+type decideString struct {
+	parent StringObservable
+	// decide returns the result and true once the answer is known.
+	decide func(next string) (bool, bool)
+	// otherwise is the result if the stream completes first.
+	otherwise bool
+}
+
+func (d *decideString) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	subscription := NewLinkedSubscription()
+	subscription.Link(d.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Next(d.otherwise)
+			observer.Complete()
+		default:
+			if result, ok := d.decide(next); ok {
+				done = true
+				observer.Next(result)
+				observer.Complete()
+				subscription.Dispose()
+			}
+		}
+	})))
+	return subscription
+}
+
+// All emits true if f returns true for every value in the stream, or false
+// as soon as it does not.
+func (s *StringStream) All(f func(string) bool) *BoolStream {
+	return FromBoolObservable(&decideString{s, func(next string) (bool, bool) { return false, !f(next) }, true})
+}
+
+// Any emits true as soon as f returns true for a value in the stream, or
+// false if it never does.
+func (s *StringStream) Any(f func(string) bool) *BoolStream {
+	return FromBoolObservable(&decideString{s, func(next string) (bool, bool) { return true, f(next) }, false})
+}
+
+// Contains emits true as soon as value is seen in the stream, or false if it
+// never is.
+func (s *StringStream) Contains(value string) *BoolStream {
+	return s.Any(func(next string) bool { return equalString(next, value) })
+}
+
+// IsEmpty emits true if the stream completes without a value, or false as
+// soon as it emits one.
+func (s *StringStream) IsEmpty() *BoolStream {
+	return FromBoolObservable(&decideString{s, func(string) (bool, bool) { return false, true }, true})
+}
+
+type sequenceEqualString struct {
+	parent StringObservable
+	other  StringObservable
+}
+
+func (e *sequenceEqualString) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	// Values seen on one side that the other has not caught up with yet.
+	// Only one side can be ahead at a time.
+	pending := [2][]string{}
+	completed := [2]bool{}
+	subscriptions := [2]*LinkedSubscription{NewLinkedSubscription(), NewLinkedSubscription()}
+	dispose := func() {
+		subscriptions[0].Dispose()
+		subscriptions[1].Dispose()
+	}
+	finish := func(result bool) {
+		done = true
+		observer.Next(result)
+		observer.Complete()
+		dispose()
+	}
+	observe := func(i int) StringObserver {
+		j := 1 - i
+		return StringObserverFunc(func(next string, err error, complete bool) {
+			lock.Lock()
+			defer lock.Unlock()
+			if done {
+				return
+			}
+			switch {
+			case err != nil:
+				done = true
+				observer.Error(err)
+				dispose()
+			case complete:
+				completed[i] = true
+				if len(pending[j]) > 0 {
+					finish(false)
+				} else if completed[j] {
+					finish(true)
+				}
+			case len(pending[j]) > 0:
+				expected := pending[j][0]
+				pending[j] = pending[j][1:]
+				if !equalString(next, expected) {
+					finish(false)
+				}
+			case completed[j]:
+				finish(false)
+			default:
+				pending[i] = append(pending[i], next)
+			}
+		})
+	}
+	subscriptions[0].Link(e.parent.Subscribe(observe(0)))
+	subscriptions[1].Link(e.other.Subscribe(observe(1)))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(dispose)
+	return subscription
+}
+
+// SequenceEqual emits true if the stream and other emit equal values in the
+// same order and both complete, or false as soon as they differ.
+func (s *StringStream) SequenceEqual(other StringObservable) *BoolStream {
+	return FromBoolObservable(&sequenceEqualString{s, other})
+}
+
+func (s *StringStream) Min() *StringStream {
+	started := false
+	var min string
+	return FromStringObservable(MapString2StringObserveDirect(s, func(next string, err error, complete bool, observer StringObserver) {
+		switch {
+		case err != nil:
+			if started {
+				observer.Next(min)
+			}
+			observer.Error(err)
+		case complete:
+			if started {
+				observer.Next(min)
+			}
+			observer.Complete()
+		default:
+			if started {
+				if next < min {
+					min = next
+				}
+			} else {
+				min = next
+				started = true
+			}
+		}
+	}))
+}
+
+func (s *StringStream) Max() *StringStream {
+	started := false
+	var max string
+	return FromStringObservable(MapString2StringObserveDirect(s, func(next string, err error, complete bool, observer StringObserver) {
+		switch {
+		case err != nil:
+			if started {
+				observer.Next(max)
+			}
+			observer.Error(err)
+		case complete:
+			if started {
+				observer.Next(max)
+			}
+			observer.Complete()
+		default:
+			if started {
+				if !(next < max) {
+					max = next
+				}
+			} else {
+				max = next
+				started = true
+			}
+		}
+	}))
+}
+
+// Sorted emits all values in ascending order once the stream terminates.
+func (s *StringStream) Sorted() *StringStream {
+	return FromStringObservable(sortedFilter(func(a, b interface{}) bool {
+		x, y := a.(string), b.(string)
+		return x < y
+	}).String(s))
+}
+
+// StringNotification is a materialized event from a StringStream.
+type StringNotification struct {
+	Kind  NotificationKind
+	Value string // Set if Kind is NotificationNext.
+	Err   error  // Set if Kind is NotificationError.
+}
+
+// Materialize emits every event of the stream, including termination, as a
+// StringNotification. The materialized stream completes after the
+// notification for the termination of this stream.
+func (s *StringStream) Materialize() *StringNotificationStream {
+	return FromStringNotificationObservable(MapString2StringNotificationObserveDirect(s, func(next string, err error, complete bool, observer StringNotificationObserver) {
+		switch {
+		case err != nil:
+			observer.Next(StringNotification{Kind: NotificationError, Err: err})
+			observer.Complete()
+		case complete:
+			observer.Next(StringNotification{Kind: NotificationComplete})
+			observer.Complete()
+		default:
+			observer.Next(StringNotification{Kind: NotificationNext, Value: next})
+		}
+	}))
+}
+
+// Dematerialize converts materialized notifications back into the events they
+// represent. Notifications after the first termination are ignored.
+func (s *StringNotificationStream) Dematerialize() *StringStream {
+	return FromStringObservable(MapStringNotification2StringObservable(s, func(StringObserver) MappingStringNotification2StringFunc {
+		terminated := false
+		return func(next StringNotification, err error, complete bool, observer StringObserver) {
+			if terminated {
+				return
+			}
+			switch {
+			case err != nil:
+				terminated = true
+				observer.Error(err)
+			case complete:
+				terminated = true
+				observer.Complete()
+			default:
+				switch next.Kind {
+				case NotificationNext:
+					observer.Next(next.Value)
+				case NotificationError:
+					terminated = true
+					observer.Error(next.Err)
+				case NotificationComplete:
+					terminated = true
+					observer.Complete()
+				}
+			}
+		}
+	}))
+}
+
+// TimestampedString is a value from a StringStream and the time it was emitted.
+type TimestampedString struct {
+	Value string
+	Time  time.Time
+}
+
+// Timestamp annotates each value in the stream with the time it was emitted.
+func (s *StringStream) Timestamp() *TimestampedStringStream {
+	return FromTimestampedStringObservable(MapString2TimestampedStringObserveNext(s, func(next string) TimestampedString {
+		return TimestampedString{next, Now()}
+	}))
+}
+
+// IntervalString is a value from a StringStream and the time elapsed
+// since the previous value was emitted.
+type IntervalString struct {
+	Value    string
+	Interval time.Duration
+}
+
+// TimeInterval annotates each value in the stream with the time elapsed since
+// the previous value, or since subscription for the first value.
+func (s *StringStream) TimeInterval() *IntervalStringStream {
+	return FromIntervalStringObservable(MapString2IntervalStringObservable(s, func(IntervalStringObserver) MappingString2IntervalStringFunc {
+		last := Now()
+		return func(next string, err error, complete bool, observer IntervalStringObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				now := Now()
+				observer.Next(IntervalString{next, now.Sub(last)})
+				last = now
+			}
+		}
+	}))
+}
+
+type MappingString2ResponseFunc func(next string, err error, complete bool, observer ResponseObserver)
+type MappingString2ResponseFuncFactory func(observer ResponseObserver) MappingString2ResponseFunc
+
+type MappingString2ResponseObservable struct {
+	parent StringObservable
+	mapper MappingString2ResponseFuncFactory
+}
+
+func (f *MappingString2ResponseObservable) Subscribe(observer ResponseObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapString2ResponseObservable(parent StringObservable, mapper MappingString2ResponseFuncFactory) ResponseObservable {
+	return &MappingString2ResponseObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapString2ResponseObserveDirect(parent StringObservable, mapper MappingString2ResponseFunc) ResponseObservable {
+	return MapString2ResponseObservable(parent, func(ResponseObserver) MappingString2ResponseFunc {
+		return mapper
+	})
+}
+
+func MapString2ResponseObserveNext(parent StringObservable, mapper func(string) *http.Response) ResponseObservable {
+	return MapString2ResponseObservable(parent, func(ResponseObserver) MappingString2ResponseFunc {
+		return func(next string, err error, complete bool, observer ResponseObserver) {
+			var mapped *http.Response
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughResponse(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapString2Response struct {
+	parent StringObservable
+	mapper func(string) ResponseObservable
+}
+
+func (f *flatMapString2Response) Subscribe(observer ResponseObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&ResponseStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &ResponseStream{ignoreCompletionFilter().Response(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapResponse maps this stream to an ResponseStream via f.
+func (s *StringStream) MapResponse(f func(string) *http.Response) *ResponseStream {
+	return FromResponseObservable(MapString2ResponseObserveNext(s, f))
+}
+
+func (s *StringStream) FlatMapResponse(f func(string) ResponseObservable) *ResponseStream {
+	return &ResponseStream{&flatMapString2Response{s, f}}
+}
+
+type MappingString2StringFunc func(next string, err error, complete bool, observer StringObserver)
+type MappingString2StringFuncFactory func(observer StringObserver) MappingString2StringFunc
+
+type MappingString2StringObservable struct {
+	parent StringObservable
+	mapper MappingString2StringFuncFactory
+}
+
+func (f *MappingString2StringObservable) Subscribe(observer StringObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapString2StringObservable(parent StringObservable, mapper MappingString2StringFuncFactory) StringObservable {
+	return &MappingString2StringObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapString2StringObserveDirect(parent StringObservable, mapper MappingString2StringFunc) StringObservable {
+	return MapString2StringObservable(parent, func(StringObserver) MappingString2StringFunc {
+		return mapper
+	})
+}
+
+func MapString2StringObserveNext(parent StringObservable, mapper func(string) string) StringObservable {
+	return MapString2StringObservable(parent, func(StringObserver) MappingString2StringFunc {
+		return func(next string, err error, complete bool, observer StringObserver) {
+			var mapped string
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughString(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapString2String struct {
+	parent StringObservable
+	mapper func(string) StringObservable
+}
+
+func (f *flatMapString2String) Subscribe(observer StringObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&StringStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &StringStream{ignoreCompletionFilter().String(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// Map maps values in this stream to another value.
+func (s *StringStream) Map(f func(string) string) *StringStream {
+	return FromStringObservable(MapString2StringObserveNext(s, f))
+}
+
+func (s *StringStream) FlatMap(f func(string) StringObservable) *StringStream {
+	return &StringStream{&flatMapString2String{s, f}}
+}
+
+type MappingString2IntFunc func(next string, err error, complete bool, observer IntObserver)
+type MappingString2IntFuncFactory func(observer IntObserver) MappingString2IntFunc
+
+type MappingString2IntObservable struct {
+	parent StringObservable
+	mapper MappingString2IntFuncFactory
+}
+
+func (f *MappingString2IntObservable) Subscribe(observer IntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapString2IntObservable(parent StringObservable, mapper MappingString2IntFuncFactory) IntObservable {
+	return &MappingString2IntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapString2IntObserveDirect(parent StringObservable, mapper MappingString2IntFunc) IntObservable {
+	return MapString2IntObservable(parent, func(IntObserver) MappingString2IntFunc {
+		return mapper
+	})
+}
+
+func MapString2IntObserveNext(parent StringObservable, mapper func(string) int) IntObservable {
+	return MapString2IntObservable(parent, func(IntObserver) MappingString2IntFunc {
+		return func(next string, err error, complete bool, observer IntObserver) {
+			var mapped int
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapString2Int struct {
+	parent StringObservable
+	mapper func(string) IntObservable
+}
+
+func (f *flatMapString2Int) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&IntStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &IntStream{ignoreCompletionFilter().Int(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *StringStream) MapInt(f func(string) int) *IntStream {
+	return FromIntObservable(MapString2IntObserveNext(s, f))
+}
+
+func (s *StringStream) FlatMapInt(f func(string) IntObservable) *IntStream {
+	return &IntStream{&flatMapString2Int{s, f}}
+}
+
+type MappingString2BoolFunc func(next string, err error, complete bool, observer BoolObserver)
+type MappingString2BoolFuncFactory func(observer BoolObserver) MappingString2BoolFunc
+
+type MappingString2BoolObservable struct {
+	parent StringObservable
+	mapper MappingString2BoolFuncFactory
+}
+
+func (f *MappingString2BoolObservable) Subscribe(observer BoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapString2BoolObservable(parent StringObservable, mapper MappingString2BoolFuncFactory) BoolObservable {
+	return &MappingString2BoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapString2BoolObserveDirect(parent StringObservable, mapper MappingString2BoolFunc) BoolObservable {
+	return MapString2BoolObservable(parent, func(BoolObserver) MappingString2BoolFunc {
+		return mapper
+	})
+}
+
+func MapString2BoolObserveNext(parent StringObservable, mapper func(string) bool) BoolObservable {
+	return MapString2BoolObservable(parent, func(BoolObserver) MappingString2BoolFunc {
+		return func(next string, err error, complete bool, observer BoolObserver) {
+			var mapped bool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapString2Bool struct {
+	parent StringObservable
+	mapper func(string) BoolObservable
+}
+
+func (f *flatMapString2Bool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&BoolStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &BoolStream{ignoreCompletionFilter().Bool(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *StringStream) MapBool(f func(string) bool) *BoolStream {
+	return FromBoolObservable(MapString2BoolObserveNext(s, f))
+}
+
+func (s *StringStream) FlatMapBool(f func(string) BoolObservable) *BoolStream {
+	return &BoolStream{&flatMapString2Bool{s, f}}
+}
+
+type MappingString2StringNotificationFunc func(next string, err error, complete bool, observer StringNotificationObserver)
+type MappingString2StringNotificationFuncFactory func(observer StringNotificationObserver) MappingString2StringNotificationFunc
+
+type MappingString2StringNotificationObservable struct {
+	parent StringObservable
+	mapper MappingString2StringNotificationFuncFactory
+}
+
+func (f *MappingString2StringNotificationObservable) Subscribe(observer StringNotificationObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapString2StringNotificationObservable(parent StringObservable, mapper MappingString2StringNotificationFuncFactory) StringNotificationObservable {
+	return &MappingString2StringNotificationObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapString2StringNotificationObserveDirect(parent StringObservable, mapper MappingString2StringNotificationFunc) StringNotificationObservable {
+	return MapString2StringNotificationObservable(parent, func(StringNotificationObserver) MappingString2StringNotificationFunc {
+		return mapper
+	})
+}
+
+func MapString2StringNotificationObserveNext(parent StringObservable, mapper func(string) StringNotification) StringNotificationObservable {
+	return MapString2StringNotificationObservable(parent, func(StringNotificationObserver) MappingString2StringNotificationFunc {
+		return func(next string, err error, complete bool, observer StringNotificationObserver) {
+			var mapped StringNotification
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughStringNotification(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapStringNotification maps this stream to an StringNotificationStream via f.
+func (s *StringStream) MapStringNotification(f func(string) StringNotification) *StringNotificationStream {
+	return FromStringNotificationObservable(MapString2StringNotificationObserveNext(s, f))
+}
+
+type MappingString2TimestampedStringFunc func(next string, err error, complete bool, observer TimestampedStringObserver)
+type MappingString2TimestampedStringFuncFactory func(observer TimestampedStringObserver) MappingString2TimestampedStringFunc
+
+type MappingString2TimestampedStringObservable struct {
+	parent StringObservable
+	mapper MappingString2TimestampedStringFuncFactory
+}
+
+func (f *MappingString2TimestampedStringObservable) Subscribe(observer TimestampedStringObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapString2TimestampedStringObservable(parent StringObservable, mapper MappingString2TimestampedStringFuncFactory) TimestampedStringObservable {
+	return &MappingString2TimestampedStringObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapString2TimestampedStringObserveDirect(parent StringObservable, mapper MappingString2TimestampedStringFunc) TimestampedStringObservable {
+	return MapString2TimestampedStringObservable(parent, func(TimestampedStringObserver) MappingString2TimestampedStringFunc {
+		return mapper
+	})
+}
+
+func MapString2TimestampedStringObserveNext(parent StringObservable, mapper func(string) TimestampedString) TimestampedStringObservable {
+	return MapString2TimestampedStringObservable(parent, func(TimestampedStringObserver) MappingString2TimestampedStringFunc {
+		return func(next string, err error, complete bool, observer TimestampedStringObserver) {
+			var mapped TimestampedString
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughTimestampedString(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapTimestampedString maps this stream to an TimestampedStringStream via f.
+func (s *StringStream) MapTimestampedString(f func(string) TimestampedString) *TimestampedStringStream {
+	return FromTimestampedStringObservable(MapString2TimestampedStringObserveNext(s, f))
+}
+
+type MappingString2IntervalStringFunc func(next string, err error, complete bool, observer IntervalStringObserver)
+type MappingString2IntervalStringFuncFactory func(observer IntervalStringObserver) MappingString2IntervalStringFunc
+
+type MappingString2IntervalStringObservable struct {
+	parent StringObservable
+	mapper MappingString2IntervalStringFuncFactory
+}
+
+func (f *MappingString2IntervalStringObservable) Subscribe(observer IntervalStringObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(StringObserverFunc(func(next string, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapString2IntervalStringObservable(parent StringObservable, mapper MappingString2IntervalStringFuncFactory) IntervalStringObservable {
+	return &MappingString2IntervalStringObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapString2IntervalStringObserveDirect(parent StringObservable, mapper MappingString2IntervalStringFunc) IntervalStringObservable {
+	return MapString2IntervalStringObservable(parent, func(IntervalStringObserver) MappingString2IntervalStringFunc {
+		return mapper
+	})
+}
+
+func MapString2IntervalStringObserveNext(parent StringObservable, mapper func(string) IntervalString) IntervalStringObservable {
+	return MapString2IntervalStringObservable(parent, func(IntervalStringObserver) MappingString2IntervalStringFunc {
+		return func(next string, err error, complete bool, observer IntervalStringObserver) {
+			var mapped IntervalString
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntervalString(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapIntervalString maps this stream to an IntervalStringStream via f.
+func (s *StringStream) MapIntervalString(f func(string) IntervalString) *IntervalStringStream {
+	return FromIntervalStringObservable(MapString2IntervalStringObserveNext(s, f))
+}
+
+type StringNotificationObserver interface {
+	Next(StringNotification)
+	TerminationObserver
+}
+
+// A StringNotificationSubscriber represents a subscribed StringNotificationObserver.
+type StringNotificationSubscriber interface {
+	Subscription
+	StringNotificationObserver
+}
+
+type implStringNotificationSubscriber struct {
+	Subscription
+	StringNotificationObserver
+}
+
+func StringNotificationObserverAsGenericObserver(observer StringNotificationObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(StringNotification))
+		}
+	})
+}
+
+func GenericObserverAsStringNotificationObserver(observer GenericObserver) StringNotificationObserver {
+	return StringNotificationObserverFunc(func(next StringNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type StringNotificationObservableFactory func(observer StringNotificationObserver, subscription Subscription)
+
+func (f StringNotificationObservableFactory) Subscribe(observer StringNotificationObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateStringNotification calls f(observer, subscription) to produce values for a stream.
+func CreateStringNotification(f func(observer StringNotificationObserver, subscription Subscription)) *StringNotificationStream {
+	return FromStringNotificationObservable(StringNotificationObservableFactory(f))
+}
+func PassthroughStringNotification(next StringNotification, err error, complete bool, observer StringNotificationObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroStringNotification = *new(StringNotification)
+
+type StringNotificationObserverFunc func(StringNotification, error, bool)
+
+func (f StringNotificationObserverFunc) Next(next StringNotification) { f(next, nil, false) }
+func (f StringNotificationObserverFunc) Error(err error)              { f(zeroStringNotification, err, false) }
+func (f StringNotificationObserverFunc) Complete()                    { f(zeroStringNotification, nil, true) }
+
+type StringNotificationObservable interface {
+	Subscribe(StringNotificationObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a StringNotificationObservable
+func (f GenericObservableFilterFactory) StringNotification(parent StringNotificationObservable) StringNotificationObservable {
+	return MapStringNotification2StringNotificationObservable(parent, func(observer StringNotificationObserver) MappingStringNotification2StringNotificationFunc {
+		gobserver := StringNotificationObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next StringNotification, err error, complete bool, observer StringNotificationObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromStringNotificationArray(array []StringNotification) *StringNotificationStream {
+	return CreateStringNotification(func(observer StringNotificationObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromStringNotifications(array ...StringNotification) *StringNotificationStream {
+	return FromStringNotificationArray(array)
+}
+
+func FromStringNotificationChannel(ch <-chan StringNotification) *StringNotificationStream {
+	return CreateStringNotification(func(observer StringNotificationObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type StringNotificationStream struct {
+	StringNotificationObservable
+}
+
+func FromStringNotificationObservable(observable StringNotificationObservable) *StringNotificationStream {
+	return &StringNotificationStream{observable}
+}
+
+func (s *StringNotificationStream) SubscribeFunc(f func(StringNotification, error, bool)) Subscription {
+	return s.Subscribe(StringNotificationObserverFunc(f))
+}
+
+func (s *StringNotificationStream) SubscribeNext(f func(v StringNotification)) Subscription {
+	return s.SubscribeFunc(func(next StringNotification, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *StringNotificationStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsStringNotificationObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *StringNotificationStream) Filter(f func(StringNotification) bool) *StringNotificationStream {
+	return FromStringNotificationObservable(filterFilter(func(v interface{}) bool { return f(v.(StringNotification)) }).StringNotification(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *StringNotificationStream) Do(f func(next StringNotification)) *StringNotificationStream {
+	return FromStringNotificationObservable(MapStringNotification2StringNotificationObserveNext(s, func(next StringNotification) StringNotification {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *StringNotificationStream) DoOnError(f func(err error)) *StringNotificationStream {
+	return FromStringNotificationObservable(MapStringNotification2StringNotificationObserveDirect(s, func(next StringNotification, err error, complete bool, observer StringNotificationObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughStringNotification(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *StringNotificationStream) DoOnComplete(f func()) *StringNotificationStream {
+	return FromStringNotificationObservable(MapStringNotification2StringNotificationObserveDirect(s, func(next StringNotification, err error, complete bool, observer StringNotificationObserver) {
+		if complete {
+			f()
+		}
+		PassthroughStringNotification(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *StringNotificationStream) ToOneWithError() (StringNotification, error) {
+	valuech := make(chan StringNotification, 1)
+	errch := make(chan error, 1)
+	FromStringNotificationObservable(oneFilter().StringNotification(s)).SubscribeFunc(func(next StringNotification, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroStringNotification, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *StringNotificationStream) ToOne() StringNotification {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *StringNotificationStream) ToArrayWithError() ([]StringNotification, error) {
+	array := []StringNotification{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next StringNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *StringNotificationStream) ToArray() []StringNotification {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *StringNotificationStream) ToChannelWithError() (<-chan StringNotification, <-chan error) {
+	ch := make(chan StringNotification, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next StringNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *StringNotificationStream) ToChannel() <-chan StringNotification {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *StringNotificationStream) Seq() iter.Seq[StringNotification] {
+	return func(yield func(StringNotification) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *StringNotificationStream) Seq2() iter.Seq2[StringNotification, error] {
+	return func(yield func(StringNotification, error) bool) {
+		type notification struct {
+			next     StringNotification
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next StringNotification, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroStringNotification, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapStringNotification2Response struct {
+	parent StringNotificationObservable
+	f      func(StringNotification) *http.Response
+}
+
+func (m *mapStringNotification2Response) Subscribe(observer ResponseObserver) Subscription {
+	return m.parent.Subscribe(StringNotificationObserverFunc(func(next StringNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapResponse maps this stream to an ResponseStream via f.
+func (s *StringNotificationStream) MapResponse(f func(StringNotification) *http.Response) *ResponseStream {
+	return &ResponseStream{&mapStringNotification2Response{s, f}}
+}
+
+type mapStringNotification2Int struct {
+	parent StringNotificationObservable
+	f      func(StringNotification) int
+}
+
+func (m *mapStringNotification2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(StringNotificationObserverFunc(func(next StringNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *StringNotificationStream) MapInt(f func(StringNotification) int) *IntStream {
+	return &IntStream{&mapStringNotification2Int{s, f}}
+}
+
+type mapStringNotification2Bool struct {
+	parent StringNotificationObservable
+	f      func(StringNotification) bool
+}
+
+func (m *mapStringNotification2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(StringNotificationObserverFunc(func(next StringNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *StringNotificationStream) MapBool(f func(StringNotification) bool) *BoolStream {
+	return &BoolStream{&mapStringNotification2Bool{s, f}}
+}
+
+type MappingStringNotification2StringNotificationFunc func(next StringNotification, err error, complete bool, observer StringNotificationObserver)
+type MappingStringNotification2StringNotificationFuncFactory func(observer StringNotificationObserver) MappingStringNotification2StringNotificationFunc
+
+type MappingStringNotification2StringNotificationObservable struct {
+	parent StringNotificationObservable
+	mapper MappingStringNotification2StringNotificationFuncFactory
+}
+
+func (f *MappingStringNotification2StringNotificationObservable) Subscribe(observer StringNotificationObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(StringNotificationObserverFunc(func(next StringNotification, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapStringNotification2StringNotificationObservable(parent StringNotificationObservable, mapper MappingStringNotification2StringNotificationFuncFactory) StringNotificationObservable {
+	return &MappingStringNotification2StringNotificationObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapStringNotification2StringNotificationObserveDirect(parent StringNotificationObservable, mapper MappingStringNotification2StringNotificationFunc) StringNotificationObservable {
+	return MapStringNotification2StringNotificationObservable(parent, func(StringNotificationObserver) MappingStringNotification2StringNotificationFunc {
+		return mapper
+	})
+}
+
+func MapStringNotification2StringNotificationObserveNext(parent StringNotificationObservable, mapper func(StringNotification) StringNotification) StringNotificationObservable {
+	return MapStringNotification2StringNotificationObservable(parent, func(StringNotificationObserver) MappingStringNotification2StringNotificationFunc {
+		return func(next StringNotification, err error, complete bool, observer StringNotificationObserver) {
+			var mapped StringNotification
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughStringNotification(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *StringNotificationStream) Map(f func(StringNotification) StringNotification) *StringNotificationStream {
+	return FromStringNotificationObservable(MapStringNotification2StringNotificationObserveNext(s, f))
+}
+
+type MappingStringNotification2StringFunc func(next StringNotification, err error, complete bool, observer StringObserver)
+type MappingStringNotification2StringFuncFactory func(observer StringObserver) MappingStringNotification2StringFunc
+
+type MappingStringNotification2StringObservable struct {
+	parent StringNotificationObservable
+	mapper MappingStringNotification2StringFuncFactory
+}
+
+func (f *MappingStringNotification2StringObservable) Subscribe(observer StringObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(StringNotificationObserverFunc(func(next StringNotification, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapStringNotification2StringObservable(parent StringNotificationObservable, mapper MappingStringNotification2StringFuncFactory) StringObservable {
+	return &MappingStringNotification2StringObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapStringNotification2StringObserveDirect(parent StringNotificationObservable, mapper MappingStringNotification2StringFunc) StringObservable {
+	return MapStringNotification2StringObservable(parent, func(StringObserver) MappingStringNotification2StringFunc {
+		return mapper
+	})
+}
+
+func MapStringNotification2StringObserveNext(parent StringNotificationObservable, mapper func(StringNotification) string) StringObservable {
+	return MapStringNotification2StringObservable(parent, func(StringObserver) MappingStringNotification2StringFunc {
+		return func(next StringNotification, err error, complete bool, observer StringObserver) {
+			var mapped string
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughString(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapStringNotification2String struct {
+	parent StringNotificationObservable
+	mapper func(StringNotification) StringObservable
+}
+
+func (f *flatMapStringNotification2String) Subscribe(observer StringObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(StringNotificationObserverFunc(func(next StringNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&StringStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &StringStream{ignoreCompletionFilter().String(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapString maps this stream to an StringStream via f.
+func (s *StringNotificationStream) MapString(f func(StringNotification) string) *StringStream {
+	return FromStringObservable(MapStringNotification2StringObserveNext(s, f))
+}
+
+func (s *StringNotificationStream) FlatMapString(f func(StringNotification) StringObservable) *StringStream {
+	return &StringStream{&flatMapStringNotification2String{s, f}}
+}
+
+type TimestampedStringObserver interface {
+	Next(TimestampedString)
+	TerminationObserver
+}
+
+// A TimestampedStringSubscriber represents a subscribed TimestampedStringObserver.
+type TimestampedStringSubscriber interface {
+	Subscription
+	TimestampedStringObserver
+}
+
+type implTimestampedStringSubscriber struct {
+	Subscription
+	TimestampedStringObserver
+}
+
+func TimestampedStringObserverAsGenericObserver(observer TimestampedStringObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(TimestampedString))
+		}
+	})
+}
+
+func GenericObserverAsTimestampedStringObserver(observer GenericObserver) TimestampedStringObserver {
+	return TimestampedStringObserverFunc(func(next TimestampedString, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type TimestampedStringObservableFactory func(observer TimestampedStringObserver, subscription Subscription)
+
+func (f TimestampedStringObservableFactory) Subscribe(observer TimestampedStringObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateTimestampedString calls f(observer, subscription) to produce values for a stream.
+func CreateTimestampedString(f func(observer TimestampedStringObserver, subscription Subscription)) *TimestampedStringStream {
+	return FromTimestampedStringObservable(TimestampedStringObservableFactory(f))
+}
+func PassthroughTimestampedString(next TimestampedString, err error, complete bool, observer TimestampedStringObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroTimestampedString = *new(TimestampedString)
+
+type TimestampedStringObserverFunc func(TimestampedString, error, bool)
+
+func (f TimestampedStringObserverFunc) Next(next TimestampedString) { f(next, nil, false) }
+func (f TimestampedStringObserverFunc) Error(err error)             { f(zeroTimestampedString, err, false) }
+func (f TimestampedStringObserverFunc) Complete()                   { f(zeroTimestampedString, nil, true) }
+
+type TimestampedStringObservable interface {
+	Subscribe(TimestampedStringObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a TimestampedStringObservable
+func (f GenericObservableFilterFactory) TimestampedString(parent TimestampedStringObservable) TimestampedStringObservable {
+	return MapTimestampedString2TimestampedStringObservable(parent, func(observer TimestampedStringObserver) MappingTimestampedString2TimestampedStringFunc {
+		gobserver := TimestampedStringObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next TimestampedString, err error, complete bool, observer TimestampedStringObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromTimestampedStringArray(array []TimestampedString) *TimestampedStringStream {
+	return CreateTimestampedString(func(observer TimestampedStringObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromTimestampedStrings(array ...TimestampedString) *TimestampedStringStream {
+	return FromTimestampedStringArray(array)
+}
+
+func FromTimestampedStringChannel(ch <-chan TimestampedString) *TimestampedStringStream {
+	return CreateTimestampedString(func(observer TimestampedStringObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type TimestampedStringStream struct {
+	TimestampedStringObservable
+}
+
+func FromTimestampedStringObservable(observable TimestampedStringObservable) *TimestampedStringStream {
+	return &TimestampedStringStream{observable}
+}
+
+func (s *TimestampedStringStream) SubscribeFunc(f func(TimestampedString, error, bool)) Subscription {
+	return s.Subscribe(TimestampedStringObserverFunc(f))
+}
+
+func (s *TimestampedStringStream) SubscribeNext(f func(v TimestampedString)) Subscription {
+	return s.SubscribeFunc(func(next TimestampedString, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *TimestampedStringStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsTimestampedStringObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *TimestampedStringStream) Filter(f func(TimestampedString) bool) *TimestampedStringStream {
+	return FromTimestampedStringObservable(filterFilter(func(v interface{}) bool { return f(v.(TimestampedString)) }).TimestampedString(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *TimestampedStringStream) Do(f func(next TimestampedString)) *TimestampedStringStream {
+	return FromTimestampedStringObservable(MapTimestampedString2TimestampedStringObserveNext(s, func(next TimestampedString) TimestampedString {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *TimestampedStringStream) DoOnError(f func(err error)) *TimestampedStringStream {
+	return FromTimestampedStringObservable(MapTimestampedString2TimestampedStringObserveDirect(s, func(next TimestampedString, err error, complete bool, observer TimestampedStringObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughTimestampedString(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *TimestampedStringStream) DoOnComplete(f func()) *TimestampedStringStream {
+	return FromTimestampedStringObservable(MapTimestampedString2TimestampedStringObserveDirect(s, func(next TimestampedString, err error, complete bool, observer TimestampedStringObserver) {
+		if complete {
+			f()
+		}
+		PassthroughTimestampedString(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *TimestampedStringStream) ToOneWithError() (TimestampedString, error) {
+	valuech := make(chan TimestampedString, 1)
+	errch := make(chan error, 1)
+	FromTimestampedStringObservable(oneFilter().TimestampedString(s)).SubscribeFunc(func(next TimestampedString, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroTimestampedString, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *TimestampedStringStream) ToOne() TimestampedString {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *TimestampedStringStream) ToArrayWithError() ([]TimestampedString, error) {
+	array := []TimestampedString{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next TimestampedString, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *TimestampedStringStream) ToArray() []TimestampedString {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *TimestampedStringStream) ToChannelWithError() (<-chan TimestampedString, <-chan error) {
+	ch := make(chan TimestampedString, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next TimestampedString, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *TimestampedStringStream) ToChannel() <-chan TimestampedString {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *TimestampedStringStream) Seq() iter.Seq[TimestampedString] {
+	return func(yield func(TimestampedString) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *TimestampedStringStream) Seq2() iter.Seq2[TimestampedString, error] {
+	return func(yield func(TimestampedString, error) bool) {
+		type notification struct {
+			next     TimestampedString
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next TimestampedString, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroTimestampedString, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapTimestampedString2Response struct {
+	parent TimestampedStringObservable
+	f      func(TimestampedString) *http.Response
+}
+
+func (m *mapTimestampedString2Response) Subscribe(observer ResponseObserver) Subscription {
+	return m.parent.Subscribe(TimestampedStringObserverFunc(func(next TimestampedString, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapResponse maps this stream to an ResponseStream via f.
+func (s *TimestampedStringStream) MapResponse(f func(TimestampedString) *http.Response) *ResponseStream {
+	return &ResponseStream{&mapTimestampedString2Response{s, f}}
+}
+
+type mapTimestampedString2Int struct {
+	parent TimestampedStringObservable
+	f      func(TimestampedString) int
+}
+
+func (m *mapTimestampedString2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(TimestampedStringObserverFunc(func(next TimestampedString, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *TimestampedStringStream) MapInt(f func(TimestampedString) int) *IntStream {
+	return &IntStream{&mapTimestampedString2Int{s, f}}
+}
+
+type mapTimestampedString2Bool struct {
+	parent TimestampedStringObservable
+	f      func(TimestampedString) bool
+}
+
+func (m *mapTimestampedString2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(TimestampedStringObserverFunc(func(next TimestampedString, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *TimestampedStringStream) MapBool(f func(TimestampedString) bool) *BoolStream {
+	return &BoolStream{&mapTimestampedString2Bool{s, f}}
+}
+
+type MappingTimestampedString2TimestampedStringFunc func(next TimestampedString, err error, complete bool, observer TimestampedStringObserver)
+type MappingTimestampedString2TimestampedStringFuncFactory func(observer TimestampedStringObserver) MappingTimestampedString2TimestampedStringFunc
+
+type MappingTimestampedString2TimestampedStringObservable struct {
+	parent TimestampedStringObservable
+	mapper MappingTimestampedString2TimestampedStringFuncFactory
+}
+
+func (f *MappingTimestampedString2TimestampedStringObservable) Subscribe(observer TimestampedStringObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(TimestampedStringObserverFunc(func(next TimestampedString, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapTimestampedString2TimestampedStringObservable(parent TimestampedStringObservable, mapper MappingTimestampedString2TimestampedStringFuncFactory) TimestampedStringObservable {
+	return &MappingTimestampedString2TimestampedStringObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapTimestampedString2TimestampedStringObserveDirect(parent TimestampedStringObservable, mapper MappingTimestampedString2TimestampedStringFunc) TimestampedStringObservable {
+	return MapTimestampedString2TimestampedStringObservable(parent, func(TimestampedStringObserver) MappingTimestampedString2TimestampedStringFunc {
+		return mapper
+	})
+}
+
+func MapTimestampedString2TimestampedStringObserveNext(parent TimestampedStringObservable, mapper func(TimestampedString) TimestampedString) TimestampedStringObservable {
+	return MapTimestampedString2TimestampedStringObservable(parent, func(TimestampedStringObserver) MappingTimestampedString2TimestampedStringFunc {
+		return func(next TimestampedString, err error, complete bool, observer TimestampedStringObserver) {
+			var mapped TimestampedString
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughTimestampedString(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *TimestampedStringStream) Map(f func(TimestampedString) TimestampedString) *TimestampedStringStream {
+	return FromTimestampedStringObservable(MapTimestampedString2TimestampedStringObserveNext(s, f))
+}
+
+type MappingTimestampedString2StringFunc func(next TimestampedString, err error, complete bool, observer StringObserver)
+type MappingTimestampedString2StringFuncFactory func(observer StringObserver) MappingTimestampedString2StringFunc
+
+type MappingTimestampedString2StringObservable struct {
+	parent TimestampedStringObservable
+	mapper MappingTimestampedString2StringFuncFactory
+}
+
+func (f *MappingTimestampedString2StringObservable) Subscribe(observer StringObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(TimestampedStringObserverFunc(func(next TimestampedString, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapTimestampedString2StringObservable(parent TimestampedStringObservable, mapper MappingTimestampedString2StringFuncFactory) StringObservable {
+	return &MappingTimestampedString2StringObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapTimestampedString2StringObserveDirect(parent TimestampedStringObservable, mapper MappingTimestampedString2StringFunc) StringObservable {
+	return MapTimestampedString2StringObservable(parent, func(StringObserver) MappingTimestampedString2StringFunc {
+		return mapper
+	})
+}
+
+func MapTimestampedString2StringObserveNext(parent TimestampedStringObservable, mapper func(TimestampedString) string) StringObservable {
+	return MapTimestampedString2StringObservable(parent, func(StringObserver) MappingTimestampedString2StringFunc {
+		return func(next TimestampedString, err error, complete bool, observer StringObserver) {
+			var mapped string
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughString(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapTimestampedString2String struct {
+	parent TimestampedStringObservable
+	mapper func(TimestampedString) StringObservable
+}
+
+func (f *flatMapTimestampedString2String) Subscribe(observer StringObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(TimestampedStringObserverFunc(func(next TimestampedString, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&StringStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &StringStream{ignoreCompletionFilter().String(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapString maps this stream to an StringStream via f.
+func (s *TimestampedStringStream) MapString(f func(TimestampedString) string) *StringStream {
+	return FromStringObservable(MapTimestampedString2StringObserveNext(s, f))
+}
+
+func (s *TimestampedStringStream) FlatMapString(f func(TimestampedString) StringObservable) *StringStream {
+	return &StringStream{&flatMapTimestampedString2String{s, f}}
+}
+
+type IntervalStringObserver interface {
+	Next(IntervalString)
+	TerminationObserver
+}
+
+// A IntervalStringSubscriber represents a subscribed IntervalStringObserver.
+type IntervalStringSubscriber interface {
+	Subscription
+	IntervalStringObserver
+}
+
+type implIntervalStringSubscriber struct {
+	Subscription
+	IntervalStringObserver
+}
+
+func IntervalStringObserverAsGenericObserver(observer IntervalStringObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(IntervalString))
+		}
+	})
+}
+
+func GenericObserverAsIntervalStringObserver(observer GenericObserver) IntervalStringObserver {
+	return IntervalStringObserverFunc(func(next IntervalString, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type IntervalStringObservableFactory func(observer IntervalStringObserver, subscription Subscription)
+
+func (f IntervalStringObservableFactory) Subscribe(observer IntervalStringObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateIntervalString calls f(observer, subscription) to produce values for a stream.
+func CreateIntervalString(f func(observer IntervalStringObserver, subscription Subscription)) *IntervalStringStream {
+	return FromIntervalStringObservable(IntervalStringObservableFactory(f))
+}
+func PassthroughIntervalString(next IntervalString, err error, complete bool, observer IntervalStringObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroIntervalString = *new(IntervalString)
+
+type IntervalStringObserverFunc func(IntervalString, error, bool)
+
+func (f IntervalStringObserverFunc) Next(next IntervalString) { f(next, nil, false) }
+func (f IntervalStringObserverFunc) Error(err error)          { f(zeroIntervalString, err, false) }
+func (f IntervalStringObserverFunc) Complete()                { f(zeroIntervalString, nil, true) }
+
+type IntervalStringObservable interface {
+	Subscribe(IntervalStringObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a IntervalStringObservable
+func (f GenericObservableFilterFactory) IntervalString(parent IntervalStringObservable) IntervalStringObservable {
+	return MapIntervalString2IntervalStringObservable(parent, func(observer IntervalStringObserver) MappingIntervalString2IntervalStringFunc {
+		gobserver := IntervalStringObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next IntervalString, err error, complete bool, observer IntervalStringObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromIntervalStringArray(array []IntervalString) *IntervalStringStream {
+	return CreateIntervalString(func(observer IntervalStringObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromIntervalStrings(array ...IntervalString) *IntervalStringStream {
+	return FromIntervalStringArray(array)
+}
+
+func FromIntervalStringChannel(ch <-chan IntervalString) *IntervalStringStream {
+	return CreateIntervalString(func(observer IntervalStringObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type IntervalStringStream struct {
+	IntervalStringObservable
+}
+
+func FromIntervalStringObservable(observable IntervalStringObservable) *IntervalStringStream {
+	return &IntervalStringStream{observable}
+}
+
+func (s *IntervalStringStream) SubscribeFunc(f func(IntervalString, error, bool)) Subscription {
+	return s.Subscribe(IntervalStringObserverFunc(f))
+}
+
+func (s *IntervalStringStream) SubscribeNext(f func(v IntervalString)) Subscription {
+	return s.SubscribeFunc(func(next IntervalString, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *IntervalStringStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsIntervalStringObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *IntervalStringStream) Filter(f func(IntervalString) bool) *IntervalStringStream {
+	return FromIntervalStringObservable(filterFilter(func(v interface{}) bool { return f(v.(IntervalString)) }).IntervalString(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *IntervalStringStream) Do(f func(next IntervalString)) *IntervalStringStream {
+	return FromIntervalStringObservable(MapIntervalString2IntervalStringObserveNext(s, func(next IntervalString) IntervalString {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *IntervalStringStream) DoOnError(f func(err error)) *IntervalStringStream {
+	return FromIntervalStringObservable(MapIntervalString2IntervalStringObserveDirect(s, func(next IntervalString, err error, complete bool, observer IntervalStringObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughIntervalString(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *IntervalStringStream) DoOnComplete(f func()) *IntervalStringStream {
+	return FromIntervalStringObservable(MapIntervalString2IntervalStringObserveDirect(s, func(next IntervalString, err error, complete bool, observer IntervalStringObserver) {
+		if complete {
+			f()
+		}
+		PassthroughIntervalString(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *IntervalStringStream) ToOneWithError() (IntervalString, error) {
+	valuech := make(chan IntervalString, 1)
+	errch := make(chan error, 1)
+	FromIntervalStringObservable(oneFilter().IntervalString(s)).SubscribeFunc(func(next IntervalString, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroIntervalString, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *IntervalStringStream) ToOne() IntervalString {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *IntervalStringStream) ToArrayWithError() ([]IntervalString, error) {
+	array := []IntervalString{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntervalString, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *IntervalStringStream) ToArray() []IntervalString {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *IntervalStringStream) ToChannelWithError() (<-chan IntervalString, <-chan error) {
+	ch := make(chan IntervalString, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntervalString, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *IntervalStringStream) ToChannel() <-chan IntervalString {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *IntervalStringStream) Seq() iter.Seq[IntervalString] {
+	return func(yield func(IntervalString) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *IntervalStringStream) Seq2() iter.Seq2[IntervalString, error] {
+	return func(yield func(IntervalString, error) bool) {
+		type notification struct {
+			next     IntervalString
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next IntervalString, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroIntervalString, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapIntervalString2Response struct {
+	parent IntervalStringObservable
+	f      func(IntervalString) *http.Response
+}
+
+func (m *mapIntervalString2Response) Subscribe(observer ResponseObserver) Subscription {
+	return m.parent.Subscribe(IntervalStringObserverFunc(func(next IntervalString, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapResponse maps this stream to an ResponseStream via f.
+func (s *IntervalStringStream) MapResponse(f func(IntervalString) *http.Response) *ResponseStream {
+	return &ResponseStream{&mapIntervalString2Response{s, f}}
+}
+
+type mapIntervalString2Int struct {
+	parent IntervalStringObservable
+	f      func(IntervalString) int
+}
+
+func (m *mapIntervalString2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(IntervalStringObserverFunc(func(next IntervalString, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *IntervalStringStream) MapInt(f func(IntervalString) int) *IntStream {
+	return &IntStream{&mapIntervalString2Int{s, f}}
+}
+
+type mapIntervalString2Bool struct {
+	parent IntervalStringObservable
+	f      func(IntervalString) bool
+}
+
+func (m *mapIntervalString2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(IntervalStringObserverFunc(func(next IntervalString, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *IntervalStringStream) MapBool(f func(IntervalString) bool) *BoolStream {
+	return &BoolStream{&mapIntervalString2Bool{s, f}}
+}
+
+type MappingIntervalString2IntervalStringFunc func(next IntervalString, err error, complete bool, observer IntervalStringObserver)
+type MappingIntervalString2IntervalStringFuncFactory func(observer IntervalStringObserver) MappingIntervalString2IntervalStringFunc
+
+type MappingIntervalString2IntervalStringObservable struct {
+	parent IntervalStringObservable
+	mapper MappingIntervalString2IntervalStringFuncFactory
+}
+
+func (f *MappingIntervalString2IntervalStringObservable) Subscribe(observer IntervalStringObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntervalStringObserverFunc(func(next IntervalString, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapIntervalString2IntervalStringObservable(parent IntervalStringObservable, mapper MappingIntervalString2IntervalStringFuncFactory) IntervalStringObservable {
+	return &MappingIntervalString2IntervalStringObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntervalString2IntervalStringObserveDirect(parent IntervalStringObservable, mapper MappingIntervalString2IntervalStringFunc) IntervalStringObservable {
+	return MapIntervalString2IntervalStringObservable(parent, func(IntervalStringObserver) MappingIntervalString2IntervalStringFunc {
+		return mapper
+	})
+}
+
+func MapIntervalString2IntervalStringObserveNext(parent IntervalStringObservable, mapper func(IntervalString) IntervalString) IntervalStringObservable {
+	return MapIntervalString2IntervalStringObservable(parent, func(IntervalStringObserver) MappingIntervalString2IntervalStringFunc {
+		return func(next IntervalString, err error, complete bool, observer IntervalStringObserver) {
+			var mapped IntervalString
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntervalString(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *IntervalStringStream) Map(f func(IntervalString) IntervalString) *IntervalStringStream {
+	return FromIntervalStringObservable(MapIntervalString2IntervalStringObserveNext(s, f))
+}
+
+type MappingIntervalString2StringFunc func(next IntervalString, err error, complete bool, observer StringObserver)
+type MappingIntervalString2StringFuncFactory func(observer StringObserver) MappingIntervalString2StringFunc
+
+type MappingIntervalString2StringObservable struct {
+	parent IntervalStringObservable
+	mapper MappingIntervalString2StringFuncFactory
+}
+
+func (f *MappingIntervalString2StringObservable) Subscribe(observer StringObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntervalStringObserverFunc(func(next IntervalString, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapIntervalString2StringObservable(parent IntervalStringObservable, mapper MappingIntervalString2StringFuncFactory) StringObservable {
+	return &MappingIntervalString2StringObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntervalString2StringObserveDirect(parent IntervalStringObservable, mapper MappingIntervalString2StringFunc) StringObservable {
+	return MapIntervalString2StringObservable(parent, func(StringObserver) MappingIntervalString2StringFunc {
+		return mapper
+	})
+}
+
+func MapIntervalString2StringObserveNext(parent IntervalStringObservable, mapper func(IntervalString) string) StringObservable {
+	return MapIntervalString2StringObservable(parent, func(StringObserver) MappingIntervalString2StringFunc {
+		return func(next IntervalString, err error, complete bool, observer StringObserver) {
+			var mapped string
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughString(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapIntervalString2String struct {
+	parent IntervalStringObservable
+	mapper func(IntervalString) StringObservable
+}
+
+func (f *flatMapIntervalString2String) Subscribe(observer StringObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntervalStringObserverFunc(func(next IntervalString, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&StringStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &StringStream{ignoreCompletionFilter().String(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapString maps this stream to an StringStream via f.
+func (s *IntervalStringStream) MapString(f func(IntervalString) string) *StringStream {
+	return FromStringObservable(MapIntervalString2StringObserveNext(s, f))
+}
+
+func (s *IntervalStringStream) FlatMapString(f func(IntervalString) StringObservable) *StringStream {
+	return &StringStream{&flatMapIntervalString2String{s, f}}
+}
+
+type IntObserver interface {
+	Next(int)
+	TerminationObserver
+}
+
+// A IntSubscriber represents a subscribed IntObserver.
+type IntSubscriber interface {
+	Subscription
+	IntObserver
+}
+
+type implIntSubscriber struct {
+	Subscription
+	IntObserver
+}
+
+func IntObserverAsGenericObserver(observer IntObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(int))
+		}
+	})
+}
+
+func GenericObserverAsIntObserver(observer GenericObserver) IntObserver {
+	return IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type IntObservableFactory func(observer IntObserver, subscription Subscription)
+
+func (f IntObservableFactory) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateInt calls f(observer, subscription) to produce values for a stream.
+func CreateInt(f func(observer IntObserver, subscription Subscription)) *IntStream {
+	return FromIntObservable(IntObservableFactory(f))
+}
+
+// Repeat value count times.
+func RepeatInt(value int, count int) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		for i := 0; i < count; i++ {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(value)
+		}
+		observer.Complete()
+	})
+}
+
+// StartInt is designed to be used with functions that return a
+// (int, error) tuple.
+//
+// If the error is non-nil the returned IntStream will be that error,
+// otherwise it will be a single-value stream of int.
+func StartInt(f func() (int, error)) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		if v, err := f(); err != nil {
+			observer.Error(err)
+		} else {
+			observer.Next(v)
+			observer.Complete()
+		}
+	})
+}
+
+type deferIntObservable func() IntObservable
+
+func (f deferIntObservable) Subscribe(observer IntObserver) Subscription {
+	return f().Subscribe(observer)
+}
+
+// DeferInt calls f to create a fresh observable for each subscription.
+func DeferInt(f func() IntObservable) *IntStream {
+	return FromIntObservable(deferIntObservable(f))
+}
+func PassthroughInt(next int, err error, complete bool, observer IntObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroInt = *new(int)
+
+type IntObserverFunc func(int, error, bool)
+
+func (f IntObserverFunc) Next(next int)   { f(next, nil, false) }
+func (f IntObserverFunc) Error(err error) { f(zeroInt, err, false) }
+func (f IntObserverFunc) Complete()       { f(zeroInt, nil, true) }
+
+type IntObservable interface {
+	Subscribe(IntObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a IntObservable
+func (f GenericObservableFilterFactory) Int(parent IntObservable) IntObservable {
+	return MapInt2IntObservable(parent, func(observer IntObserver) MappingInt2IntFunc {
+		gobserver := IntObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next int, err error, complete bool, observer IntObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func NeverInt() *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {})
+}
+
+func EmptyInt() *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		observer.Complete()
+	})
+}
+
+func ThrowInt(err error) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		observer.Error(err)
+	})
+}
+func FromIntArray(array []int) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromInts(array ...int) *IntStream {
+	return FromIntArray(array)
+}
+
+// FromIntSeq emits each value yielded by seq, iterating it once per
+// subscription.
+func FromIntSeq(seq iter.Seq[int]) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		for v := range seq {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+func JustInt(element int) *IntStream {
+	return FromIntArray([]int{element})
+}
+
+func MergeInt(observables ...IntObservable) *IntStream {
+	if len(observables) == 0 {
+		return EmptyInt()
+	}
+	return (&IntStream{observables[0]}).Merge(observables[1:]...)
+}
+
+func MergeIntDelayError(observables ...IntObservable) *IntStream {
+	if len(observables) == 0 {
+		return EmptyInt()
+	}
+	return (&IntStream{observables[0]}).MergeDelayError(observables[1:]...)
+}
+
+type ambIntObservable []IntObservable
+
+func (a ambIntObservable) Subscribe(observer IntObserver) Subscription {
+	lock := sync.Mutex{}
+	winner := -1
+	subscriptions := make([]*LinkedSubscription, len(a))
+	for i := range subscriptions {
+		subscriptions[i] = NewLinkedSubscription()
+	}
+	for i, observable := range a {
+		i := i
+		subscriptions[i].Link(observable.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+			lock.Lock()
+			if winner == -1 {
+				winner = i
+				for j, subscription := range subscriptions {
+					if j != i {
+						subscription.Dispose()
+					}
+				}
+			}
+			won := winner == i
+			lock.Unlock()
+			if won {
+				PassthroughInt(next, err, complete, observer)
+			}
+		})))
+	}
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		for _, s := range subscriptions {
+			s.Dispose()
+		}
+	})
+	return subscription
+}
+
+// AmbInt subscribes to all observables and mirrors whichever emits
+// first, disposing the others.
+func AmbInt(observables ...IntObservable) *IntStream {
+	if len(observables) == 0 {
+		return EmptyInt()
+	}
+	return FromIntObservable(ambIntObservable(observables))
+}
+func FromIntChannel(ch <-chan int) *IntStream {
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type IntStream struct {
+	IntObservable
+}
+
+func FromIntObservable(observable IntObservable) *IntStream {
+	return &IntStream{observable}
+}
+
+func (s *IntStream) SubscribeFunc(f func(int, error, bool)) Subscription {
+	return s.Subscribe(IntObserverFunc(f))
+}
+
+func (s *IntStream) SubscribeNext(f func(v int)) Subscription {
+	return s.SubscribeFunc(func(next int, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *IntStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsIntObserver(observer))
+}
+
+// Distinct removes duplicate elements in the stream. Values that can not be
+// map keys, such as slices, are compared with reflect.DeepEqual.
+func (s *IntStream) Distinct() *IntStream {
+	return FromIntObservable(distinctFilter().Int(s))
+}
+
+// ElementAt yields the Nth element of the stream.
+func (s *IntStream) ElementAt(n int) *IntStream {
+	return FromIntObservable(elementAtFilter(n).Int(s))
+}
+
+// Filter elements in the stream on a function.
+func (s *IntStream) Filter(f func(int) bool) *IntStream {
+	return FromIntObservable(filterFilter(func(v interface{}) bool { return f(v.(int)) }).Int(s))
+}
+
+// Last returns just the first element of the stream.
+func (s *IntStream) First() *IntStream {
+	return FromIntObservable(firstFilter().Int(s))
+}
+
+// Last returns just the last element of the stream.
+func (s *IntStream) Last() *IntStream {
+	return FromIntObservable(lastFilter().Int(s))
+}
+
+// SkipLast skips the first N elements of the stream.
+func (s *IntStream) Skip(n int) *IntStream {
+	return FromIntObservable(skipFilter(n).Int(s))
+}
+
+// SkipLast skips the last N elements of the stream.
+func (s *IntStream) SkipLast(n int) *IntStream {
+	return FromIntObservable(skipLastFilter(n).Int(s))
+}
+
+// Take returns just the first N elements of the stream.
+func (s *IntStream) Take(n int) *IntStream {
+	return FromIntObservable(takeFilter(n).Int(s))
+}
+
+// TakeLast returns just the last N elements of the stream.
+func (s *IntStream) TakeLast(n int) *IntStream {
+	return FromIntObservable(takeLastFilter(n).Int(s))
+}
+
+type takeWhileInt struct {
+	parent IntObservable
+	f      func(int) bool
+}
+
+func (t *takeWhileInt) Subscribe(observer IntObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	parent.Link(t.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Complete()
+		case t.f(next):
+			observer.Next(next)
+		default:
+			done = true
+			observer.Complete()
+			parent.Dispose()
+		}
+	})))
+	return parent
+}
+
+// TakeWhile returns elements of the stream until f returns false, then
+// completes and disposes the stream.
+func (s *IntStream) TakeWhile(f func(int) bool) *IntStream {
+	return &IntStream{&takeWhileInt{s, f}}
+}
+
+// SkipWhile skips elements of the stream until f returns false.
+func (s *IntStream) SkipWhile(f func(int) bool) *IntStream {
+	return FromIntObservable(MapInt2IntObservable(s, func(IntObserver) MappingInt2IntFunc {
+		skipping := true
+		return func(next int, err error, complete bool, observer IntObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if skipping && !f(next) {
+					skipping = false
+				}
+				if !skipping {
+					observer.Next(next)
+				}
+			}
+		}
+	}))
+}
+
+// IgnoreElements ignores elements of the stream and emits only the completion events.
+func (s *IntStream) IgnoreElements() *IntStream {
+	return FromIntObservable(ignoreElementsFilter().Int(s))
+}
+
+func (s *IntStream) Replay(size int, duration time.Duration) *IntStream {
+	return FromIntObservable(replayFilter(size, duration).Int(s))
+}
+
+func (s *IntStream) Sample(duration time.Duration) *IntStream {
+	return FromIntObservable(sampleFilter(duration).Int(s))
+}
+
+func (s *IntStream) Debounce(duration time.Duration) *IntStream {
+	return FromIntObservable(debounceFilter(duration).Int(s))
+}
+
+type delayInt struct {
+	parent     IntObservable
+	delay      func(int) time.Duration
+	completion time.Duration
+}
+
+func (d *delayInt) Subscribe(observer IntObserver) Subscription {
+	queue := newDelayQueue(IntObserverAsGenericObserver(observer))
+	parent := d.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			queue.error(err)
+		case complete:
+			queue.enqueue(delayedEntry{complete: true}, d.completion)
+		default:
+			queue.enqueue(delayedEntry{next: next}, d.delay(next))
+		}
+	}))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		queue.dispose()
+	})
+	return subscription
+}
+
+// Delay shifts each value, and completion, forward in time by duration. Errors
+// are not delayed. Values that are still pending are discarded on disposal.
+func (s *IntStream) Delay(duration time.Duration) *IntStream {
+	return &IntStream{&delayInt{s, func(int) time.Duration { return duration }, duration}}
+}
+
+// DelayWhen shifts each value forward in time by the duration returned by f.
+// Values are never reordered, so a value is emitted no earlier than the value
+// before it. Completion is emitted after the last value. Errors are not delayed.
+func (s *IntStream) DelayWhen(f func(int) time.Duration) *IntStream {
+	return &IntStream{&delayInt{s, f, 0}}
+}
+
+// Wait for completion of the stream and return any error.
+func (s *IntStream) Wait() error {
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			errch <- nil
+		default:
+		}
+	})
+	return <-errch
+}
+
+func MakeIntSubscriber(observer IntObserver) IntSubscriber {
+	if subscriber, ok := observer.(IntSubscriber); ok {
+		return subscriber
+	}
+	return &implIntSubscriber{NewGenericSubscription(), observer}
+}
+
+type concatIntSubscriber struct {
+	observable  int
+	observer    IntObserver
+	observables []IntObservable
+	Subscription
+}
+
+func (c *concatIntSubscriber) Next(next int) {
+	c.observer.Next(next)
+}
+
+func (c *concatIntSubscriber) Error(err error) {
+	c.observer.Error(err)
+	c.observable = len(c.observables)
+	c.Dispose()
+}
+
+func (c *concatIntSubscriber) Complete() {
+	c.observable++
+	if c.observable >= len(c.observables) {
+		c.observer.Complete()
+		c.Dispose()
+		return
+	}
+	c.observables[c.observable].Subscribe(c)
+}
+
+type concatIntObservable struct {
+	observables []IntObservable
+}
+
+func (m *concatIntObservable) Subscribe(observer IntObserver) Subscription {
+	if len(m.observables) == 0 {
+		observer.Complete()
+		return ClosedSubscription
+	}
+	subscriber := &concatIntSubscriber{
+		observer:     observer,
+		Subscription: NewGenericSubscription(),
+		observables:  m.observables,
+	}
+	m.observables[0].Subscribe(subscriber)
+	return subscriber
+}
+
+func (s *IntStream) Concat(observables ...IntObservable) *IntStream {
+	return &IntStream{&concatIntObservable{append([]IntObservable{s}, observables...)}}
+}
+
+// StartWith emits values before the values of the stream.
+func (s *IntStream) StartWith(values ...int) *IntStream {
+	return FromIntArray(values).Concat(s)
+}
+
+// EndWith emits values after the stream completes.
+func (s *IntStream) EndWith(values ...int) *IntStream {
+	return s.Concat(FromIntArray(values))
+}
+
+// DefaultIfEmpty emits value if the stream completes without emitting any values.
+func (s *IntStream) DefaultIfEmpty(value int) *IntStream {
+	return FromIntObservable(MapInt2IntObservable(s, func(IntObserver) MappingInt2IntFunc {
+		empty := true
+		return func(next int, err error, complete bool, observer IntObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				if empty {
+					observer.Next(value)
+				}
+				observer.Complete()
+			default:
+				empty = false
+				observer.Next(next)
+			}
+		}
+	}))
+}
+
+type switchIfEmptyIntObservable struct {
+	parent IntObservable
+	other  IntObservable
+}
+
+func (e *switchIfEmptyIntObservable) Subscribe(observer IntObserver) Subscription {
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
+	empty := true
+	link.Link(e.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			if !empty {
+				observer.Complete()
+			} else if !subscription.Disposed() {
+				subscription.Set(e.other.Subscribe(observer))
+			}
+		default:
+			empty = false
+			observer.Next(next)
+		}
+	})))
+	return subscription
+}
+
+// SwitchIfEmpty switches to other if the stream completes without emitting any values.
+func (s *IntStream) SwitchIfEmpty(other IntObservable) *IntStream {
+	return &IntStream{&switchIfEmptyIntObservable{s, other}}
+}
+
+type mergeIntObservable struct {
+	delayError  bool
+	observables []IntObservable
+}
+
+func (m *mergeIntObservable) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	lock := sync.Mutex{}
+	completed := 0
+	var firstError error
+	relay := func(next int, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if completed >= len(m.observables) {
+			return
+		}
+
+		switch {
+		case err != nil:
+			if m.delayError {
+				firstError = err
+				completed++
+			} else {
+				observer.Error(err)
+				completed = len(m.observables)
+			}
+
+		case complete:
+			completed++
+			if completed == len(m.observables) {
+				if firstError != nil {
+					observer.Error(firstError)
+				} else {
+					observer.Complete()
+				}
+			}
+		default:
+			observer.Next(next)
+		}
+	}
+	for _, observable := range m.observables {
+		observable.Subscribe(IntObserverFunc(relay))
+	}
+	return subscription
+}
+
+// Merge an arbitrary number of observables with this one.
+// An error from any of the observables will terminate the merged stream.
+func (s *IntStream) Merge(other ...IntObservable) *IntStream {
+	if len(other) == 0 {
+		return s
+	}
+	return &IntStream{&mergeIntObservable{false, append(other, s)}}
+}
+
+// Merge an arbitrary number of observables with this one.
+// Any error will be deferred until all observables terminate.
+func (s *IntStream) MergeDelayError(other ...IntObservable) *IntStream {
+	if len(other) == 0 {
+		return s
+	}
+	return &IntStream{&mergeIntObservable{true, append(other, s)}}
+}
+
+// Amb mirrors whichever of this stream and others emits first, disposing the rest.
+func (s *IntStream) Amb(others ...IntObservable) *IntStream {
+	return AmbInt(append([]IntObservable{s}, others...)...)
+}
+
+type catchIntObservable struct {
+	parent IntObservable
+	catch  func(err error) IntObservable
+	// Also switch to the fallback when the parent completes. err will be nil.
+	resume bool
+}
+
+func (r *catchIntObservable) Subscribe(observer IntObserver) Subscription {
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
+	run := func(next int, err error, complete bool) {
+		switch {
+		case err != nil || (complete && r.resume):
+			if !subscription.Disposed() {
+				subscription.Set(r.catch(err).Subscribe(observer))
+			}
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	}
+	link.Link(r.parent.Subscribe(IntObserverFunc(run)))
+	return subscription
+}
+
+// Catch switches to the catch observable if the stream errors.
+func (s *IntStream) Catch(catch IntObservable) *IntStream {
+	return s.CatchFunc(func(error) IntObservable { return catch })
+}
+
+// CatchFunc switches to the observable returned by f(err) if the stream errors.
+func (s *IntStream) CatchFunc(f func(err error) IntObservable) *IntStream {
+	return &IntStream{&catchIntObservable{parent: s, catch: f}}
+}
+
+// OnErrorReturn emits the single value returned by f(err) and completes if the stream errors.
+func (s *IntStream) OnErrorReturn(f func(err error) int) *IntStream {
+	return s.CatchFunc(func(err error) IntObservable { return JustInt(f(err)) })
+}
+
+// OnErrorResumeNext switches to next when the stream terminates, whether it
+// completes or errors.
+func (s *IntStream) OnErrorResumeNext(next IntObservable) *IntStream {
+	return &IntStream{&catchIntObservable{
+		parent: s,
+		catch:  func(error) IntObservable { return next },
+		resume: true,
+	}}
+}
+
+type retryIntObservable struct {
+	observable IntObservable
+	policy     RetryPolicy
+}
+
+func (r *retryIntObservable) Subscribe(observer IntObserver) Subscription {
+	subscription := NewSerialSubscription()
+	attempt := 0
+	var subscribe func()
+	subscribe = func() {
+		// Set the link before subscribing, in case the observable fails
+		// and is resubscribed before Subscribe returns.
+		link := NewLinkedSubscription()
+		subscription.Set(link)
+		link.Link(r.observable.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+			switch {
+			case err != nil:
+				if subscription.Disposed() {
+					return
+				}
+				attempt++
+				delay, ok := r.policy(err, attempt)
+				switch {
+				case !ok:
+					observer.Error(err)
+				case delay > 0:
+					time.AfterFunc(delay, func() {
+						if !subscription.Disposed() {
+							subscribe()
+						}
+					})
+				default:
+					subscribe()
+				}
+			case complete:
+				observer.Complete()
+			default:
+				attempt = 0
+				observer.Next(next)
+			}
+		})))
+	}
+	subscribe()
+	return subscription
+}
+
+// Retry resubscribes to the stream immediately, and indefinitely, on error.
+func (s *IntStream) Retry() *IntStream {
+	return s.RetryWhen(func(error, int) (time.Duration, bool) { return 0, true })
+}
+
+// RetryN resubscribes to the stream on error, at most n times in a row without
+// the stream emitting a value in between.
+func (s *IntStream) RetryN(n int) *IntStream {
+	return s.RetryWhen(func(err error, attempt int) (time.Duration, bool) { return 0, attempt <= n })
+}
+
+// RetryWhen calls policy on each error to decide whether, and after how long,
+// to resubscribe to the stream. attempt counts consecutive errors, starting at
+// 1 and reset whenever the stream emits a value.
+func (s *IntStream) RetryWhen(policy func(err error, attempt int) (time.Duration, bool)) *IntStream {
+	return &IntStream{&retryIntObservable{s, policy}}
+}
+
+// RetryWithBackoff resubscribes to the stream on error, indefinitely, with
+// an ExponentialBackoff delay. The delay is reset to initial whenever the
+// stream emits a value.
+func (s *IntStream) RetryWithBackoff(initial, max time.Duration, multiplier, jitter float64) *IntStream {
+	return s.RetryWhen(ExponentialBackoff(initial, max, multiplier, jitter))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *IntStream) Do(f func(next int)) *IntStream {
+	return FromIntObservable(MapInt2IntObserveNext(s, func(next int) int {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *IntStream) DoOnError(f func(err error)) *IntStream {
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughInt(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *IntStream) DoOnComplete(f func()) *IntStream {
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		if complete {
+			f()
+		}
+		PassthroughInt(next, err, complete, observer)
+	}))
+}
+func (s *IntStream) Reduce(initial int, reducer func(int, int) int) *IntStream {
+	value := initial
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			observer.Next(value)
+			observer.Error(err)
+		case complete:
+			observer.Next(value)
+			observer.Complete()
+		default:
+			value = reducer(value, next)
+		}
+	}))
+}
+
+func (s *IntStream) Scan(initial int, f func(int, int) int) *IntStream {
+	value := initial
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			value = f(value, next)
+			observer.Next(value)
+		}
+	}))
+}
+
+type timeoutInt struct {
+	parent  IntObservable
+	timeout time.Duration
+}
+
+func (t *timeoutInt) Subscribe(observer IntObserver) Subscription {
+	subscription := NewChannelSubscription()
+	cancel := t.parent.Subscribe(observer)
+	go func() {
+		select {
+		case <-time.After(t.timeout):
+			observer.Error(ErrTimeout)
+			cancel.Dispose()
+			subscription.Dispose()
+		case <-subscription:
+			cancel.Dispose()
+		}
+	}()
+	return subscription
+}
+
+func (s *IntStream) Timeout(timeout time.Duration) *IntStream {
+	return &IntStream{&timeoutInt{s, timeout}}
+}
+
+type delaySubscriptionInt struct {
+	parent IntObservable
+	delay  time.Duration
+}
+
+func (d *delaySubscriptionInt) Subscribe(observer IntObserver) Subscription {
+	subscription := NewLinkedSubscription()
+	time.AfterFunc(d.delay, func() {
+		if !subscription.Disposed() {
+			subscription.Link(d.parent.Subscribe(observer))
+		}
+	})
+	return subscription
+}
+
+// DelaySubscription waits for delay before subscribing to the stream.
+func (s *IntStream) DelaySubscription(delay time.Duration) *IntStream {
+	return &IntStream{&delaySubscriptionInt{s, delay}}
+}
+
+type takeUntilInt struct {
+	parent IntObservable
+	signal GenericObservable
+}
+
+func (t *takeUntilInt) Subscribe(observer IntObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			// A signal that completes without a value never ends the stream.
+			break
+		default:
+			terminate(observer.Complete)
+		}
+	})))
+	parent.Link(t.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// TakeUntil returns elements of the stream until signal, which may be a
+// stream of any type, emits a value. The stream then completes.
+func (s *IntStream) TakeUntil(signal GenericObservable) *IntStream {
+	return &IntStream{&takeUntilInt{s, signal}}
+}
+
+type skipUntilInt struct {
+	parent IntObservable
+	signal GenericObservable
+}
+
+func (t *skipUntilInt) Subscribe(observer IntObserver) Subscription {
+	lock := sync.Mutex{}
+	open := false
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			break
+		default:
+			lock.Lock()
+			open = true
+			lock.Unlock()
+			signal.Dispose()
+		}
+	})))
+	parent.Link(t.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if open && !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// SkipUntil skips elements of the stream until signal, which may be a stream
+// of any type, emits a value.
+func (s *IntStream) SkipUntil(signal GenericObservable) *IntStream {
+	return &IntStream{&skipUntilInt{s, signal}}
+}
+
+type forkedIntStream struct {
+	lock      sync.Mutex
+	parent    IntObservable
+	observers []IntObserver
+}
+
+func (f *forkedIntStream) Subscribe(observer IntObserver) Subscription {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	i := len(f.observers)
+	f.observers = append(f.observers, observer)
+	sub := new(CallbackSubscription)
+	*sub = CallbackSubscription(func() {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		f.observers[i] = nil
+	})
+	return sub
+}
+
+// Fork replicates each event from the parent to every subscriber of the fork.
+func (s *IntStream) Fork() *IntStream {
+	f := &forkedIntStream{parent: s}
+	go s.Subscribe(IntObserverFunc(func(n int, err error, complete bool) {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		for _, o := range f.observers {
+			if o == nil {
+				continue
+			}
+			switch {
+			case err != nil:
+				o.Error(err)
+			case complete:
+				o.Complete()
+			default:
+				o.Next(n)
+			}
+		}
+	}))
+	return &IntStream{f}
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *IntStream) ToOneWithError() (int, error) {
+	valuech := make(chan int, 1)
+	errch := make(chan error, 1)
+	FromIntObservable(oneFilter().Int(s)).SubscribeFunc(func(next int, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroInt, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *IntStream) ToOne() int {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *IntStream) ToArrayWithError() ([]int, error) {
+	array := []int{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *IntStream) ToArray() []int {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *IntStream) ToChannelWithError() (<-chan int, <-chan error) {
+	ch := make(chan int, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *IntStream) ToChannel() <-chan int {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *IntStream) Seq() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *IntStream) Seq2() iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		type notification struct {
+			next     int
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next int, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroInt, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+// Count returns an IntStream with the count of elements in this stream.
+func (s *IntStream) Count() *IntStream {
+	count := 0
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			observer.Next(count)
+			observer.Error(err)
+		case complete:
+			observer.Next(count)
+			observer.Complete()
+		default:
+			count++
+		}
+	}))
+}
+
+func equalInt(a, b int) bool {
+	return a == b
+}
+
+type decideInt struct {
+	parent IntObservable
+	// decide returns the result and true once the answer is known.
+	decide func(next int) (bool, bool)
+	// otherwise is the result if the stream completes first.
+	otherwise bool
+}
+
+func (d *decideInt) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	subscription := NewLinkedSubscription()
+	subscription.Link(d.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Next(d.otherwise)
+			observer.Complete()
+		default:
+			if result, ok := d.decide(next); ok {
+				done = true
+				observer.Next(result)
+				observer.Complete()
+				subscription.Dispose()
+			}
+		}
+	})))
+	return subscription
+}
+
+// All emits true if f returns true for every value in the stream, or false
+// as soon as it does not.
+func (s *IntStream) All(f func(int) bool) *BoolStream {
+	return FromBoolObservable(&decideInt{s, func(next int) (bool, bool) { return false, !f(next) }, true})
+}
+
+// Any emits true as soon as f returns true for a value in the stream, or
+// false if it never does.
+func (s *IntStream) Any(f func(int) bool) *BoolStream {
+	return FromBoolObservable(&decideInt{s, func(next int) (bool, bool) { return true, f(next) }, false})
+}
+
+// Contains emits true as soon as value is seen in the stream, or false if it
+// never is.
+func (s *IntStream) Contains(value int) *BoolStream {
+	return s.Any(func(next int) bool { return equalInt(next, value) })
+}
+
+// IsEmpty emits true if the stream completes without a value, or false as
+// soon as it emits one.
+func (s *IntStream) IsEmpty() *BoolStream {
+	return FromBoolObservable(&decideInt{s, func(int) (bool, bool) { return false, true }, true})
+}
+
+type sequenceEqualInt struct {
+	parent IntObservable
+	other  IntObservable
+}
+
+func (e *sequenceEqualInt) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	// Values seen on one side that the other has not caught up with yet.
+	// Only one side can be ahead at a time.
+	pending := [2][]int{}
+	completed := [2]bool{}
+	subscriptions := [2]*LinkedSubscription{NewLinkedSubscription(), NewLinkedSubscription()}
+	dispose := func() {
+		subscriptions[0].Dispose()
+		subscriptions[1].Dispose()
+	}
+	finish := func(result bool) {
+		done = true
+		observer.Next(result)
+		observer.Complete()
+		dispose()
+	}
+	observe := func(i int) IntObserver {
+		j := 1 - i
+		return IntObserverFunc(func(next int, err error, complete bool) {
+			lock.Lock()
+			defer lock.Unlock()
+			if done {
+				return
+			}
+			switch {
+			case err != nil:
+				done = true
+				observer.Error(err)
+				dispose()
+			case complete:
+				completed[i] = true
+				if len(pending[j]) > 0 {
+					finish(false)
+				} else if completed[j] {
+					finish(true)
+				}
+			case len(pending[j]) > 0:
+				expected := pending[j][0]
+				pending[j] = pending[j][1:]
+				if !equalInt(next, expected) {
+					finish(false)
+				}
+			case completed[j]:
+				finish(false)
+			default:
+				pending[i] = append(pending[i], next)
+			}
+		})
+	}
+	subscriptions[0].Link(e.parent.Subscribe(observe(0)))
+	subscriptions[1].Link(e.other.Subscribe(observe(1)))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(dispose)
+	return subscription
+}
+
+// SequenceEqual emits true if the stream and other emit equal values in the
+// same order and both complete, or false as soon as they differ.
+func (s *IntStream) SequenceEqual(other IntObservable) *BoolStream {
+	return FromBoolObservable(&sequenceEqualInt{s, other})
+}
+
+func (s *IntStream) Average() *IntStream {
+	var sum int
+	var count int
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			observer.Next(sum / count)
+			observer.Error(err)
+		case complete:
+			observer.Next(sum / count)
+			observer.Complete()
+		default:
+			sum += next
+			count++
+		}
+	}))
+}
+
+func (s *IntStream) Sum() *IntStream {
+	var sum int
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			observer.Next(sum)
+			observer.Error(err)
+		case complete:
+			observer.Next(sum)
+			observer.Complete()
+		default:
+			sum += next
+		}
+	}))
+}
+
+func (s *IntStream) Min() *IntStream {
+	started := false
+	var min int
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			if started {
+				observer.Next(min)
+			}
+			observer.Error(err)
+		case complete:
+			if started {
+				observer.Next(min)
+			}
+			observer.Complete()
+		default:
+			if started {
+				if next < min {
+					min = next
+				}
+			} else {
+				min = next
+				started = true
+			}
+		}
+	}))
+}
+
+func (s *IntStream) Max() *IntStream {
+	started := false
+	var max int
+	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			if started {
+				observer.Next(max)
+			}
+			observer.Error(err)
+		case complete:
+			if started {
+				observer.Next(max)
+			}
+			observer.Complete()
+		default:
+			if started {
+				if !(next < max) {
+					max = next
+				}
+			} else {
+				max = next
+				started = true
+			}
+		}
+	}))
+}
+
+// Sorted emits all values in ascending order once the stream terminates.
+func (s *IntStream) Sorted() *IntStream {
+	return FromIntObservable(sortedFilter(func(a, b interface{}) bool {
+		x, y := a.(int), b.(int)
+		return x < y
+	}).Int(s))
+}
+
+// IntNotification is a materialized event from a IntStream.
+type IntNotification struct {
+	Kind  NotificationKind
+	Value int   // Set if Kind is NotificationNext.
+	Err   error // Set if Kind is NotificationError.
+}
+
+// Materialize emits every event of the stream, including termination, as a
+// IntNotification. The materialized stream completes after the
+// notification for the termination of this stream.
+func (s *IntStream) Materialize() *IntNotificationStream {
+	return FromIntNotificationObservable(MapInt2IntNotificationObserveDirect(s, func(next int, err error, complete bool, observer IntNotificationObserver) {
+		switch {
+		case err != nil:
+			observer.Next(IntNotification{Kind: NotificationError, Err: err})
+			observer.Complete()
+		case complete:
+			observer.Next(IntNotification{Kind: NotificationComplete})
+			observer.Complete()
+		default:
+			observer.Next(IntNotification{Kind: NotificationNext, Value: next})
+		}
+	}))
+}
+
+// Dematerialize converts materialized notifications back into the events they
+// represent. Notifications after the first termination are ignored.
+func (s *IntNotificationStream) Dematerialize() *IntStream {
+	return FromIntObservable(MapIntNotification2IntObservable(s, func(IntObserver) MappingIntNotification2IntFunc {
+		terminated := false
+		return func(next IntNotification, err error, complete bool, observer IntObserver) {
+			if terminated {
+				return
+			}
+			switch {
+			case err != nil:
+				terminated = true
+				observer.Error(err)
+			case complete:
+				terminated = true
+				observer.Complete()
+			default:
+				switch next.Kind {
+				case NotificationNext:
+					observer.Next(next.Value)
+				case NotificationError:
+					terminated = true
+					observer.Error(next.Err)
+				case NotificationComplete:
+					terminated = true
+					observer.Complete()
+				}
+			}
+		}
+	}))
+}
+
+// TimestampedInt is a value from a IntStream and the time it was emitted.
+type TimestampedInt struct {
+	Value int
+	Time  time.Time
+}
+
+// Timestamp annotates each value in the stream with the time it was emitted.
+func (s *IntStream) Timestamp() *TimestampedIntStream {
+	return FromTimestampedIntObservable(MapInt2TimestampedIntObserveNext(s, func(next int) TimestampedInt {
+		return TimestampedInt{next, Now()}
+	}))
+}
+
+// IntervalInt is a value from a IntStream and the time elapsed
+// since the previous value was emitted.
+type IntervalInt struct {
+	Value    int
+	Interval time.Duration
+}
+
+// TimeInterval annotates each value in the stream with the time elapsed since
+// the previous value, or since subscription for the first value.
+func (s *IntStream) TimeInterval() *IntervalIntStream {
+	return FromIntervalIntObservable(MapInt2IntervalIntObservable(s, func(IntervalIntObserver) MappingInt2IntervalIntFunc {
+		last := Now()
+		return func(next int, err error, complete bool, observer IntervalIntObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				now := Now()
+				observer.Next(IntervalInt{next, now.Sub(last)})
+				last = now
+			}
+		}
+	}))
+}
+
+type MappingInt2ResponseFunc func(next int, err error, complete bool, observer ResponseObserver)
+type MappingInt2ResponseFuncFactory func(observer ResponseObserver) MappingInt2ResponseFunc
+
+type MappingInt2ResponseObservable struct {
+	parent IntObservable
+	mapper MappingInt2ResponseFuncFactory
+}
+
+func (f *MappingInt2ResponseObservable) Subscribe(observer ResponseObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInt2ResponseObservable(parent IntObservable, mapper MappingInt2ResponseFuncFactory) ResponseObservable {
+	return &MappingInt2ResponseObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInt2ResponseObserveDirect(parent IntObservable, mapper MappingInt2ResponseFunc) ResponseObservable {
+	return MapInt2ResponseObservable(parent, func(ResponseObserver) MappingInt2ResponseFunc {
+		return mapper
+	})
+}
+
+func MapInt2ResponseObserveNext(parent IntObservable, mapper func(int) *http.Response) ResponseObservable {
+	return MapInt2ResponseObservable(parent, func(ResponseObserver) MappingInt2ResponseFunc {
+		return func(next int, err error, complete bool, observer ResponseObserver) {
+			var mapped *http.Response
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughResponse(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapInt2Response struct {
+	parent IntObservable
+	mapper func(int) ResponseObservable
+}
+
+func (f *flatMapInt2Response) Subscribe(observer ResponseObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&ResponseStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &ResponseStream{ignoreCompletionFilter().Response(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapResponse maps this stream to an ResponseStream via f.
+func (s *IntStream) MapResponse(f func(int) *http.Response) *ResponseStream {
+	return FromResponseObservable(MapInt2ResponseObserveNext(s, f))
+}
+
+func (s *IntStream) FlatMapResponse(f func(int) ResponseObservable) *ResponseStream {
+	return &ResponseStream{&flatMapInt2Response{s, f}}
+}
+
+type MappingInt2StringFunc func(next int, err error, complete bool, observer StringObserver)
+type MappingInt2StringFuncFactory func(observer StringObserver) MappingInt2StringFunc
+
+type MappingInt2StringObservable struct {
+	parent IntObservable
+	mapper MappingInt2StringFuncFactory
+}
+
+func (f *MappingInt2StringObservable) Subscribe(observer StringObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInt2StringObservable(parent IntObservable, mapper MappingInt2StringFuncFactory) StringObservable {
+	return &MappingInt2StringObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInt2StringObserveDirect(parent IntObservable, mapper MappingInt2StringFunc) StringObservable {
+	return MapInt2StringObservable(parent, func(StringObserver) MappingInt2StringFunc {
+		return mapper
+	})
+}
+
+func MapInt2StringObserveNext(parent IntObservable, mapper func(int) string) StringObservable {
+	return MapInt2StringObservable(parent, func(StringObserver) MappingInt2StringFunc {
+		return func(next int, err error, complete bool, observer StringObserver) {
+			var mapped string
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughString(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapInt2String struct {
+	parent IntObservable
+	mapper func(int) StringObservable
+}
+
+func (f *flatMapInt2String) Subscribe(observer StringObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&StringStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &StringStream{ignoreCompletionFilter().String(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapString maps this stream to an StringStream via f.
+func (s *IntStream) MapString(f func(int) string) *StringStream {
+	return FromStringObservable(MapInt2StringObserveNext(s, f))
+}
+
+func (s *IntStream) FlatMapString(f func(int) StringObservable) *StringStream {
+	return &StringStream{&flatMapInt2String{s, f}}
+}
+
+type MappingInt2IntFunc func(next int, err error, complete bool, observer IntObserver)
+type MappingInt2IntFuncFactory func(observer IntObserver) MappingInt2IntFunc
+
+type MappingInt2IntObservable struct {
+	parent IntObservable
+	mapper MappingInt2IntFuncFactory
+}
+
+func (f *MappingInt2IntObservable) Subscribe(observer IntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInt2IntObservable(parent IntObservable, mapper MappingInt2IntFuncFactory) IntObservable {
+	return &MappingInt2IntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInt2IntObserveDirect(parent IntObservable, mapper MappingInt2IntFunc) IntObservable {
+	return MapInt2IntObservable(parent, func(IntObserver) MappingInt2IntFunc {
+		return mapper
+	})
+}
+
+func MapInt2IntObserveNext(parent IntObservable, mapper func(int) int) IntObservable {
+	return MapInt2IntObservable(parent, func(IntObserver) MappingInt2IntFunc {
+		return func(next int, err error, complete bool, observer IntObserver) {
+			var mapped int
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapInt2Int struct {
+	parent IntObservable
+	mapper func(int) IntObservable
+}
+
+func (f *flatMapInt2Int) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&IntStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &IntStream{ignoreCompletionFilter().Int(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// Map maps values in this stream to another value.
+func (s *IntStream) Map(f func(int) int) *IntStream {
+	return FromIntObservable(MapInt2IntObserveNext(s, f))
+}
+
+func (s *IntStream) FlatMap(f func(int) IntObservable) *IntStream {
+	return &IntStream{&flatMapInt2Int{s, f}}
+}
+
+type MappingInt2BoolFunc func(next int, err error, complete bool, observer BoolObserver)
+type MappingInt2BoolFuncFactory func(observer BoolObserver) MappingInt2BoolFunc
+
+type MappingInt2BoolObservable struct {
+	parent IntObservable
+	mapper MappingInt2BoolFuncFactory
+}
+
+func (f *MappingInt2BoolObservable) Subscribe(observer BoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInt2BoolObservable(parent IntObservable, mapper MappingInt2BoolFuncFactory) BoolObservable {
+	return &MappingInt2BoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInt2BoolObserveDirect(parent IntObservable, mapper MappingInt2BoolFunc) BoolObservable {
+	return MapInt2BoolObservable(parent, func(BoolObserver) MappingInt2BoolFunc {
+		return mapper
+	})
+}
+
+func MapInt2BoolObserveNext(parent IntObservable, mapper func(int) bool) BoolObservable {
+	return MapInt2BoolObservable(parent, func(BoolObserver) MappingInt2BoolFunc {
+		return func(next int, err error, complete bool, observer BoolObserver) {
+			var mapped bool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapInt2Bool struct {
+	parent IntObservable
+	mapper func(int) BoolObservable
+}
+
+func (f *flatMapInt2Bool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&BoolStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &BoolStream{ignoreCompletionFilter().Bool(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *IntStream) MapBool(f func(int) bool) *BoolStream {
+	return FromBoolObservable(MapInt2BoolObserveNext(s, f))
+}
+
+func (s *IntStream) FlatMapBool(f func(int) BoolObservable) *BoolStream {
+	return &BoolStream{&flatMapInt2Bool{s, f}}
+}
+
+type MappingInt2IntNotificationFunc func(next int, err error, complete bool, observer IntNotificationObserver)
+type MappingInt2IntNotificationFuncFactory func(observer IntNotificationObserver) MappingInt2IntNotificationFunc
+
+type MappingInt2IntNotificationObservable struct {
+	parent IntObservable
+	mapper MappingInt2IntNotificationFuncFactory
+}
+
+func (f *MappingInt2IntNotificationObservable) Subscribe(observer IntNotificationObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInt2IntNotificationObservable(parent IntObservable, mapper MappingInt2IntNotificationFuncFactory) IntNotificationObservable {
+	return &MappingInt2IntNotificationObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInt2IntNotificationObserveDirect(parent IntObservable, mapper MappingInt2IntNotificationFunc) IntNotificationObservable {
+	return MapInt2IntNotificationObservable(parent, func(IntNotificationObserver) MappingInt2IntNotificationFunc {
+		return mapper
+	})
+}
+
+func MapInt2IntNotificationObserveNext(parent IntObservable, mapper func(int) IntNotification) IntNotificationObservable {
+	return MapInt2IntNotificationObservable(parent, func(IntNotificationObserver) MappingInt2IntNotificationFunc {
+		return func(next int, err error, complete bool, observer IntNotificationObserver) {
+			var mapped IntNotification
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntNotification(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapIntNotification maps this stream to an IntNotificationStream via f.
+func (s *IntStream) MapIntNotification(f func(int) IntNotification) *IntNotificationStream {
+	return FromIntNotificationObservable(MapInt2IntNotificationObserveNext(s, f))
+}
+
+type MappingInt2TimestampedIntFunc func(next int, err error, complete bool, observer TimestampedIntObserver)
+type MappingInt2TimestampedIntFuncFactory func(observer TimestampedIntObserver) MappingInt2TimestampedIntFunc
+
+type MappingInt2TimestampedIntObservable struct {
+	parent IntObservable
+	mapper MappingInt2TimestampedIntFuncFactory
+}
+
+func (f *MappingInt2TimestampedIntObservable) Subscribe(observer TimestampedIntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInt2TimestampedIntObservable(parent IntObservable, mapper MappingInt2TimestampedIntFuncFactory) TimestampedIntObservable {
+	return &MappingInt2TimestampedIntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInt2TimestampedIntObserveDirect(parent IntObservable, mapper MappingInt2TimestampedIntFunc) TimestampedIntObservable {
+	return MapInt2TimestampedIntObservable(parent, func(TimestampedIntObserver) MappingInt2TimestampedIntFunc {
+		return mapper
+	})
+}
+
+func MapInt2TimestampedIntObserveNext(parent IntObservable, mapper func(int) TimestampedInt) TimestampedIntObservable {
+	return MapInt2TimestampedIntObservable(parent, func(TimestampedIntObserver) MappingInt2TimestampedIntFunc {
+		return func(next int, err error, complete bool, observer TimestampedIntObserver) {
+			var mapped TimestampedInt
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughTimestampedInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapTimestampedInt maps this stream to an TimestampedIntStream via f.
+func (s *IntStream) MapTimestampedInt(f func(int) TimestampedInt) *TimestampedIntStream {
+	return FromTimestampedIntObservable(MapInt2TimestampedIntObserveNext(s, f))
+}
+
+type MappingInt2IntervalIntFunc func(next int, err error, complete bool, observer IntervalIntObserver)
+type MappingInt2IntervalIntFuncFactory func(observer IntervalIntObserver) MappingInt2IntervalIntFunc
+
+type MappingInt2IntervalIntObservable struct {
+	parent IntObservable
+	mapper MappingInt2IntervalIntFuncFactory
+}
+
+func (f *MappingInt2IntervalIntObservable) Subscribe(observer IntervalIntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapInt2IntervalIntObservable(parent IntObservable, mapper MappingInt2IntervalIntFuncFactory) IntervalIntObservable {
+	return &MappingInt2IntervalIntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapInt2IntervalIntObserveDirect(parent IntObservable, mapper MappingInt2IntervalIntFunc) IntervalIntObservable {
+	return MapInt2IntervalIntObservable(parent, func(IntervalIntObserver) MappingInt2IntervalIntFunc {
+		return mapper
+	})
+}
+
+func MapInt2IntervalIntObserveNext(parent IntObservable, mapper func(int) IntervalInt) IntervalIntObservable {
+	return MapInt2IntervalIntObservable(parent, func(IntervalIntObserver) MappingInt2IntervalIntFunc {
+		return func(next int, err error, complete bool, observer IntervalIntObserver) {
+			var mapped IntervalInt
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntervalInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapIntervalInt maps this stream to an IntervalIntStream via f.
+func (s *IntStream) MapIntervalInt(f func(int) IntervalInt) *IntervalIntStream {
+	return FromIntervalIntObservable(MapInt2IntervalIntObserveNext(s, f))
+}
+
+type IntNotificationObserver interface {
+	Next(IntNotification)
+	TerminationObserver
+}
+
+// A IntNotificationSubscriber represents a subscribed IntNotificationObserver.
+type IntNotificationSubscriber interface {
+	Subscription
+	IntNotificationObserver
+}
+
+type implIntNotificationSubscriber struct {
+	Subscription
+	IntNotificationObserver
+}
+
+func IntNotificationObserverAsGenericObserver(observer IntNotificationObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(IntNotification))
+		}
+	})
+}
+
+func GenericObserverAsIntNotificationObserver(observer GenericObserver) IntNotificationObserver {
+	return IntNotificationObserverFunc(func(next IntNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type IntNotificationObservableFactory func(observer IntNotificationObserver, subscription Subscription)
+
+func (f IntNotificationObservableFactory) Subscribe(observer IntNotificationObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateIntNotification calls f(observer, subscription) to produce values for a stream.
+func CreateIntNotification(f func(observer IntNotificationObserver, subscription Subscription)) *IntNotificationStream {
+	return FromIntNotificationObservable(IntNotificationObservableFactory(f))
+}
+func PassthroughIntNotification(next IntNotification, err error, complete bool, observer IntNotificationObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroIntNotification = *new(IntNotification)
+
+type IntNotificationObserverFunc func(IntNotification, error, bool)
+
+func (f IntNotificationObserverFunc) Next(next IntNotification) { f(next, nil, false) }
+func (f IntNotificationObserverFunc) Error(err error)           { f(zeroIntNotification, err, false) }
+func (f IntNotificationObserverFunc) Complete()                 { f(zeroIntNotification, nil, true) }
+
+type IntNotificationObservable interface {
+	Subscribe(IntNotificationObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a IntNotificationObservable
+func (f GenericObservableFilterFactory) IntNotification(parent IntNotificationObservable) IntNotificationObservable {
+	return MapIntNotification2IntNotificationObservable(parent, func(observer IntNotificationObserver) MappingIntNotification2IntNotificationFunc {
+		gobserver := IntNotificationObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next IntNotification, err error, complete bool, observer IntNotificationObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromIntNotificationArray(array []IntNotification) *IntNotificationStream {
+	return CreateIntNotification(func(observer IntNotificationObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromIntNotifications(array ...IntNotification) *IntNotificationStream {
+	return FromIntNotificationArray(array)
+}
+
+func FromIntNotificationChannel(ch <-chan IntNotification) *IntNotificationStream {
+	return CreateIntNotification(func(observer IntNotificationObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type IntNotificationStream struct {
+	IntNotificationObservable
+}
+
+func FromIntNotificationObservable(observable IntNotificationObservable) *IntNotificationStream {
+	return &IntNotificationStream{observable}
+}
+
+func (s *IntNotificationStream) SubscribeFunc(f func(IntNotification, error, bool)) Subscription {
+	return s.Subscribe(IntNotificationObserverFunc(f))
+}
+
+func (s *IntNotificationStream) SubscribeNext(f func(v IntNotification)) Subscription {
+	return s.SubscribeFunc(func(next IntNotification, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *IntNotificationStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsIntNotificationObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *IntNotificationStream) Filter(f func(IntNotification) bool) *IntNotificationStream {
+	return FromIntNotificationObservable(filterFilter(func(v interface{}) bool { return f(v.(IntNotification)) }).IntNotification(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *IntNotificationStream) Do(f func(next IntNotification)) *IntNotificationStream {
+	return FromIntNotificationObservable(MapIntNotification2IntNotificationObserveNext(s, func(next IntNotification) IntNotification {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *IntNotificationStream) DoOnError(f func(err error)) *IntNotificationStream {
+	return FromIntNotificationObservable(MapIntNotification2IntNotificationObserveDirect(s, func(next IntNotification, err error, complete bool, observer IntNotificationObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughIntNotification(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *IntNotificationStream) DoOnComplete(f func()) *IntNotificationStream {
+	return FromIntNotificationObservable(MapIntNotification2IntNotificationObserveDirect(s, func(next IntNotification, err error, complete bool, observer IntNotificationObserver) {
+		if complete {
+			f()
+		}
+		PassthroughIntNotification(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *IntNotificationStream) ToOneWithError() (IntNotification, error) {
+	valuech := make(chan IntNotification, 1)
+	errch := make(chan error, 1)
+	FromIntNotificationObservable(oneFilter().IntNotification(s)).SubscribeFunc(func(next IntNotification, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroIntNotification, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *IntNotificationStream) ToOne() IntNotification {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *IntNotificationStream) ToArrayWithError() ([]IntNotification, error) {
+	array := []IntNotification{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *IntNotificationStream) ToArray() []IntNotification {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *IntNotificationStream) ToChannelWithError() (<-chan IntNotification, <-chan error) {
+	ch := make(chan IntNotification, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *IntNotificationStream) ToChannel() <-chan IntNotification {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *IntNotificationStream) Seq() iter.Seq[IntNotification] {
+	return func(yield func(IntNotification) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *IntNotificationStream) Seq2() iter.Seq2[IntNotification, error] {
+	return func(yield func(IntNotification, error) bool) {
+		type notification struct {
+			next     IntNotification
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next IntNotification, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroIntNotification, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapIntNotification2Response struct {
+	parent IntNotificationObservable
+	f      func(IntNotification) *http.Response
+}
+
+func (m *mapIntNotification2Response) Subscribe(observer ResponseObserver) Subscription {
+	return m.parent.Subscribe(IntNotificationObserverFunc(func(next IntNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapResponse maps this stream to an ResponseStream via f.
+func (s *IntNotificationStream) MapResponse(f func(IntNotification) *http.Response) *ResponseStream {
+	return &ResponseStream{&mapIntNotification2Response{s, f}}
+}
+
+type mapIntNotification2String struct {
+	parent IntNotificationObservable
+	f      func(IntNotification) string
+}
+
+func (m *mapIntNotification2String) Subscribe(observer StringObserver) Subscription {
+	return m.parent.Subscribe(IntNotificationObserverFunc(func(next IntNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapString maps this stream to an StringStream via f.
+func (s *IntNotificationStream) MapString(f func(IntNotification) string) *StringStream {
+	return &StringStream{&mapIntNotification2String{s, f}}
+}
+
+type mapIntNotification2Bool struct {
+	parent IntNotificationObservable
+	f      func(IntNotification) bool
+}
+
+func (m *mapIntNotification2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(IntNotificationObserverFunc(func(next IntNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *IntNotificationStream) MapBool(f func(IntNotification) bool) *BoolStream {
+	return &BoolStream{&mapIntNotification2Bool{s, f}}
+}
+
+type MappingIntNotification2IntNotificationFunc func(next IntNotification, err error, complete bool, observer IntNotificationObserver)
+type MappingIntNotification2IntNotificationFuncFactory func(observer IntNotificationObserver) MappingIntNotification2IntNotificationFunc
+
+type MappingIntNotification2IntNotificationObservable struct {
+	parent IntNotificationObservable
+	mapper MappingIntNotification2IntNotificationFuncFactory
+}
+
+func (f *MappingIntNotification2IntNotificationObservable) Subscribe(observer IntNotificationObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntNotificationObserverFunc(func(next IntNotification, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapIntNotification2IntNotificationObservable(parent IntNotificationObservable, mapper MappingIntNotification2IntNotificationFuncFactory) IntNotificationObservable {
+	return &MappingIntNotification2IntNotificationObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntNotification2IntNotificationObserveDirect(parent IntNotificationObservable, mapper MappingIntNotification2IntNotificationFunc) IntNotificationObservable {
+	return MapIntNotification2IntNotificationObservable(parent, func(IntNotificationObserver) MappingIntNotification2IntNotificationFunc {
+		return mapper
+	})
+}
+
+func MapIntNotification2IntNotificationObserveNext(parent IntNotificationObservable, mapper func(IntNotification) IntNotification) IntNotificationObservable {
+	return MapIntNotification2IntNotificationObservable(parent, func(IntNotificationObserver) MappingIntNotification2IntNotificationFunc {
+		return func(next IntNotification, err error, complete bool, observer IntNotificationObserver) {
+			var mapped IntNotification
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntNotification(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *IntNotificationStream) Map(f func(IntNotification) IntNotification) *IntNotificationStream {
+	return FromIntNotificationObservable(MapIntNotification2IntNotificationObserveNext(s, f))
+}
+
+type MappingIntNotification2IntFunc func(next IntNotification, err error, complete bool, observer IntObserver)
+type MappingIntNotification2IntFuncFactory func(observer IntObserver) MappingIntNotification2IntFunc
+
+type MappingIntNotification2IntObservable struct {
+	parent IntNotificationObservable
+	mapper MappingIntNotification2IntFuncFactory
+}
+
+func (f *MappingIntNotification2IntObservable) Subscribe(observer IntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntNotificationObserverFunc(func(next IntNotification, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapIntNotification2IntObservable(parent IntNotificationObservable, mapper MappingIntNotification2IntFuncFactory) IntObservable {
+	return &MappingIntNotification2IntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntNotification2IntObserveDirect(parent IntNotificationObservable, mapper MappingIntNotification2IntFunc) IntObservable {
+	return MapIntNotification2IntObservable(parent, func(IntObserver) MappingIntNotification2IntFunc {
+		return mapper
+	})
+}
+
+func MapIntNotification2IntObserveNext(parent IntNotificationObservable, mapper func(IntNotification) int) IntObservable {
+	return MapIntNotification2IntObservable(parent, func(IntObserver) MappingIntNotification2IntFunc {
+		return func(next IntNotification, err error, complete bool, observer IntObserver) {
+			var mapped int
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapIntNotification2Int struct {
+	parent IntNotificationObservable
+	mapper func(IntNotification) IntObservable
+}
+
+func (f *flatMapIntNotification2Int) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntNotificationObserverFunc(func(next IntNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&IntStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &IntStream{ignoreCompletionFilter().Int(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *IntNotificationStream) MapInt(f func(IntNotification) int) *IntStream {
+	return FromIntObservable(MapIntNotification2IntObserveNext(s, f))
+}
+
+func (s *IntNotificationStream) FlatMapInt(f func(IntNotification) IntObservable) *IntStream {
+	return &IntStream{&flatMapIntNotification2Int{s, f}}
+}
+
+type TimestampedIntObserver interface {
+	Next(TimestampedInt)
+	TerminationObserver
+}
+
+// A TimestampedIntSubscriber represents a subscribed TimestampedIntObserver.
+type TimestampedIntSubscriber interface {
+	Subscription
+	TimestampedIntObserver
+}
+
+type implTimestampedIntSubscriber struct {
+	Subscription
+	TimestampedIntObserver
+}
+
+func TimestampedIntObserverAsGenericObserver(observer TimestampedIntObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(TimestampedInt))
+		}
+	})
+}
+
+func GenericObserverAsTimestampedIntObserver(observer GenericObserver) TimestampedIntObserver {
+	return TimestampedIntObserverFunc(func(next TimestampedInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type TimestampedIntObservableFactory func(observer TimestampedIntObserver, subscription Subscription)
+
+func (f TimestampedIntObservableFactory) Subscribe(observer TimestampedIntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateTimestampedInt calls f(observer, subscription) to produce values for a stream.
+func CreateTimestampedInt(f func(observer TimestampedIntObserver, subscription Subscription)) *TimestampedIntStream {
+	return FromTimestampedIntObservable(TimestampedIntObservableFactory(f))
+}
+func PassthroughTimestampedInt(next TimestampedInt, err error, complete bool, observer TimestampedIntObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroTimestampedInt = *new(TimestampedInt)
+
+type TimestampedIntObserverFunc func(TimestampedInt, error, bool)
+
+func (f TimestampedIntObserverFunc) Next(next TimestampedInt) { f(next, nil, false) }
+func (f TimestampedIntObserverFunc) Error(err error)          { f(zeroTimestampedInt, err, false) }
+func (f TimestampedIntObserverFunc) Complete()                { f(zeroTimestampedInt, nil, true) }
+
+type TimestampedIntObservable interface {
+	Subscribe(TimestampedIntObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a TimestampedIntObservable
+func (f GenericObservableFilterFactory) TimestampedInt(parent TimestampedIntObservable) TimestampedIntObservable {
+	return MapTimestampedInt2TimestampedIntObservable(parent, func(observer TimestampedIntObserver) MappingTimestampedInt2TimestampedIntFunc {
+		gobserver := TimestampedIntObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next TimestampedInt, err error, complete bool, observer TimestampedIntObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromTimestampedIntArray(array []TimestampedInt) *TimestampedIntStream {
+	return CreateTimestampedInt(func(observer TimestampedIntObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromTimestampedInts(array ...TimestampedInt) *TimestampedIntStream {
+	return FromTimestampedIntArray(array)
+}
+
+func FromTimestampedIntChannel(ch <-chan TimestampedInt) *TimestampedIntStream {
+	return CreateTimestampedInt(func(observer TimestampedIntObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type TimestampedIntStream struct {
+	TimestampedIntObservable
+}
+
+func FromTimestampedIntObservable(observable TimestampedIntObservable) *TimestampedIntStream {
+	return &TimestampedIntStream{observable}
+}
+
+func (s *TimestampedIntStream) SubscribeFunc(f func(TimestampedInt, error, bool)) Subscription {
+	return s.Subscribe(TimestampedIntObserverFunc(f))
+}
+
+func (s *TimestampedIntStream) SubscribeNext(f func(v TimestampedInt)) Subscription {
+	return s.SubscribeFunc(func(next TimestampedInt, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *TimestampedIntStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsTimestampedIntObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *TimestampedIntStream) Filter(f func(TimestampedInt) bool) *TimestampedIntStream {
+	return FromTimestampedIntObservable(filterFilter(func(v interface{}) bool { return f(v.(TimestampedInt)) }).TimestampedInt(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *TimestampedIntStream) Do(f func(next TimestampedInt)) *TimestampedIntStream {
+	return FromTimestampedIntObservable(MapTimestampedInt2TimestampedIntObserveNext(s, func(next TimestampedInt) TimestampedInt {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *TimestampedIntStream) DoOnError(f func(err error)) *TimestampedIntStream {
+	return FromTimestampedIntObservable(MapTimestampedInt2TimestampedIntObserveDirect(s, func(next TimestampedInt, err error, complete bool, observer TimestampedIntObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughTimestampedInt(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *TimestampedIntStream) DoOnComplete(f func()) *TimestampedIntStream {
+	return FromTimestampedIntObservable(MapTimestampedInt2TimestampedIntObserveDirect(s, func(next TimestampedInt, err error, complete bool, observer TimestampedIntObserver) {
+		if complete {
+			f()
+		}
+		PassthroughTimestampedInt(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *TimestampedIntStream) ToOneWithError() (TimestampedInt, error) {
+	valuech := make(chan TimestampedInt, 1)
+	errch := make(chan error, 1)
+	FromTimestampedIntObservable(oneFilter().TimestampedInt(s)).SubscribeFunc(func(next TimestampedInt, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroTimestampedInt, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *TimestampedIntStream) ToOne() TimestampedInt {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *TimestampedIntStream) ToArrayWithError() ([]TimestampedInt, error) {
+	array := []TimestampedInt{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next TimestampedInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *TimestampedIntStream) ToArray() []TimestampedInt {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *TimestampedIntStream) ToChannelWithError() (<-chan TimestampedInt, <-chan error) {
+	ch := make(chan TimestampedInt, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next TimestampedInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *TimestampedIntStream) ToChannel() <-chan TimestampedInt {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *TimestampedIntStream) Seq() iter.Seq[TimestampedInt] {
+	return func(yield func(TimestampedInt) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *TimestampedIntStream) Seq2() iter.Seq2[TimestampedInt, error] {
+	return func(yield func(TimestampedInt, error) bool) {
+		type notification struct {
+			next     TimestampedInt
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next TimestampedInt, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroTimestampedInt, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapTimestampedInt2Response struct {
+	parent TimestampedIntObservable
+	f      func(TimestampedInt) *http.Response
+}
+
+func (m *mapTimestampedInt2Response) Subscribe(observer ResponseObserver) Subscription {
+	return m.parent.Subscribe(TimestampedIntObserverFunc(func(next TimestampedInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapResponse maps this stream to an ResponseStream via f.
+func (s *TimestampedIntStream) MapResponse(f func(TimestampedInt) *http.Response) *ResponseStream {
+	return &ResponseStream{&mapTimestampedInt2Response{s, f}}
+}
+
+type mapTimestampedInt2String struct {
+	parent TimestampedIntObservable
+	f      func(TimestampedInt) string
+}
+
+func (m *mapTimestampedInt2String) Subscribe(observer StringObserver) Subscription {
+	return m.parent.Subscribe(TimestampedIntObserverFunc(func(next TimestampedInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapString maps this stream to an StringStream via f.
+func (s *TimestampedIntStream) MapString(f func(TimestampedInt) string) *StringStream {
+	return &StringStream{&mapTimestampedInt2String{s, f}}
+}
+
+type mapTimestampedInt2Bool struct {
+	parent TimestampedIntObservable
+	f      func(TimestampedInt) bool
+}
+
+func (m *mapTimestampedInt2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(TimestampedIntObserverFunc(func(next TimestampedInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *TimestampedIntStream) MapBool(f func(TimestampedInt) bool) *BoolStream {
+	return &BoolStream{&mapTimestampedInt2Bool{s, f}}
+}
+
+type MappingTimestampedInt2TimestampedIntFunc func(next TimestampedInt, err error, complete bool, observer TimestampedIntObserver)
+type MappingTimestampedInt2TimestampedIntFuncFactory func(observer TimestampedIntObserver) MappingTimestampedInt2TimestampedIntFunc
+
+type MappingTimestampedInt2TimestampedIntObservable struct {
+	parent TimestampedIntObservable
+	mapper MappingTimestampedInt2TimestampedIntFuncFactory
+}
+
+func (f *MappingTimestampedInt2TimestampedIntObservable) Subscribe(observer TimestampedIntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(TimestampedIntObserverFunc(func(next TimestampedInt, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapTimestampedInt2TimestampedIntObservable(parent TimestampedIntObservable, mapper MappingTimestampedInt2TimestampedIntFuncFactory) TimestampedIntObservable {
+	return &MappingTimestampedInt2TimestampedIntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapTimestampedInt2TimestampedIntObserveDirect(parent TimestampedIntObservable, mapper MappingTimestampedInt2TimestampedIntFunc) TimestampedIntObservable {
+	return MapTimestampedInt2TimestampedIntObservable(parent, func(TimestampedIntObserver) MappingTimestampedInt2TimestampedIntFunc {
+		return mapper
+	})
+}
+
+func MapTimestampedInt2TimestampedIntObserveNext(parent TimestampedIntObservable, mapper func(TimestampedInt) TimestampedInt) TimestampedIntObservable {
+	return MapTimestampedInt2TimestampedIntObservable(parent, func(TimestampedIntObserver) MappingTimestampedInt2TimestampedIntFunc {
+		return func(next TimestampedInt, err error, complete bool, observer TimestampedIntObserver) {
+			var mapped TimestampedInt
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughTimestampedInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *TimestampedIntStream) Map(f func(TimestampedInt) TimestampedInt) *TimestampedIntStream {
+	return FromTimestampedIntObservable(MapTimestampedInt2TimestampedIntObserveNext(s, f))
+}
+
+type MappingTimestampedInt2IntFunc func(next TimestampedInt, err error, complete bool, observer IntObserver)
+type MappingTimestampedInt2IntFuncFactory func(observer IntObserver) MappingTimestampedInt2IntFunc
+
+type MappingTimestampedInt2IntObservable struct {
+	parent TimestampedIntObservable
+	mapper MappingTimestampedInt2IntFuncFactory
+}
+
+func (f *MappingTimestampedInt2IntObservable) Subscribe(observer IntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(TimestampedIntObserverFunc(func(next TimestampedInt, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapTimestampedInt2IntObservable(parent TimestampedIntObservable, mapper MappingTimestampedInt2IntFuncFactory) IntObservable {
+	return &MappingTimestampedInt2IntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapTimestampedInt2IntObserveDirect(parent TimestampedIntObservable, mapper MappingTimestampedInt2IntFunc) IntObservable {
+	return MapTimestampedInt2IntObservable(parent, func(IntObserver) MappingTimestampedInt2IntFunc {
+		return mapper
+	})
+}
+
+func MapTimestampedInt2IntObserveNext(parent TimestampedIntObservable, mapper func(TimestampedInt) int) IntObservable {
+	return MapTimestampedInt2IntObservable(parent, func(IntObserver) MappingTimestampedInt2IntFunc {
+		return func(next TimestampedInt, err error, complete bool, observer IntObserver) {
+			var mapped int
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapTimestampedInt2Int struct {
+	parent TimestampedIntObservable
+	mapper func(TimestampedInt) IntObservable
+}
+
+func (f *flatMapTimestampedInt2Int) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(TimestampedIntObserverFunc(func(next TimestampedInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&IntStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &IntStream{ignoreCompletionFilter().Int(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *TimestampedIntStream) MapInt(f func(TimestampedInt) int) *IntStream {
+	return FromIntObservable(MapTimestampedInt2IntObserveNext(s, f))
+}
+
+func (s *TimestampedIntStream) FlatMapInt(f func(TimestampedInt) IntObservable) *IntStream {
+	return &IntStream{&flatMapTimestampedInt2Int{s, f}}
+}
+
+type IntervalIntObserver interface {
+	Next(IntervalInt)
+	TerminationObserver
+}
+
+// A IntervalIntSubscriber represents a subscribed IntervalIntObserver.
+type IntervalIntSubscriber interface {
+	Subscription
+	IntervalIntObserver
+}
+
+type implIntervalIntSubscriber struct {
+	Subscription
+	IntervalIntObserver
+}
+
+func IntervalIntObserverAsGenericObserver(observer IntervalIntObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(IntervalInt))
+		}
+	})
+}
+
+func GenericObserverAsIntervalIntObserver(observer GenericObserver) IntervalIntObserver {
+	return IntervalIntObserverFunc(func(next IntervalInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type IntervalIntObservableFactory func(observer IntervalIntObserver, subscription Subscription)
+
+func (f IntervalIntObservableFactory) Subscribe(observer IntervalIntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateIntervalInt calls f(observer, subscription) to produce values for a stream.
+func CreateIntervalInt(f func(observer IntervalIntObserver, subscription Subscription)) *IntervalIntStream {
+	return FromIntervalIntObservable(IntervalIntObservableFactory(f))
+}
+func PassthroughIntervalInt(next IntervalInt, err error, complete bool, observer IntervalIntObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroIntervalInt = *new(IntervalInt)
+
+type IntervalIntObserverFunc func(IntervalInt, error, bool)
+
+func (f IntervalIntObserverFunc) Next(next IntervalInt) { f(next, nil, false) }
+func (f IntervalIntObserverFunc) Error(err error)       { f(zeroIntervalInt, err, false) }
+func (f IntervalIntObserverFunc) Complete()             { f(zeroIntervalInt, nil, true) }
+
+type IntervalIntObservable interface {
+	Subscribe(IntervalIntObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a IntervalIntObservable
+func (f GenericObservableFilterFactory) IntervalInt(parent IntervalIntObservable) IntervalIntObservable {
+	return MapIntervalInt2IntervalIntObservable(parent, func(observer IntervalIntObserver) MappingIntervalInt2IntervalIntFunc {
+		gobserver := IntervalIntObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next IntervalInt, err error, complete bool, observer IntervalIntObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromIntervalIntArray(array []IntervalInt) *IntervalIntStream {
+	return CreateIntervalInt(func(observer IntervalIntObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromIntervalInts(array ...IntervalInt) *IntervalIntStream {
+	return FromIntervalIntArray(array)
+}
+
+func FromIntervalIntChannel(ch <-chan IntervalInt) *IntervalIntStream {
+	return CreateIntervalInt(func(observer IntervalIntObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type IntervalIntStream struct {
+	IntervalIntObservable
+}
+
+func FromIntervalIntObservable(observable IntervalIntObservable) *IntervalIntStream {
+	return &IntervalIntStream{observable}
+}
+
+func (s *IntervalIntStream) SubscribeFunc(f func(IntervalInt, error, bool)) Subscription {
+	return s.Subscribe(IntervalIntObserverFunc(f))
+}
+
+func (s *IntervalIntStream) SubscribeNext(f func(v IntervalInt)) Subscription {
+	return s.SubscribeFunc(func(next IntervalInt, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *IntervalIntStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsIntervalIntObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *IntervalIntStream) Filter(f func(IntervalInt) bool) *IntervalIntStream {
+	return FromIntervalIntObservable(filterFilter(func(v interface{}) bool { return f(v.(IntervalInt)) }).IntervalInt(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *IntervalIntStream) Do(f func(next IntervalInt)) *IntervalIntStream {
+	return FromIntervalIntObservable(MapIntervalInt2IntervalIntObserveNext(s, func(next IntervalInt) IntervalInt {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *IntervalIntStream) DoOnError(f func(err error)) *IntervalIntStream {
+	return FromIntervalIntObservable(MapIntervalInt2IntervalIntObserveDirect(s, func(next IntervalInt, err error, complete bool, observer IntervalIntObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughIntervalInt(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *IntervalIntStream) DoOnComplete(f func()) *IntervalIntStream {
+	return FromIntervalIntObservable(MapIntervalInt2IntervalIntObserveDirect(s, func(next IntervalInt, err error, complete bool, observer IntervalIntObserver) {
+		if complete {
+			f()
+		}
+		PassthroughIntervalInt(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *IntervalIntStream) ToOneWithError() (IntervalInt, error) {
+	valuech := make(chan IntervalInt, 1)
+	errch := make(chan error, 1)
+	FromIntervalIntObservable(oneFilter().IntervalInt(s)).SubscribeFunc(func(next IntervalInt, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroIntervalInt, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *IntervalIntStream) ToOne() IntervalInt {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *IntervalIntStream) ToArrayWithError() ([]IntervalInt, error) {
+	array := []IntervalInt{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntervalInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *IntervalIntStream) ToArray() []IntervalInt {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *IntervalIntStream) ToChannelWithError() (<-chan IntervalInt, <-chan error) {
+	ch := make(chan IntervalInt, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next IntervalInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *IntervalIntStream) ToChannel() <-chan IntervalInt {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *IntervalIntStream) Seq() iter.Seq[IntervalInt] {
+	return func(yield func(IntervalInt) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *IntervalIntStream) Seq2() iter.Seq2[IntervalInt, error] {
+	return func(yield func(IntervalInt, error) bool) {
+		type notification struct {
+			next     IntervalInt
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next IntervalInt, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroIntervalInt, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapIntervalInt2Response struct {
+	parent IntervalIntObservable
+	f      func(IntervalInt) *http.Response
+}
+
+func (m *mapIntervalInt2Response) Subscribe(observer ResponseObserver) Subscription {
+	return m.parent.Subscribe(IntervalIntObserverFunc(func(next IntervalInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapResponse maps this stream to an ResponseStream via f.
+func (s *IntervalIntStream) MapResponse(f func(IntervalInt) *http.Response) *ResponseStream {
+	return &ResponseStream{&mapIntervalInt2Response{s, f}}
+}
+
+type mapIntervalInt2String struct {
+	parent IntervalIntObservable
+	f      func(IntervalInt) string
+}
+
+func (m *mapIntervalInt2String) Subscribe(observer StringObserver) Subscription {
+	return m.parent.Subscribe(IntervalIntObserverFunc(func(next IntervalInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapString maps this stream to an StringStream via f.
+func (s *IntervalIntStream) MapString(f func(IntervalInt) string) *StringStream {
+	return &StringStream{&mapIntervalInt2String{s, f}}
+}
+
+type mapIntervalInt2Bool struct {
+	parent IntervalIntObservable
+	f      func(IntervalInt) bool
+}
+
+func (m *mapIntervalInt2Bool) Subscribe(observer BoolObserver) Subscription {
+	return m.parent.Subscribe(IntervalIntObserverFunc(func(next IntervalInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *IntervalIntStream) MapBool(f func(IntervalInt) bool) *BoolStream {
+	return &BoolStream{&mapIntervalInt2Bool{s, f}}
+}
+
+type MappingIntervalInt2IntervalIntFunc func(next IntervalInt, err error, complete bool, observer IntervalIntObserver)
+type MappingIntervalInt2IntervalIntFuncFactory func(observer IntervalIntObserver) MappingIntervalInt2IntervalIntFunc
+
+type MappingIntervalInt2IntervalIntObservable struct {
+	parent IntervalIntObservable
+	mapper MappingIntervalInt2IntervalIntFuncFactory
+}
+
+func (f *MappingIntervalInt2IntervalIntObservable) Subscribe(observer IntervalIntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntervalIntObserverFunc(func(next IntervalInt, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapIntervalInt2IntervalIntObservable(parent IntervalIntObservable, mapper MappingIntervalInt2IntervalIntFuncFactory) IntervalIntObservable {
+	return &MappingIntervalInt2IntervalIntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntervalInt2IntervalIntObserveDirect(parent IntervalIntObservable, mapper MappingIntervalInt2IntervalIntFunc) IntervalIntObservable {
+	return MapIntervalInt2IntervalIntObservable(parent, func(IntervalIntObserver) MappingIntervalInt2IntervalIntFunc {
+		return mapper
+	})
+}
+
+func MapIntervalInt2IntervalIntObserveNext(parent IntervalIntObservable, mapper func(IntervalInt) IntervalInt) IntervalIntObservable {
+	return MapIntervalInt2IntervalIntObservable(parent, func(IntervalIntObserver) MappingIntervalInt2IntervalIntFunc {
+		return func(next IntervalInt, err error, complete bool, observer IntervalIntObserver) {
+			var mapped IntervalInt
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntervalInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *IntervalIntStream) Map(f func(IntervalInt) IntervalInt) *IntervalIntStream {
+	return FromIntervalIntObservable(MapIntervalInt2IntervalIntObserveNext(s, f))
+}
+
+type MappingIntervalInt2IntFunc func(next IntervalInt, err error, complete bool, observer IntObserver)
+type MappingIntervalInt2IntFuncFactory func(observer IntObserver) MappingIntervalInt2IntFunc
+
+type MappingIntervalInt2IntObservable struct {
+	parent IntervalIntObservable
+	mapper MappingIntervalInt2IntFuncFactory
+}
+
+func (f *MappingIntervalInt2IntObservable) Subscribe(observer IntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(IntervalIntObserverFunc(func(next IntervalInt, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapIntervalInt2IntObservable(parent IntervalIntObservable, mapper MappingIntervalInt2IntFuncFactory) IntObservable {
+	return &MappingIntervalInt2IntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapIntervalInt2IntObserveDirect(parent IntervalIntObservable, mapper MappingIntervalInt2IntFunc) IntObservable {
+	return MapIntervalInt2IntObservable(parent, func(IntObserver) MappingIntervalInt2IntFunc {
+		return mapper
+	})
+}
+
+func MapIntervalInt2IntObserveNext(parent IntervalIntObservable, mapper func(IntervalInt) int) IntObservable {
+	return MapIntervalInt2IntObservable(parent, func(IntObserver) MappingIntervalInt2IntFunc {
+		return func(next IntervalInt, err error, complete bool, observer IntObserver) {
+			var mapped int
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapIntervalInt2Int struct {
+	parent IntervalIntObservable
+	mapper func(IntervalInt) IntObservable
+}
+
+func (f *flatMapIntervalInt2Int) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(IntervalIntObserverFunc(func(next IntervalInt, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&IntStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &IntStream{ignoreCompletionFilter().Int(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *IntervalIntStream) MapInt(f func(IntervalInt) int) *IntStream {
+	return FromIntObservable(MapIntervalInt2IntObserveNext(s, f))
+}
+
+func (s *IntervalIntStream) FlatMapInt(f func(IntervalInt) IntObservable) *IntStream {
+	return &IntStream{&flatMapIntervalInt2Int{s, f}}
+}
+
+type BoolObserver interface {
+	Next(bool)
+	TerminationObserver
+}
+
+// A BoolSubscriber represents a subscribed BoolObserver.
+type BoolSubscriber interface {
+	Subscription
+	BoolObserver
+}
+
+type implBoolSubscriber struct {
+	Subscription
+	BoolObserver
+}
+
+func BoolObserverAsGenericObserver(observer BoolObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(bool))
+		}
+	})
+}
+
+func GenericObserverAsBoolObserver(observer GenericObserver) BoolObserver {
+	return BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type BoolObservableFactory func(observer BoolObserver, subscription Subscription)
+
+func (f BoolObservableFactory) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateBool calls f(observer, subscription) to produce values for a stream.
+func CreateBool(f func(observer BoolObserver, subscription Subscription)) *BoolStream {
+	return FromBoolObservable(BoolObservableFactory(f))
+}
+
+// Repeat value count times.
+func RepeatBool(value bool, count int) *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {
+		for i := 0; i < count; i++ {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(value)
+		}
+		observer.Complete()
+	})
+}
+
+// StartBool is designed to be used with functions that return a
+// (bool, error) tuple.
+//
+// If the error is non-nil the returned BoolStream will be that error,
+// otherwise it will be a single-value stream of bool.
+func StartBool(f func() (bool, error)) *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {
+		if v, err := f(); err != nil {
+			observer.Error(err)
+		} else {
+			observer.Next(v)
+			observer.Complete()
+		}
+	})
+}
+
+type deferBoolObservable func() BoolObservable
+
+func (f deferBoolObservable) Subscribe(observer BoolObserver) Subscription {
+	return f().Subscribe(observer)
+}
+
+// DeferBool calls f to create a fresh observable for each subscription.
+func DeferBool(f func() BoolObservable) *BoolStream {
+	return FromBoolObservable(deferBoolObservable(f))
+}
+func PassthroughBool(next bool, err error, complete bool, observer BoolObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroBool = *new(bool)
+
+type BoolObserverFunc func(bool, error, bool)
+
+func (f BoolObserverFunc) Next(next bool)  { f(next, nil, false) }
+func (f BoolObserverFunc) Error(err error) { f(zeroBool, err, false) }
+func (f BoolObserverFunc) Complete()       { f(zeroBool, nil, true) }
+
+type BoolObservable interface {
+	Subscribe(BoolObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a BoolObservable
+func (f GenericObservableFilterFactory) Bool(parent BoolObservable) BoolObservable {
+	return MapBool2BoolObservable(parent, func(observer BoolObserver) MappingBool2BoolFunc {
+		gobserver := BoolObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next bool, err error, complete bool, observer BoolObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func NeverBool() *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {})
+}
+
+func EmptyBool() *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {
+		observer.Complete()
+	})
+}
+
+func ThrowBool(err error) *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {
+		observer.Error(err)
+	})
+}
+func FromBoolArray(array []bool) *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromBools(array ...bool) *BoolStream {
+	return FromBoolArray(array)
+}
+
+// FromBoolSeq emits each value yielded by seq, iterating it once per
+// subscription.
+func FromBoolSeq(seq iter.Seq[bool]) *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {
+		for v := range seq {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+func JustBool(element bool) *BoolStream {
+	return FromBoolArray([]bool{element})
+}
+
+func MergeBool(observables ...BoolObservable) *BoolStream {
+	if len(observables) == 0 {
+		return EmptyBool()
+	}
+	return (&BoolStream{observables[0]}).Merge(observables[1:]...)
+}
+
+func MergeBoolDelayError(observables ...BoolObservable) *BoolStream {
+	if len(observables) == 0 {
+		return EmptyBool()
+	}
+	return (&BoolStream{observables[0]}).MergeDelayError(observables[1:]...)
+}
+
+type ambBoolObservable []BoolObservable
+
+func (a ambBoolObservable) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	winner := -1
+	subscriptions := make([]*LinkedSubscription, len(a))
+	for i := range subscriptions {
+		subscriptions[i] = NewLinkedSubscription()
+	}
+	for i, observable := range a {
+		i := i
+		subscriptions[i].Link(observable.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+			lock.Lock()
+			if winner == -1 {
+				winner = i
+				for j, subscription := range subscriptions {
+					if j != i {
+						subscription.Dispose()
+					}
+				}
+			}
+			won := winner == i
+			lock.Unlock()
+			if won {
+				PassthroughBool(next, err, complete, observer)
+			}
+		})))
+	}
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		for _, s := range subscriptions {
+			s.Dispose()
+		}
+	})
+	return subscription
+}
+
+// AmbBool subscribes to all observables and mirrors whichever emits
+// first, disposing the others.
+func AmbBool(observables ...BoolObservable) *BoolStream {
+	if len(observables) == 0 {
+		return EmptyBool()
+	}
+	return FromBoolObservable(ambBoolObservable(observables))
+}
+func FromBoolChannel(ch <-chan bool) *BoolStream {
+	return CreateBool(func(observer BoolObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type BoolStream struct {
+	BoolObservable
+}
+
+func FromBoolObservable(observable BoolObservable) *BoolStream {
+	return &BoolStream{observable}
+}
+
+func (s *BoolStream) SubscribeFunc(f func(bool, error, bool)) Subscription {
+	return s.Subscribe(BoolObserverFunc(f))
+}
+
+func (s *BoolStream) SubscribeNext(f func(v bool)) Subscription {
+	return s.SubscribeFunc(func(next bool, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *BoolStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsBoolObserver(observer))
+}
+
+// Distinct removes duplicate elements in the stream. Values that can not be
+// map keys, such as slices, are compared with reflect.DeepEqual.
+func (s *BoolStream) Distinct() *BoolStream {
+	return FromBoolObservable(distinctFilter().Bool(s))
+}
+
+// ElementAt yields the Nth element of the stream.
+func (s *BoolStream) ElementAt(n int) *BoolStream {
+	return FromBoolObservable(elementAtFilter(n).Bool(s))
+}
+
+// Filter elements in the stream on a function.
+func (s *BoolStream) Filter(f func(bool) bool) *BoolStream {
+	return FromBoolObservable(filterFilter(func(v interface{}) bool { return f(v.(bool)) }).Bool(s))
+}
+
+// Last returns just the first element of the stream.
+func (s *BoolStream) First() *BoolStream {
+	return FromBoolObservable(firstFilter().Bool(s))
+}
+
+// Last returns just the last element of the stream.
+func (s *BoolStream) Last() *BoolStream {
+	return FromBoolObservable(lastFilter().Bool(s))
+}
+
+// SkipLast skips the first N elements of the stream.
+func (s *BoolStream) Skip(n int) *BoolStream {
+	return FromBoolObservable(skipFilter(n).Bool(s))
+}
+
+// SkipLast skips the last N elements of the stream.
+func (s *BoolStream) SkipLast(n int) *BoolStream {
+	return FromBoolObservable(skipLastFilter(n).Bool(s))
+}
+
+// Take returns just the first N elements of the stream.
+func (s *BoolStream) Take(n int) *BoolStream {
+	return FromBoolObservable(takeFilter(n).Bool(s))
+}
+
+// TakeLast returns just the last N elements of the stream.
+func (s *BoolStream) TakeLast(n int) *BoolStream {
+	return FromBoolObservable(takeLastFilter(n).Bool(s))
+}
+
+type takeWhileBool struct {
+	parent BoolObservable
+	f      func(bool) bool
+}
+
+func (t *takeWhileBool) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	parent.Link(t.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Complete()
+		case t.f(next):
+			observer.Next(next)
+		default:
+			done = true
+			observer.Complete()
+			parent.Dispose()
+		}
+	})))
+	return parent
+}
+
+// TakeWhile returns elements of the stream until f returns false, then
+// completes and disposes the stream.
+func (s *BoolStream) TakeWhile(f func(bool) bool) *BoolStream {
+	return &BoolStream{&takeWhileBool{s, f}}
+}
+
+// SkipWhile skips elements of the stream until f returns false.
+func (s *BoolStream) SkipWhile(f func(bool) bool) *BoolStream {
+	return FromBoolObservable(MapBool2BoolObservable(s, func(BoolObserver) MappingBool2BoolFunc {
+		skipping := true
+		return func(next bool, err error, complete bool, observer BoolObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				if skipping && !f(next) {
+					skipping = false
+				}
+				if !skipping {
+					observer.Next(next)
+				}
+			}
+		}
+	}))
+}
+
+// IgnoreElements ignores elements of the stream and emits only the completion events.
+func (s *BoolStream) IgnoreElements() *BoolStream {
+	return FromBoolObservable(ignoreElementsFilter().Bool(s))
+}
+
+func (s *BoolStream) Replay(size int, duration time.Duration) *BoolStream {
+	return FromBoolObservable(replayFilter(size, duration).Bool(s))
+}
+
+func (s *BoolStream) Sample(duration time.Duration) *BoolStream {
+	return FromBoolObservable(sampleFilter(duration).Bool(s))
+}
+
+func (s *BoolStream) Debounce(duration time.Duration) *BoolStream {
+	return FromBoolObservable(debounceFilter(duration).Bool(s))
+}
+
+type delayBool struct {
+	parent     BoolObservable
+	delay      func(bool) time.Duration
+	completion time.Duration
+}
+
+func (d *delayBool) Subscribe(observer BoolObserver) Subscription {
+	queue := newDelayQueue(BoolObserverAsGenericObserver(observer))
+	parent := d.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			queue.error(err)
+		case complete:
+			queue.enqueue(delayedEntry{complete: true}, d.completion)
+		default:
+			queue.enqueue(delayedEntry{next: next}, d.delay(next))
+		}
+	}))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		queue.dispose()
+	})
+	return subscription
+}
+
+// Delay shifts each value, and completion, forward in time by duration. Errors
+// are not delayed. Values that are still pending are discarded on disposal.
+func (s *BoolStream) Delay(duration time.Duration) *BoolStream {
+	return &BoolStream{&delayBool{s, func(bool) time.Duration { return duration }, duration}}
+}
+
+// DelayWhen shifts each value forward in time by the duration returned by f.
+// Values are never reordered, so a value is emitted no earlier than the value
+// before it. Completion is emitted after the last value. Errors are not delayed.
+func (s *BoolStream) DelayWhen(f func(bool) time.Duration) *BoolStream {
+	return &BoolStream{&delayBool{s, f, 0}}
+}
+
+// Wait for completion of the stream and return any error.
+func (s *BoolStream) Wait() error {
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			errch <- nil
+		default:
+		}
+	})
+	return <-errch
+}
+
+func MakeBoolSubscriber(observer BoolObserver) BoolSubscriber {
+	if subscriber, ok := observer.(BoolSubscriber); ok {
+		return subscriber
+	}
+	return &implBoolSubscriber{NewGenericSubscription(), observer}
+}
+
+type concatBoolSubscriber struct {
+	observable  int
+	observer    BoolObserver
+	observables []BoolObservable
+	Subscription
+}
+
+func (c *concatBoolSubscriber) Next(next bool) {
+	c.observer.Next(next)
+}
+
+func (c *concatBoolSubscriber) Error(err error) {
+	c.observer.Error(err)
+	c.observable = len(c.observables)
+	c.Dispose()
+}
+
+func (c *concatBoolSubscriber) Complete() {
+	c.observable++
+	if c.observable >= len(c.observables) {
+		c.observer.Complete()
+		c.Dispose()
+		return
+	}
+	c.observables[c.observable].Subscribe(c)
+}
+
+type concatBoolObservable struct {
+	observables []BoolObservable
+}
+
+func (m *concatBoolObservable) Subscribe(observer BoolObserver) Subscription {
+	if len(m.observables) == 0 {
+		observer.Complete()
+		return ClosedSubscription
+	}
+	subscriber := &concatBoolSubscriber{
+		observer:     observer,
+		Subscription: NewGenericSubscription(),
+		observables:  m.observables,
+	}
+	m.observables[0].Subscribe(subscriber)
+	return subscriber
+}
+
+func (s *BoolStream) Concat(observables ...BoolObservable) *BoolStream {
+	return &BoolStream{&concatBoolObservable{append([]BoolObservable{s}, observables...)}}
+}
+
+// StartWith emits values before the values of the stream.
+func (s *BoolStream) StartWith(values ...bool) *BoolStream {
+	return FromBoolArray(values).Concat(s)
+}
+
+// EndWith emits values after the stream completes.
+func (s *BoolStream) EndWith(values ...bool) *BoolStream {
+	return s.Concat(FromBoolArray(values))
+}
+
+// DefaultIfEmpty emits value if the stream completes without emitting any values.
+func (s *BoolStream) DefaultIfEmpty(value bool) *BoolStream {
+	return FromBoolObservable(MapBool2BoolObservable(s, func(BoolObserver) MappingBool2BoolFunc {
+		empty := true
+		return func(next bool, err error, complete bool, observer BoolObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				if empty {
+					observer.Next(value)
+				}
+				observer.Complete()
+			default:
+				empty = false
+				observer.Next(next)
+			}
+		}
+	}))
+}
+
+type switchIfEmptyBoolObservable struct {
+	parent BoolObservable
+	other  BoolObservable
+}
+
+func (e *switchIfEmptyBoolObservable) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
+	empty := true
+	link.Link(e.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			if !empty {
+				observer.Complete()
+			} else if !subscription.Disposed() {
+				subscription.Set(e.other.Subscribe(observer))
+			}
+		default:
+			empty = false
+			observer.Next(next)
+		}
+	})))
+	return subscription
+}
+
+// SwitchIfEmpty switches to other if the stream completes without emitting any values.
+func (s *BoolStream) SwitchIfEmpty(other BoolObservable) *BoolStream {
+	return &BoolStream{&switchIfEmptyBoolObservable{s, other}}
+}
+
+type mergeBoolObservable struct {
+	delayError  bool
+	observables []BoolObservable
+}
+
+func (m *mergeBoolObservable) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	lock := sync.Mutex{}
+	completed := 0
+	var firstError error
+	relay := func(next bool, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if completed >= len(m.observables) {
+			return
+		}
+
+		switch {
+		case err != nil:
+			if m.delayError {
+				firstError = err
+				completed++
+			} else {
+				observer.Error(err)
+				completed = len(m.observables)
+			}
+
+		case complete:
+			completed++
+			if completed == len(m.observables) {
+				if firstError != nil {
+					observer.Error(firstError)
+				} else {
+					observer.Complete()
+				}
+			}
+		default:
+			observer.Next(next)
+		}
+	}
+	for _, observable := range m.observables {
+		observable.Subscribe(BoolObserverFunc(relay))
+	}
+	return subscription
+}
+
+// Merge an arbitrary number of observables with this one.
+// An error from any of the observables will terminate the merged stream.
+func (s *BoolStream) Merge(other ...BoolObservable) *BoolStream {
+	if len(other) == 0 {
+		return s
+	}
+	return &BoolStream{&mergeBoolObservable{false, append(other, s)}}
+}
+
+// Merge an arbitrary number of observables with this one.
+// Any error will be deferred until all observables terminate.
+func (s *BoolStream) MergeDelayError(other ...BoolObservable) *BoolStream {
+	if len(other) == 0 {
+		return s
+	}
+	return &BoolStream{&mergeBoolObservable{true, append(other, s)}}
+}
+
+// Amb mirrors whichever of this stream and others emits first, disposing the rest.
+func (s *BoolStream) Amb(others ...BoolObservable) *BoolStream {
+	return AmbBool(append([]BoolObservable{s}, others...)...)
+}
+
+type catchBoolObservable struct {
+	parent BoolObservable
+	catch  func(err error) BoolObservable
+	// Also switch to the fallback when the parent completes. err will be nil.
+	resume bool
+}
+
+func (r *catchBoolObservable) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewSerialSubscription()
+	link := NewLinkedSubscription()
+	subscription.Set(link)
+	run := func(next bool, err error, complete bool) {
+		switch {
+		case err != nil || (complete && r.resume):
+			if !subscription.Disposed() {
+				subscription.Set(r.catch(err).Subscribe(observer))
+			}
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	}
+	link.Link(r.parent.Subscribe(BoolObserverFunc(run)))
+	return subscription
+}
+
+// Catch switches to the catch observable if the stream errors.
+func (s *BoolStream) Catch(catch BoolObservable) *BoolStream {
+	return s.CatchFunc(func(error) BoolObservable { return catch })
+}
+
+// CatchFunc switches to the observable returned by f(err) if the stream errors.
+func (s *BoolStream) CatchFunc(f func(err error) BoolObservable) *BoolStream {
+	return &BoolStream{&catchBoolObservable{parent: s, catch: f}}
+}
+
+// OnErrorReturn emits the single value returned by f(err) and completes if the stream errors.
+func (s *BoolStream) OnErrorReturn(f func(err error) bool) *BoolStream {
+	return s.CatchFunc(func(err error) BoolObservable { return JustBool(f(err)) })
+}
+
+// OnErrorResumeNext switches to next when the stream terminates, whether it
+// completes or errors.
+func (s *BoolStream) OnErrorResumeNext(next BoolObservable) *BoolStream {
+	return &BoolStream{&catchBoolObservable{
+		parent: s,
+		catch:  func(error) BoolObservable { return next },
+		resume: true,
+	}}
+}
+
+type retryBoolObservable struct {
+	observable BoolObservable
+	policy     RetryPolicy
+}
+
+func (r *retryBoolObservable) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewSerialSubscription()
+	attempt := 0
+	var subscribe func()
+	subscribe = func() {
+		// Set the link before subscribing, in case the observable fails
+		// and is resubscribed before Subscribe returns.
+		link := NewLinkedSubscription()
+		subscription.Set(link)
+		link.Link(r.observable.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+			switch {
+			case err != nil:
+				if subscription.Disposed() {
+					return
+				}
+				attempt++
+				delay, ok := r.policy(err, attempt)
+				switch {
+				case !ok:
+					observer.Error(err)
+				case delay > 0:
+					time.AfterFunc(delay, func() {
+						if !subscription.Disposed() {
+							subscribe()
+						}
+					})
+				default:
+					subscribe()
+				}
+			case complete:
+				observer.Complete()
+			default:
+				attempt = 0
+				observer.Next(next)
+			}
+		})))
+	}
+	subscribe()
+	return subscription
+}
+
+// Retry resubscribes to the stream immediately, and indefinitely, on error.
+func (s *BoolStream) Retry() *BoolStream {
+	return s.RetryWhen(func(error, int) (time.Duration, bool) { return 0, true })
+}
+
+// RetryN resubscribes to the stream on error, at most n times in a row without
+// the stream emitting a value in between.
+func (s *BoolStream) RetryN(n int) *BoolStream {
+	return s.RetryWhen(func(err error, attempt int) (time.Duration, bool) { return 0, attempt <= n })
+}
+
+// RetryWhen calls policy on each error to decide whether, and after how long,
+// to resubscribe to the stream. attempt counts consecutive errors, starting at
+// 1 and reset whenever the stream emits a value.
+func (s *BoolStream) RetryWhen(policy func(err error, attempt int) (time.Duration, bool)) *BoolStream {
+	return &BoolStream{&retryBoolObservable{s, policy}}
+}
+
+// RetryWithBackoff resubscribes to the stream on error, indefinitely, with
+// an ExponentialBackoff delay. The delay is reset to initial whenever the
+// stream emits a value.
+func (s *BoolStream) RetryWithBackoff(initial, max time.Duration, multiplier, jitter float64) *BoolStream {
+	return s.RetryWhen(ExponentialBackoff(initial, max, multiplier, jitter))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *BoolStream) Do(f func(next bool)) *BoolStream {
+	return FromBoolObservable(MapBool2BoolObserveNext(s, func(next bool) bool {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *BoolStream) DoOnError(f func(err error)) *BoolStream {
+	return FromBoolObservable(MapBool2BoolObserveDirect(s, func(next bool, err error, complete bool, observer BoolObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughBool(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *BoolStream) DoOnComplete(f func()) *BoolStream {
+	return FromBoolObservable(MapBool2BoolObserveDirect(s, func(next bool, err error, complete bool, observer BoolObserver) {
+		if complete {
+			f()
+		}
+		PassthroughBool(next, err, complete, observer)
+	}))
+}
+func (s *BoolStream) Reduce(initial bool, reducer func(bool, bool) bool) *BoolStream {
+	value := initial
+	return FromBoolObservable(MapBool2BoolObserveDirect(s, func(next bool, err error, complete bool, observer BoolObserver) {
+		switch {
+		case err != nil:
+			observer.Next(value)
+			observer.Error(err)
+		case complete:
+			observer.Next(value)
+			observer.Complete()
+		default:
+			value = reducer(value, next)
+		}
+	}))
+}
+
+func (s *BoolStream) Scan(initial bool, f func(bool, bool) bool) *BoolStream {
+	value := initial
+	return FromBoolObservable(MapBool2BoolObserveDirect(s, func(next bool, err error, complete bool, observer BoolObserver) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			value = f(value, next)
+			observer.Next(value)
+		}
+	}))
+}
+
+type timeoutBool struct {
+	parent  BoolObservable
+	timeout time.Duration
+}
+
+func (t *timeoutBool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewChannelSubscription()
+	cancel := t.parent.Subscribe(observer)
+	go func() {
+		select {
+		case <-time.After(t.timeout):
+			observer.Error(ErrTimeout)
+			cancel.Dispose()
+			subscription.Dispose()
+		case <-subscription:
+			cancel.Dispose()
+		}
+	}()
+	return subscription
+}
+
+func (s *BoolStream) Timeout(timeout time.Duration) *BoolStream {
+	return &BoolStream{&timeoutBool{s, timeout}}
+}
+
+type delaySubscriptionBool struct {
+	parent BoolObservable
+	delay  time.Duration
+}
+
+func (d *delaySubscriptionBool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewLinkedSubscription()
+	time.AfterFunc(d.delay, func() {
+		if !subscription.Disposed() {
+			subscription.Link(d.parent.Subscribe(observer))
+		}
+	})
+	return subscription
+}
+
+// DelaySubscription waits for delay before subscribing to the stream.
+func (s *BoolStream) DelaySubscription(delay time.Duration) *BoolStream {
+	return &BoolStream{&delaySubscriptionBool{s, delay}}
+}
+
+type takeUntilBool struct {
+	parent BoolObservable
+	signal GenericObservable
+}
+
+func (t *takeUntilBool) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			// A signal that completes without a value never ends the stream.
+			break
+		default:
+			terminate(observer.Complete)
+		}
+	})))
+	parent.Link(t.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// TakeUntil returns elements of the stream until signal, which may be a
+// stream of any type, emits a value. The stream then completes.
+func (s *BoolStream) TakeUntil(signal GenericObservable) *BoolStream {
+	return &BoolStream{&takeUntilBool{s, signal}}
+}
+
+type skipUntilBool struct {
+	parent BoolObservable
+	signal GenericObservable
+}
+
+func (t *skipUntilBool) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	open := false
+	done := false
+	parent := NewLinkedSubscription()
+	signal := NewLinkedSubscription()
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(func() {
+		parent.Dispose()
+		signal.Dispose()
+	})
+	// terminate delivers at most one terminal event and disposes both streams.
+	terminate := func(event func()) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !done {
+			done = true
+			event()
+			parent.Dispose()
+			signal.Dispose()
+		}
+	}
+	signal.Link(t.signal.SubscribeGeneric(NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			break
+		default:
+			lock.Lock()
+			open = true
+			lock.Unlock()
+			signal.Dispose()
+		}
+	})))
+	parent.Link(t.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			terminate(func() { observer.Error(err) })
+		case complete:
+			terminate(observer.Complete)
+		default:
+			lock.Lock()
+			if open && !done {
+				observer.Next(next)
+			}
+			lock.Unlock()
+		}
+	})))
+	return subscription
+}
+
+// SkipUntil skips elements of the stream until signal, which may be a stream
+// of any type, emits a value.
+func (s *BoolStream) SkipUntil(signal GenericObservable) *BoolStream {
+	return &BoolStream{&skipUntilBool{s, signal}}
+}
+
+type forkedBoolStream struct {
+	lock      sync.Mutex
+	parent    BoolObservable
+	observers []BoolObserver
+}
+
+func (f *forkedBoolStream) Subscribe(observer BoolObserver) Subscription {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	i := len(f.observers)
+	f.observers = append(f.observers, observer)
+	sub := new(CallbackSubscription)
+	*sub = CallbackSubscription(func() {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		f.observers[i] = nil
+	})
+	return sub
+}
+
+// Fork replicates each event from the parent to every subscriber of the fork.
+func (s *BoolStream) Fork() *BoolStream {
+	f := &forkedBoolStream{parent: s}
+	go s.Subscribe(BoolObserverFunc(func(n bool, err error, complete bool) {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		for _, o := range f.observers {
+			if o == nil {
+				continue
+			}
+			switch {
+			case err != nil:
+				o.Error(err)
+			case complete:
+				o.Complete()
+			default:
+				o.Next(n)
+			}
+		}
+	}))
+	return &BoolStream{f}
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *BoolStream) ToOneWithError() (bool, error) {
+	valuech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	FromBoolObservable(oneFilter().Bool(s)).SubscribeFunc(func(next bool, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroBool, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *BoolStream) ToOne() bool {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *BoolStream) ToArrayWithError() ([]bool, error) {
+	array := []bool{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *BoolStream) ToArray() []bool {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *BoolStream) ToChannelWithError() (<-chan bool, <-chan error) {
+	ch := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *BoolStream) ToChannel() <-chan bool {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *BoolStream) Seq() iter.Seq[bool] {
+	return func(yield func(bool) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *BoolStream) Seq2() iter.Seq2[bool, error] {
+	return func(yield func(bool, error) bool) {
+		type notification struct {
+			next     bool
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next bool, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroBool, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+// Count returns an IntStream with the count of elements in this stream.
+func (s *BoolStream) Count() *IntStream {
+	count := 0
+	return FromIntObservable(MapBool2IntObserveDirect(s, func(next bool, err error, complete bool, observer IntObserver) {
+		switch {
+		case err != nil:
+			observer.Next(count)
+			observer.Error(err)
+		case complete:
+			observer.Next(count)
+			observer.Complete()
+		default:
+			count++
+		}
+	}))
+}
+
+func equalBool(a, b bool) bool {
+	return a == b
+}
+
+type decideBool struct {
+	parent BoolObservable
+	// decide returns the result and true once the answer is known.
+	decide func(next bool) (bool, bool)
+	// otherwise is the result if the stream completes first.
+	otherwise bool
+}
+
+func (d *decideBool) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	subscription := NewLinkedSubscription()
+	subscription.Link(d.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if done {
+			return
+		}
+		switch {
+		case err != nil:
+			done = true
+			observer.Error(err)
+		case complete:
+			done = true
+			observer.Next(d.otherwise)
+			observer.Complete()
+		default:
+			if result, ok := d.decide(next); ok {
+				done = true
+				observer.Next(result)
+				observer.Complete()
+				subscription.Dispose()
+			}
+		}
+	})))
+	return subscription
+}
+
+// All emits true if f returns true for every value in the stream, or false
+// as soon as it does not.
+func (s *BoolStream) All(f func(bool) bool) *BoolStream {
+	return FromBoolObservable(&decideBool{s, func(next bool) (bool, bool) { return false, !f(next) }, true})
+}
+
+// Any emits true as soon as f returns true for a value in the stream, or
+// false if it never does.
+func (s *BoolStream) Any(f func(bool) bool) *BoolStream {
+	return FromBoolObservable(&decideBool{s, func(next bool) (bool, bool) { return true, f(next) }, false})
+}
+
+// Contains emits true as soon as value is seen in the stream, or false if it
+// never is.
+func (s *BoolStream) Contains(value bool) *BoolStream {
+	return s.Any(func(next bool) bool { return equalBool(next, value) })
+}
+
+// IsEmpty emits true if the stream completes without a value, or false as
+// soon as it emits one.
+func (s *BoolStream) IsEmpty() *BoolStream {
+	return FromBoolObservable(&decideBool{s, func(bool) (bool, bool) { return false, true }, true})
+}
+
+type sequenceEqualBool struct {
+	parent BoolObservable
+	other  BoolObservable
+}
+
+func (e *sequenceEqualBool) Subscribe(observer BoolObserver) Subscription {
+	lock := sync.Mutex{}
+	done := false
+	// Values seen on one side that the other has not caught up with yet.
+	// Only one side can be ahead at a time.
+	pending := [2][]bool{}
+	completed := [2]bool{}
+	subscriptions := [2]*LinkedSubscription{NewLinkedSubscription(), NewLinkedSubscription()}
+	dispose := func() {
+		subscriptions[0].Dispose()
+		subscriptions[1].Dispose()
+	}
+	finish := func(result bool) {
+		done = true
+		observer.Next(result)
+		observer.Complete()
+		dispose()
+	}
+	observe := func(i int) BoolObserver {
+		j := 1 - i
+		return BoolObserverFunc(func(next bool, err error, complete bool) {
+			lock.Lock()
+			defer lock.Unlock()
+			if done {
+				return
+			}
+			switch {
+			case err != nil:
+				done = true
+				observer.Error(err)
+				dispose()
+			case complete:
+				completed[i] = true
+				if len(pending[j]) > 0 {
+					finish(false)
+				} else if completed[j] {
+					finish(true)
+				}
+			case len(pending[j]) > 0:
+				expected := pending[j][0]
+				pending[j] = pending[j][1:]
+				if !equalBool(next, expected) {
+					finish(false)
+				}
+			case completed[j]:
+				finish(false)
+			default:
+				pending[i] = append(pending[i], next)
+			}
+		})
+	}
+	subscriptions[0].Link(e.parent.Subscribe(observe(0)))
+	subscriptions[1].Link(e.other.Subscribe(observe(1)))
+	subscription := new(CallbackSubscription)
+	*subscription = CallbackSubscription(dispose)
+	return subscription
+}
+
+// SequenceEqual emits true if the stream and other emit equal values in the
+// same order and both complete, or false as soon as they differ.
+func (s *BoolStream) SequenceEqual(other BoolObservable) *BoolStream {
+	return FromBoolObservable(&sequenceEqualBool{s, other})
+}
+
+// BoolNotification is a materialized event from a BoolStream.
+type BoolNotification struct {
+	Kind  NotificationKind
+	Value bool  // Set if Kind is NotificationNext.
+	Err   error // Set if Kind is NotificationError.
+}
+
+// Materialize emits every event of the stream, including termination, as a
+// BoolNotification. The materialized stream completes after the
+// notification for the termination of this stream.
+func (s *BoolStream) Materialize() *BoolNotificationStream {
+	return FromBoolNotificationObservable(MapBool2BoolNotificationObserveDirect(s, func(next bool, err error, complete bool, observer BoolNotificationObserver) {
+		switch {
+		case err != nil:
+			observer.Next(BoolNotification{Kind: NotificationError, Err: err})
+			observer.Complete()
+		case complete:
+			observer.Next(BoolNotification{Kind: NotificationComplete})
+			observer.Complete()
+		default:
+			observer.Next(BoolNotification{Kind: NotificationNext, Value: next})
+		}
+	}))
+}
+
+// Dematerialize converts materialized notifications back into the events they
+// represent. Notifications after the first termination are ignored.
+func (s *BoolNotificationStream) Dematerialize() *BoolStream {
+	return FromBoolObservable(MapBoolNotification2BoolObservable(s, func(BoolObserver) MappingBoolNotification2BoolFunc {
+		terminated := false
+		return func(next BoolNotification, err error, complete bool, observer BoolObserver) {
+			if terminated {
+				return
+			}
+			switch {
+			case err != nil:
+				terminated = true
+				observer.Error(err)
+			case complete:
+				terminated = true
+				observer.Complete()
+			default:
+				switch next.Kind {
+				case NotificationNext:
+					observer.Next(next.Value)
+				case NotificationError:
+					terminated = true
+					observer.Error(next.Err)
+				case NotificationComplete:
+					terminated = true
+					observer.Complete()
+				}
+			}
+		}
+	}))
+}
+
+// TimestampedBool is a value from a BoolStream and the time it was emitted.
+type TimestampedBool struct {
+	Value bool
+	Time  time.Time
+}
+
+// Timestamp annotates each value in the stream with the time it was emitted.
+func (s *BoolStream) Timestamp() *TimestampedBoolStream {
+	return FromTimestampedBoolObservable(MapBool2TimestampedBoolObserveNext(s, func(next bool) TimestampedBool {
+		return TimestampedBool{next, Now()}
+	}))
+}
+
+// IntervalBool is a value from a BoolStream and the time elapsed
+// since the previous value was emitted.
+type IntervalBool struct {
+	Value    bool
+	Interval time.Duration
+}
+
+// TimeInterval annotates each value in the stream with the time elapsed since
+// the previous value, or since subscription for the first value.
+func (s *BoolStream) TimeInterval() *IntervalBoolStream {
+	return FromIntervalBoolObservable(MapBool2IntervalBoolObservable(s, func(IntervalBoolObserver) MappingBool2IntervalBoolFunc {
+		last := Now()
+		return func(next bool, err error, complete bool, observer IntervalBoolObserver) {
+			switch {
+			case err != nil:
+				observer.Error(err)
+			case complete:
+				observer.Complete()
+			default:
+				now := Now()
+				observer.Next(IntervalBool{next, now.Sub(last)})
+				last = now
+			}
+		}
+	}))
+}
+
+type MappingBool2ResponseFunc func(next bool, err error, complete bool, observer ResponseObserver)
+type MappingBool2ResponseFuncFactory func(observer ResponseObserver) MappingBool2ResponseFunc
+
+type MappingBool2ResponseObservable struct {
+	parent BoolObservable
+	mapper MappingBool2ResponseFuncFactory
+}
+
+func (f *MappingBool2ResponseObservable) Subscribe(observer ResponseObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBool2ResponseObservable(parent BoolObservable, mapper MappingBool2ResponseFuncFactory) ResponseObservable {
+	return &MappingBool2ResponseObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBool2ResponseObserveDirect(parent BoolObservable, mapper MappingBool2ResponseFunc) ResponseObservable {
+	return MapBool2ResponseObservable(parent, func(ResponseObserver) MappingBool2ResponseFunc {
+		return mapper
+	})
+}
+
+func MapBool2ResponseObserveNext(parent BoolObservable, mapper func(bool) *http.Response) ResponseObservable {
+	return MapBool2ResponseObservable(parent, func(ResponseObserver) MappingBool2ResponseFunc {
+		return func(next bool, err error, complete bool, observer ResponseObserver) {
+			var mapped *http.Response
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughResponse(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapBool2Response struct {
+	parent BoolObservable
+	mapper func(bool) ResponseObservable
+}
+
+func (f *flatMapBool2Response) Subscribe(observer ResponseObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&ResponseStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &ResponseStream{ignoreCompletionFilter().Response(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapResponse maps this stream to an ResponseStream via f.
+func (s *BoolStream) MapResponse(f func(bool) *http.Response) *ResponseStream {
+	return FromResponseObservable(MapBool2ResponseObserveNext(s, f))
+}
+
+func (s *BoolStream) FlatMapResponse(f func(bool) ResponseObservable) *ResponseStream {
+	return &ResponseStream{&flatMapBool2Response{s, f}}
+}
+
+type MappingBool2StringFunc func(next bool, err error, complete bool, observer StringObserver)
+type MappingBool2StringFuncFactory func(observer StringObserver) MappingBool2StringFunc
+
+type MappingBool2StringObservable struct {
+	parent BoolObservable
+	mapper MappingBool2StringFuncFactory
+}
+
+func (f *MappingBool2StringObservable) Subscribe(observer StringObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBool2StringObservable(parent BoolObservable, mapper MappingBool2StringFuncFactory) StringObservable {
+	return &MappingBool2StringObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBool2StringObserveDirect(parent BoolObservable, mapper MappingBool2StringFunc) StringObservable {
+	return MapBool2StringObservable(parent, func(StringObserver) MappingBool2StringFunc {
+		return mapper
+	})
+}
+
+func MapBool2StringObserveNext(parent BoolObservable, mapper func(bool) string) StringObservable {
+	return MapBool2StringObservable(parent, func(StringObserver) MappingBool2StringFunc {
+		return func(next bool, err error, complete bool, observer StringObserver) {
+			var mapped string
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughString(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapBool2String struct {
+	parent BoolObservable
+	mapper func(bool) StringObservable
+}
+
+func (f *flatMapBool2String) Subscribe(observer StringObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&StringStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &StringStream{ignoreCompletionFilter().String(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapString maps this stream to an StringStream via f.
+func (s *BoolStream) MapString(f func(bool) string) *StringStream {
+	return FromStringObservable(MapBool2StringObserveNext(s, f))
+}
+
+func (s *BoolStream) FlatMapString(f func(bool) StringObservable) *StringStream {
+	return &StringStream{&flatMapBool2String{s, f}}
+}
+
+type MappingBool2IntFunc func(next bool, err error, complete bool, observer IntObserver)
+type MappingBool2IntFuncFactory func(observer IntObserver) MappingBool2IntFunc
+
+type MappingBool2IntObservable struct {
+	parent BoolObservable
+	mapper MappingBool2IntFuncFactory
+}
+
+func (f *MappingBool2IntObservable) Subscribe(observer IntObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBool2IntObservable(parent BoolObservable, mapper MappingBool2IntFuncFactory) IntObservable {
+	return &MappingBool2IntObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBool2IntObserveDirect(parent BoolObservable, mapper MappingBool2IntFunc) IntObservable {
+	return MapBool2IntObservable(parent, func(IntObserver) MappingBool2IntFunc {
+		return mapper
+	})
+}
+
+func MapBool2IntObserveNext(parent BoolObservable, mapper func(bool) int) IntObservable {
+	return MapBool2IntObservable(parent, func(IntObserver) MappingBool2IntFunc {
+		return func(next bool, err error, complete bool, observer IntObserver) {
+			var mapped int
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughInt(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapBool2Int struct {
+	parent BoolObservable
+	mapper func(bool) IntObservable
+}
+
+func (f *flatMapBool2Int) Subscribe(observer IntObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&IntStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &IntStream{ignoreCompletionFilter().Int(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *BoolStream) MapInt(f func(bool) int) *IntStream {
+	return FromIntObservable(MapBool2IntObserveNext(s, f))
+}
+
+func (s *BoolStream) FlatMapInt(f func(bool) IntObservable) *IntStream {
+	return &IntStream{&flatMapBool2Int{s, f}}
+}
+
+type MappingBool2BoolFunc func(next bool, err error, complete bool, observer BoolObserver)
+type MappingBool2BoolFuncFactory func(observer BoolObserver) MappingBool2BoolFunc
+
+type MappingBool2BoolObservable struct {
+	parent BoolObservable
+	mapper MappingBool2BoolFuncFactory
+}
+
+func (f *MappingBool2BoolObservable) Subscribe(observer BoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBool2BoolObservable(parent BoolObservable, mapper MappingBool2BoolFuncFactory) BoolObservable {
+	return &MappingBool2BoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBool2BoolObserveDirect(parent BoolObservable, mapper MappingBool2BoolFunc) BoolObservable {
+	return MapBool2BoolObservable(parent, func(BoolObserver) MappingBool2BoolFunc {
+		return mapper
+	})
+}
+
+func MapBool2BoolObserveNext(parent BoolObservable, mapper func(bool) bool) BoolObservable {
+	return MapBool2BoolObservable(parent, func(BoolObserver) MappingBool2BoolFunc {
+		return func(next bool, err error, complete bool, observer BoolObserver) {
+			var mapped bool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapBool2Bool struct {
+	parent BoolObservable
+	mapper func(bool) BoolObservable
+}
+
+func (f *flatMapBool2Bool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&BoolStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &BoolStream{ignoreCompletionFilter().Bool(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// Map maps values in this stream to another value.
+func (s *BoolStream) Map(f func(bool) bool) *BoolStream {
+	return FromBoolObservable(MapBool2BoolObserveNext(s, f))
+}
+
+func (s *BoolStream) FlatMap(f func(bool) BoolObservable) *BoolStream {
+	return &BoolStream{&flatMapBool2Bool{s, f}}
+}
+
+type MappingBool2BoolNotificationFunc func(next bool, err error, complete bool, observer BoolNotificationObserver)
+type MappingBool2BoolNotificationFuncFactory func(observer BoolNotificationObserver) MappingBool2BoolNotificationFunc
+
+type MappingBool2BoolNotificationObservable struct {
+	parent BoolObservable
+	mapper MappingBool2BoolNotificationFuncFactory
+}
+
+func (f *MappingBool2BoolNotificationObservable) Subscribe(observer BoolNotificationObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBool2BoolNotificationObservable(parent BoolObservable, mapper MappingBool2BoolNotificationFuncFactory) BoolNotificationObservable {
+	return &MappingBool2BoolNotificationObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBool2BoolNotificationObserveDirect(parent BoolObservable, mapper MappingBool2BoolNotificationFunc) BoolNotificationObservable {
+	return MapBool2BoolNotificationObservable(parent, func(BoolNotificationObserver) MappingBool2BoolNotificationFunc {
+		return mapper
+	})
+}
+
+func MapBool2BoolNotificationObserveNext(parent BoolObservable, mapper func(bool) BoolNotification) BoolNotificationObservable {
+	return MapBool2BoolNotificationObservable(parent, func(BoolNotificationObserver) MappingBool2BoolNotificationFunc {
+		return func(next bool, err error, complete bool, observer BoolNotificationObserver) {
+			var mapped BoolNotification
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBoolNotification(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapBoolNotification maps this stream to an BoolNotificationStream via f.
+func (s *BoolStream) MapBoolNotification(f func(bool) BoolNotification) *BoolNotificationStream {
+	return FromBoolNotificationObservable(MapBool2BoolNotificationObserveNext(s, f))
+}
+
+type MappingBool2TimestampedBoolFunc func(next bool, err error, complete bool, observer TimestampedBoolObserver)
+type MappingBool2TimestampedBoolFuncFactory func(observer TimestampedBoolObserver) MappingBool2TimestampedBoolFunc
+
+type MappingBool2TimestampedBoolObservable struct {
+	parent BoolObservable
+	mapper MappingBool2TimestampedBoolFuncFactory
+}
+
+func (f *MappingBool2TimestampedBoolObservable) Subscribe(observer TimestampedBoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBool2TimestampedBoolObservable(parent BoolObservable, mapper MappingBool2TimestampedBoolFuncFactory) TimestampedBoolObservable {
+	return &MappingBool2TimestampedBoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBool2TimestampedBoolObserveDirect(parent BoolObservable, mapper MappingBool2TimestampedBoolFunc) TimestampedBoolObservable {
+	return MapBool2TimestampedBoolObservable(parent, func(TimestampedBoolObserver) MappingBool2TimestampedBoolFunc {
+		return mapper
+	})
+}
+
+func MapBool2TimestampedBoolObserveNext(parent BoolObservable, mapper func(bool) TimestampedBool) TimestampedBoolObservable {
+	return MapBool2TimestampedBoolObservable(parent, func(TimestampedBoolObserver) MappingBool2TimestampedBoolFunc {
+		return func(next bool, err error, complete bool, observer TimestampedBoolObserver) {
+			var mapped TimestampedBool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughTimestampedBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapTimestampedBool maps this stream to an TimestampedBoolStream via f.
+func (s *BoolStream) MapTimestampedBool(f func(bool) TimestampedBool) *TimestampedBoolStream {
+	return FromTimestampedBoolObservable(MapBool2TimestampedBoolObserveNext(s, f))
+}
+
+type MappingBool2IntervalBoolFunc func(next bool, err error, complete bool, observer IntervalBoolObserver)
+type MappingBool2IntervalBoolFuncFactory func(observer IntervalBoolObserver) MappingBool2IntervalBoolFunc
+
+type MappingBool2IntervalBoolObservable struct {
+	parent BoolObservable
+	mapper MappingBool2IntervalBoolFuncFactory
+}
+
+func (f *MappingBool2IntervalBoolObservable) Subscribe(observer IntervalBoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolObserverFunc(func(next bool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBool2IntervalBoolObservable(parent BoolObservable, mapper MappingBool2IntervalBoolFuncFactory) IntervalBoolObservable {
+	return &MappingBool2IntervalBoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBool2IntervalBoolObserveDirect(parent BoolObservable, mapper MappingBool2IntervalBoolFunc) IntervalBoolObservable {
+	return MapBool2IntervalBoolObservable(parent, func(IntervalBoolObserver) MappingBool2IntervalBoolFunc {
+		return mapper
+	})
+}
+
+func MapBool2IntervalBoolObserveNext(parent BoolObservable, mapper func(bool) IntervalBool) IntervalBoolObservable {
+	return MapBool2IntervalBoolObservable(parent, func(IntervalBoolObserver) MappingBool2IntervalBoolFunc {
+		return func(next bool, err error, complete bool, observer IntervalBoolObserver) {
+			var mapped IntervalBool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughIntervalBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// MapIntervalBool maps this stream to an IntervalBoolStream via f.
+func (s *BoolStream) MapIntervalBool(f func(bool) IntervalBool) *IntervalBoolStream {
+	return FromIntervalBoolObservable(MapBool2IntervalBoolObserveNext(s, f))
+}
+
+type BoolNotificationObserver interface {
+	Next(BoolNotification)
+	TerminationObserver
+}
+
+// A BoolNotificationSubscriber represents a subscribed BoolNotificationObserver.
+type BoolNotificationSubscriber interface {
+	Subscription
+	BoolNotificationObserver
+}
+
+type implBoolNotificationSubscriber struct {
+	Subscription
+	BoolNotificationObserver
+}
+
+func BoolNotificationObserverAsGenericObserver(observer BoolNotificationObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(BoolNotification))
+		}
+	})
+}
+
+func GenericObserverAsBoolNotificationObserver(observer GenericObserver) BoolNotificationObserver {
+	return BoolNotificationObserverFunc(func(next BoolNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type BoolNotificationObservableFactory func(observer BoolNotificationObserver, subscription Subscription)
+
+func (f BoolNotificationObservableFactory) Subscribe(observer BoolNotificationObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateBoolNotification calls f(observer, subscription) to produce values for a stream.
+func CreateBoolNotification(f func(observer BoolNotificationObserver, subscription Subscription)) *BoolNotificationStream {
+	return FromBoolNotificationObservable(BoolNotificationObservableFactory(f))
+}
+func PassthroughBoolNotification(next BoolNotification, err error, complete bool, observer BoolNotificationObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroBoolNotification = *new(BoolNotification)
+
+type BoolNotificationObserverFunc func(BoolNotification, error, bool)
+
+func (f BoolNotificationObserverFunc) Next(next BoolNotification) { f(next, nil, false) }
+func (f BoolNotificationObserverFunc) Error(err error)            { f(zeroBoolNotification, err, false) }
+func (f BoolNotificationObserverFunc) Complete()                  { f(zeroBoolNotification, nil, true) }
+
+type BoolNotificationObservable interface {
+	Subscribe(BoolNotificationObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a BoolNotificationObservable
+func (f GenericObservableFilterFactory) BoolNotification(parent BoolNotificationObservable) BoolNotificationObservable {
+	return MapBoolNotification2BoolNotificationObservable(parent, func(observer BoolNotificationObserver) MappingBoolNotification2BoolNotificationFunc {
+		gobserver := BoolNotificationObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next BoolNotification, err error, complete bool, observer BoolNotificationObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromBoolNotificationArray(array []BoolNotification) *BoolNotificationStream {
+	return CreateBoolNotification(func(observer BoolNotificationObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromBoolNotifications(array ...BoolNotification) *BoolNotificationStream {
+	return FromBoolNotificationArray(array)
+}
+
+func FromBoolNotificationChannel(ch <-chan BoolNotification) *BoolNotificationStream {
+	return CreateBoolNotification(func(observer BoolNotificationObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type BoolNotificationStream struct {
+	BoolNotificationObservable
+}
+
+func FromBoolNotificationObservable(observable BoolNotificationObservable) *BoolNotificationStream {
+	return &BoolNotificationStream{observable}
+}
+
+func (s *BoolNotificationStream) SubscribeFunc(f func(BoolNotification, error, bool)) Subscription {
+	return s.Subscribe(BoolNotificationObserverFunc(f))
+}
+
+func (s *BoolNotificationStream) SubscribeNext(f func(v BoolNotification)) Subscription {
+	return s.SubscribeFunc(func(next BoolNotification, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *BoolNotificationStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsBoolNotificationObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *BoolNotificationStream) Filter(f func(BoolNotification) bool) *BoolNotificationStream {
+	return FromBoolNotificationObservable(filterFilter(func(v interface{}) bool { return f(v.(BoolNotification)) }).BoolNotification(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *BoolNotificationStream) Do(f func(next BoolNotification)) *BoolNotificationStream {
+	return FromBoolNotificationObservable(MapBoolNotification2BoolNotificationObserveNext(s, func(next BoolNotification) BoolNotification {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *BoolNotificationStream) DoOnError(f func(err error)) *BoolNotificationStream {
+	return FromBoolNotificationObservable(MapBoolNotification2BoolNotificationObserveDirect(s, func(next BoolNotification, err error, complete bool, observer BoolNotificationObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughBoolNotification(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *BoolNotificationStream) DoOnComplete(f func()) *BoolNotificationStream {
+	return FromBoolNotificationObservable(MapBoolNotification2BoolNotificationObserveDirect(s, func(next BoolNotification, err error, complete bool, observer BoolNotificationObserver) {
+		if complete {
+			f()
+		}
+		PassthroughBoolNotification(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *BoolNotificationStream) ToOneWithError() (BoolNotification, error) {
+	valuech := make(chan BoolNotification, 1)
+	errch := make(chan error, 1)
+	FromBoolNotificationObservable(oneFilter().BoolNotification(s)).SubscribeFunc(func(next BoolNotification, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroBoolNotification, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *BoolNotificationStream) ToOne() BoolNotification {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *BoolNotificationStream) ToArrayWithError() ([]BoolNotification, error) {
+	array := []BoolNotification{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next BoolNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *BoolNotificationStream) ToArray() []BoolNotification {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *BoolNotificationStream) ToChannelWithError() (<-chan BoolNotification, <-chan error) {
+	ch := make(chan BoolNotification, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next BoolNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *BoolNotificationStream) ToChannel() <-chan BoolNotification {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *BoolNotificationStream) Seq() iter.Seq[BoolNotification] {
+	return func(yield func(BoolNotification) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *BoolNotificationStream) Seq2() iter.Seq2[BoolNotification, error] {
+	return func(yield func(BoolNotification, error) bool) {
+		type notification struct {
+			next     BoolNotification
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next BoolNotification, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroBoolNotification, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapBoolNotification2Response struct {
+	parent BoolNotificationObservable
+	f      func(BoolNotification) *http.Response
+}
+
+func (m *mapBoolNotification2Response) Subscribe(observer ResponseObserver) Subscription {
+	return m.parent.Subscribe(BoolNotificationObserverFunc(func(next BoolNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapResponse maps this stream to an ResponseStream via f.
+func (s *BoolNotificationStream) MapResponse(f func(BoolNotification) *http.Response) *ResponseStream {
+	return &ResponseStream{&mapBoolNotification2Response{s, f}}
+}
+
+type mapBoolNotification2String struct {
+	parent BoolNotificationObservable
+	f      func(BoolNotification) string
+}
+
+func (m *mapBoolNotification2String) Subscribe(observer StringObserver) Subscription {
+	return m.parent.Subscribe(BoolNotificationObserverFunc(func(next BoolNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapString maps this stream to an StringStream via f.
+func (s *BoolNotificationStream) MapString(f func(BoolNotification) string) *StringStream {
+	return &StringStream{&mapBoolNotification2String{s, f}}
+}
+
+type mapBoolNotification2Int struct {
+	parent BoolNotificationObservable
+	f      func(BoolNotification) int
+}
+
+func (m *mapBoolNotification2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(BoolNotificationObserverFunc(func(next BoolNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
+		}
+	}))
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *BoolNotificationStream) MapInt(f func(BoolNotification) int) *IntStream {
+	return &IntStream{&mapBoolNotification2Int{s, f}}
+}
+
+type MappingBoolNotification2BoolNotificationFunc func(next BoolNotification, err error, complete bool, observer BoolNotificationObserver)
+type MappingBoolNotification2BoolNotificationFuncFactory func(observer BoolNotificationObserver) MappingBoolNotification2BoolNotificationFunc
+
+type MappingBoolNotification2BoolNotificationObservable struct {
+	parent BoolNotificationObservable
+	mapper MappingBoolNotification2BoolNotificationFuncFactory
+}
+
+func (f *MappingBoolNotification2BoolNotificationObservable) Subscribe(observer BoolNotificationObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolNotificationObserverFunc(func(next BoolNotification, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBoolNotification2BoolNotificationObservable(parent BoolNotificationObservable, mapper MappingBoolNotification2BoolNotificationFuncFactory) BoolNotificationObservable {
+	return &MappingBoolNotification2BoolNotificationObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBoolNotification2BoolNotificationObserveDirect(parent BoolNotificationObservable, mapper MappingBoolNotification2BoolNotificationFunc) BoolNotificationObservable {
+	return MapBoolNotification2BoolNotificationObservable(parent, func(BoolNotificationObserver) MappingBoolNotification2BoolNotificationFunc {
+		return mapper
+	})
+}
+
+func MapBoolNotification2BoolNotificationObserveNext(parent BoolNotificationObservable, mapper func(BoolNotification) BoolNotification) BoolNotificationObservable {
+	return MapBoolNotification2BoolNotificationObservable(parent, func(BoolNotificationObserver) MappingBoolNotification2BoolNotificationFunc {
+		return func(next BoolNotification, err error, complete bool, observer BoolNotificationObserver) {
+			var mapped BoolNotification
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBoolNotification(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *BoolNotificationStream) Map(f func(BoolNotification) BoolNotification) *BoolNotificationStream {
+	return FromBoolNotificationObservable(MapBoolNotification2BoolNotificationObserveNext(s, f))
+}
+
+type MappingBoolNotification2BoolFunc func(next BoolNotification, err error, complete bool, observer BoolObserver)
+type MappingBoolNotification2BoolFuncFactory func(observer BoolObserver) MappingBoolNotification2BoolFunc
+
+type MappingBoolNotification2BoolObservable struct {
+	parent BoolNotificationObservable
+	mapper MappingBoolNotification2BoolFuncFactory
+}
+
+func (f *MappingBoolNotification2BoolObservable) Subscribe(observer BoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(BoolNotificationObserverFunc(func(next BoolNotification, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapBoolNotification2BoolObservable(parent BoolNotificationObservable, mapper MappingBoolNotification2BoolFuncFactory) BoolObservable {
+	return &MappingBoolNotification2BoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapBoolNotification2BoolObserveDirect(parent BoolNotificationObservable, mapper MappingBoolNotification2BoolFunc) BoolObservable {
+	return MapBoolNotification2BoolObservable(parent, func(BoolObserver) MappingBoolNotification2BoolFunc {
+		return mapper
+	})
+}
+
+func MapBoolNotification2BoolObserveNext(parent BoolNotificationObservable, mapper func(BoolNotification) bool) BoolObservable {
+	return MapBoolNotification2BoolObservable(parent, func(BoolObserver) MappingBoolNotification2BoolFunc {
+		return func(next BoolNotification, err error, complete bool, observer BoolObserver) {
+			var mapped bool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapBoolNotification2Bool struct {
+	parent BoolNotificationObservable
+	mapper func(BoolNotification) BoolObservable
+}
+
+func (f *flatMapBoolNotification2Bool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(BoolNotificationObserverFunc(func(next BoolNotification, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&BoolStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &BoolStream{ignoreCompletionFilter().Bool(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
+	return subscription
+}
+
+// MapBool maps this stream to an BoolStream via f.
+func (s *BoolNotificationStream) MapBool(f func(BoolNotification) bool) *BoolStream {
+	return FromBoolObservable(MapBoolNotification2BoolObserveNext(s, f))
+}
+
+func (s *BoolNotificationStream) FlatMapBool(f func(BoolNotification) BoolObservable) *BoolStream {
+	return &BoolStream{&flatMapBoolNotification2Bool{s, f}}
+}
+
+type TimestampedBoolObserver interface {
+	Next(TimestampedBool)
+	TerminationObserver
+}
+
+// A TimestampedBoolSubscriber represents a subscribed TimestampedBoolObserver.
+type TimestampedBoolSubscriber interface {
+	Subscription
+	TimestampedBoolObserver
+}
+
+type implTimestampedBoolSubscriber struct {
+	Subscription
+	TimestampedBoolObserver
+}
+
+func TimestampedBoolObserverAsGenericObserver(observer TimestampedBoolObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(TimestampedBool))
+		}
+	})
+}
+
+func GenericObserverAsTimestampedBoolObserver(observer GenericObserver) TimestampedBoolObserver {
+	return TimestampedBoolObserverFunc(func(next TimestampedBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type TimestampedBoolObservableFactory func(observer TimestampedBoolObserver, subscription Subscription)
+
+func (f TimestampedBoolObservableFactory) Subscribe(observer TimestampedBoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateTimestampedBool calls f(observer, subscription) to produce values for a stream.
+func CreateTimestampedBool(f func(observer TimestampedBoolObserver, subscription Subscription)) *TimestampedBoolStream {
+	return FromTimestampedBoolObservable(TimestampedBoolObservableFactory(f))
+}
+func PassthroughTimestampedBool(next TimestampedBool, err error, complete bool, observer TimestampedBoolObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
+
+var zeroTimestampedBool = *new(TimestampedBool)
+
+type TimestampedBoolObserverFunc func(TimestampedBool, error, bool)
+
+func (f TimestampedBoolObserverFunc) Next(next TimestampedBool) { f(next, nil, false) }
+func (f TimestampedBoolObserverFunc) Error(err error)           { f(zeroTimestampedBool, err, false) }
+func (f TimestampedBoolObserverFunc) Complete()                 { f(zeroTimestampedBool, nil, true) }
+
+type TimestampedBoolObservable interface {
+	Subscribe(TimestampedBoolObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a TimestampedBoolObservable
+func (f GenericObservableFilterFactory) TimestampedBool(parent TimestampedBoolObservable) TimestampedBoolObservable {
+	return MapTimestampedBool2TimestampedBoolObservable(parent, func(observer TimestampedBoolObserver) MappingTimestampedBool2TimestampedBoolFunc {
+		gobserver := TimestampedBoolObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next TimestampedBool, err error, complete bool, observer TimestampedBoolObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromTimestampedBoolArray(array []TimestampedBool) *TimestampedBoolStream {
+	return CreateTimestampedBool(func(observer TimestampedBoolObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromTimestampedBools(array ...TimestampedBool) *TimestampedBoolStream {
+	return FromTimestampedBoolArray(array)
+}
+
+func FromTimestampedBoolChannel(ch <-chan TimestampedBool) *TimestampedBoolStream {
+	return CreateTimestampedBool(func(observer TimestampedBoolObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type TimestampedBoolStream struct {
+	TimestampedBoolObservable
+}
+
+func FromTimestampedBoolObservable(observable TimestampedBoolObservable) *TimestampedBoolStream {
+	return &TimestampedBoolStream{observable}
+}
+
+func (s *TimestampedBoolStream) SubscribeFunc(f func(TimestampedBool, error, bool)) Subscription {
+	return s.Subscribe(TimestampedBoolObserverFunc(f))
+}
+
+func (s *TimestampedBoolStream) SubscribeNext(f func(v TimestampedBool)) Subscription {
+	return s.SubscribeFunc(func(next TimestampedBool, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *TimestampedBoolStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsTimestampedBoolObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *TimestampedBoolStream) Filter(f func(TimestampedBool) bool) *TimestampedBoolStream {
+	return FromTimestampedBoolObservable(filterFilter(func(v interface{}) bool { return f(v.(TimestampedBool)) }).TimestampedBool(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *TimestampedBoolStream) Do(f func(next TimestampedBool)) *TimestampedBoolStream {
+	return FromTimestampedBoolObservable(MapTimestampedBool2TimestampedBoolObserveNext(s, func(next TimestampedBool) TimestampedBool {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *TimestampedBoolStream) DoOnError(f func(err error)) *TimestampedBoolStream {
+	return FromTimestampedBoolObservable(MapTimestampedBool2TimestampedBoolObserveDirect(s, func(next TimestampedBool, err error, complete bool, observer TimestampedBoolObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughTimestampedBool(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *TimestampedBoolStream) DoOnComplete(f func()) *TimestampedBoolStream {
+	return FromTimestampedBoolObservable(MapTimestampedBool2TimestampedBoolObserveDirect(s, func(next TimestampedBool, err error, complete bool, observer TimestampedBoolObserver) {
+		if complete {
+			f()
+		}
+		PassthroughTimestampedBool(next, err, complete, observer)
+	}))
+}
+
+// ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
+func (s *TimestampedBoolStream) ToOneWithError() (TimestampedBool, error) {
+	valuech := make(chan TimestampedBool, 1)
+	errch := make(chan error, 1)
+	FromTimestampedBoolObservable(oneFilter().TimestampedBool(s)).SubscribeFunc(func(next TimestampedBool, err error, complete bool) {
+		if err != nil {
+			errch <- err
+		} else if !complete {
+			valuech <- next
+		}
+	})
+	select {
+	case value := <-valuech:
+		return value, nil
+	case err := <-errch:
+		return zeroTimestampedBool, err
+	}
+}
+
+// ToOne blocks and returns the only value emitted by the stream, or the zero
+// value if an error occurs.
+func (s *TimestampedBoolStream) ToOne() TimestampedBool {
+	value, _ := s.ToOneWithError()
+	return value
+}
+
+// ToArrayWithError collects all values from the stream into an array,
+// returning it and any error.
+func (s *TimestampedBoolStream) ToArrayWithError() ([]TimestampedBool, error) {
+	array := []TimestampedBool{}
+	completech := make(chan bool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next TimestampedBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+		case complete:
+			completech <- true
+		default:
+			array = append(array, next)
+		}
+	})
+	select {
+	case <-completech:
+		return array, nil
+	case err := <-errch:
+		return array, err
+	}
+}
+
+// ToArray blocks and returns the values from the stream in an array.
+func (s *TimestampedBoolStream) ToArray() []TimestampedBool {
+	out, _ := s.ToArrayWithError()
+	return out
+}
+
+// ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
+func (s *TimestampedBoolStream) ToChannelWithError() (<-chan TimestampedBool, <-chan error) {
+	ch := make(chan TimestampedBool, 1)
+	errch := make(chan error, 1)
+	s.SubscribeFunc(func(next TimestampedBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			errch <- err
+			close(errch)
+			close(ch)
+		case complete:
+			close(ch)
+		default:
+			ch <- next
+		}
+	})
+	return ch, errch
+}
+
+func (s *TimestampedBoolStream) ToChannel() <-chan TimestampedBool {
+	ch, _ := s.ToChannelWithError()
+	return ch
+}
+
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *TimestampedBoolStream) Seq() iter.Seq[TimestampedBool] {
+	return func(yield func(TimestampedBool) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *TimestampedBoolStream) Seq2() iter.Seq2[TimestampedBool, error] {
+	return func(yield func(TimestampedBool, error) bool) {
+		type notification struct {
+			next     TimestampedBool
+			err      error
+			complete bool
+		}
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next TimestampedBool, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroTimestampedBool, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
+}
+
+type mapTimestampedBool2Response struct {
+	parent TimestampedBoolObservable
+	f      func(TimestampedBool) *http.Response
+}
+
+func (m *mapTimestampedBool2Response) Subscribe(observer ResponseObserver) Subscription {
+	return m.parent.Subscribe(TimestampedBoolObserverFunc(func(next TimestampedBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
 		}
 	}))
 }
 
-func (s *IntStream) Scan(initial int, f func(int, int) int) *IntStream {
-	value := initial
-	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+// MapResponse maps this stream to an ResponseStream via f.
+func (s *TimestampedBoolStream) MapResponse(f func(TimestampedBool) *http.Response) *ResponseStream {
+	return &ResponseStream{&mapTimestampedBool2Response{s, f}}
+}
+
+type mapTimestampedBool2String struct {
+	parent TimestampedBoolObservable
+	f      func(TimestampedBool) string
+}
+
+func (m *mapTimestampedBool2String) Subscribe(observer StringObserver) Subscription {
+	return m.parent.Subscribe(TimestampedBoolObserverFunc(func(next TimestampedBool, err error, complete bool) {
 		switch {
 		case err != nil:
 			observer.Error(err)
 		case complete:
 			observer.Complete()
 		default:
-			value = f(value, next)
-			observer.Next(value)
+			observer.Next(m.f(next))
 		}
 	}))
 }
 
-type timeoutInt struct {
-	parent  IntObservable
-	timeout time.Duration
+// MapString maps this stream to an StringStream via f.
+func (s *TimestampedBoolStream) MapString(f func(TimestampedBool) string) *StringStream {
+	return &StringStream{&mapTimestampedBool2String{s, f}}
 }
 
-func (t *timeoutInt) Subscribe(observer IntObserver) Subscription {
-	subscription := NewChannelSubscription()
-	cancel := t.parent.Subscribe(observer)
-	go func() {
-		select {
-		case <-time.After(t.timeout):
-			observer.Error(ErrTimeout)
-			_ = cancel.Close()
-			_ = subscription.Close()
-		case <-subscription:
-			_ = cancel.Close()
+type mapTimestampedBool2Int struct {
+	parent TimestampedBoolObservable
+	f      func(TimestampedBool) int
+}
+
+func (m *mapTimestampedBool2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(TimestampedBoolObserverFunc(func(next TimestampedBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(m.f(next))
 		}
-	}()
+	}))
+}
+
+// MapInt maps this stream to an IntStream via f.
+func (s *TimestampedBoolStream) MapInt(f func(TimestampedBool) int) *IntStream {
+	return &IntStream{&mapTimestampedBool2Int{s, f}}
+}
+
+type MappingTimestampedBool2TimestampedBoolFunc func(next TimestampedBool, err error, complete bool, observer TimestampedBoolObserver)
+type MappingTimestampedBool2TimestampedBoolFuncFactory func(observer TimestampedBoolObserver) MappingTimestampedBool2TimestampedBoolFunc
+
+type MappingTimestampedBool2TimestampedBoolObservable struct {
+	parent TimestampedBoolObservable
+	mapper MappingTimestampedBool2TimestampedBoolFuncFactory
+}
+
+func (f *MappingTimestampedBool2TimestampedBoolObservable) Subscribe(observer TimestampedBoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(TimestampedBoolObserverFunc(func(next TimestampedBool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapTimestampedBool2TimestampedBoolObservable(parent TimestampedBoolObservable, mapper MappingTimestampedBool2TimestampedBoolFuncFactory) TimestampedBoolObservable {
+	return &MappingTimestampedBool2TimestampedBoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapTimestampedBool2TimestampedBoolObserveDirect(parent TimestampedBoolObservable, mapper MappingTimestampedBool2TimestampedBoolFunc) TimestampedBoolObservable {
+	return MapTimestampedBool2TimestampedBoolObservable(parent, func(TimestampedBoolObserver) MappingTimestampedBool2TimestampedBoolFunc {
+		return mapper
+	})
+}
+
+func MapTimestampedBool2TimestampedBoolObserveNext(parent TimestampedBoolObservable, mapper func(TimestampedBool) TimestampedBool) TimestampedBoolObservable {
+	return MapTimestampedBool2TimestampedBoolObservable(parent, func(TimestampedBoolObserver) MappingTimestampedBool2TimestampedBoolFunc {
+		return func(next TimestampedBool, err error, complete bool, observer TimestampedBoolObserver) {
+			var mapped TimestampedBool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughTimestampedBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+// Map maps values in this stream to another value.
+func (s *TimestampedBoolStream) Map(f func(TimestampedBool) TimestampedBool) *TimestampedBoolStream {
+	return FromTimestampedBoolObservable(MapTimestampedBool2TimestampedBoolObserveNext(s, f))
+}
+
+type MappingTimestampedBool2BoolFunc func(next TimestampedBool, err error, complete bool, observer BoolObserver)
+type MappingTimestampedBool2BoolFuncFactory func(observer BoolObserver) MappingTimestampedBool2BoolFunc
+
+type MappingTimestampedBool2BoolObservable struct {
+	parent TimestampedBoolObservable
+	mapper MappingTimestampedBool2BoolFuncFactory
+}
+
+func (f *MappingTimestampedBool2BoolObservable) Subscribe(observer BoolObserver) Subscription {
+	mapper := f.mapper(observer)
+	return f.parent.Subscribe(TimestampedBoolObserverFunc(func(next TimestampedBool, err error, complete bool) {
+		mapper(next, err, complete, observer)
+	}))
+}
+
+func MapTimestampedBool2BoolObservable(parent TimestampedBoolObservable, mapper MappingTimestampedBool2BoolFuncFactory) BoolObservable {
+	return &MappingTimestampedBool2BoolObservable{
+		parent: parent,
+		mapper: mapper,
+	}
+}
+
+func MapTimestampedBool2BoolObserveDirect(parent TimestampedBoolObservable, mapper MappingTimestampedBool2BoolFunc) BoolObservable {
+	return MapTimestampedBool2BoolObservable(parent, func(BoolObserver) MappingTimestampedBool2BoolFunc {
+		return mapper
+	})
+}
+
+func MapTimestampedBool2BoolObserveNext(parent TimestampedBoolObservable, mapper func(TimestampedBool) bool) BoolObservable {
+	return MapTimestampedBool2BoolObservable(parent, func(BoolObserver) MappingTimestampedBool2BoolFunc {
+		return func(next TimestampedBool, err error, complete bool, observer BoolObserver) {
+			var mapped bool
+			if err == nil && !complete {
+				mapped = mapper(next)
+			}
+			PassthroughBool(mapped, err, complete, observer)
+		}
+	},
+	)
+}
+
+type flatMapTimestampedBool2Bool struct {
+	parent TimestampedBoolObservable
+	mapper func(TimestampedBool) BoolObservable
+}
+
+func (f *flatMapTimestampedBool2Bool) Subscribe(observer BoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	wg := sync.WaitGroup{}
+	f.parent.Subscribe(TimestampedBoolObserverFunc(func(next TimestampedBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			wg.Wait()
+			observer.Error(err)
+		case complete:
+			wg.Wait()
+			observer.Complete()
+		default:
+			wg.Add(1)
+			observable := f.mapper(next)
+			stream := (&BoolStream{observable}).
+				DoOnComplete(func() { wg.Done() }).
+				DoOnError(func(error) { wg.Done() })
+			stream = &BoolStream{ignoreCompletionFilter().Bool(stream)}
+			stream.Subscribe(observer)
+		}
+	}))
 	return subscription
 }
 
-func (s *IntStream) Timeout(timeout time.Duration) *IntStream {
-	return &IntStream{&timeoutInt{s, timeout}}
+// MapBool maps this stream to an BoolStream via f.
+func (s *TimestampedBoolStream) MapBool(f func(TimestampedBool) bool) *BoolStream {
+	return FromBoolObservable(MapTimestampedBool2BoolObserveNext(s, f))
+}
+
+func (s *TimestampedBoolStream) FlatMapBool(f func(TimestampedBool) BoolObservable) *BoolStream {
+	return &BoolStream{&flatMapTimestampedBool2Bool{s, f}}
+}
+
+type IntervalBoolObserver interface {
+	Next(IntervalBool)
+	TerminationObserver
+}
+
+// A IntervalBoolSubscriber represents a subscribed IntervalBoolObserver.
+type IntervalBoolSubscriber interface {
+	Subscription
+	IntervalBoolObserver
+}
+
+type implIntervalBoolSubscriber struct {
+	Subscription
+	IntervalBoolObserver
+}
+
+func IntervalBoolObserverAsGenericObserver(observer IntervalBoolObserver) GenericObserver {
+	return NewGenericObserverFunc(func(next interface{}, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next.(IntervalBool))
+		}
+	})
+}
+
+func GenericObserverAsIntervalBoolObserver(observer GenericObserver) IntervalBoolObserver {
+	return IntervalBoolObserverFunc(func(next IntervalBool, err error, complete bool) {
+		switch {
+		case err != nil:
+			observer.Error(err)
+		case complete:
+			observer.Complete()
+		default:
+			observer.Next(next)
+		}
+	})
+}
+
+type IntervalBoolObservableFactory func(observer IntervalBoolObserver, subscription Subscription)
+
+func (f IntervalBoolObservableFactory) Subscribe(observer IntervalBoolObserver) Subscription {
+	subscription := NewGenericSubscription()
+	go f(observer, subscription)
+	return subscription
+}
+
+// CreateIntervalBool calls f(observer, subscription) to produce values for a stream.
+func CreateIntervalBool(f func(observer IntervalBoolObserver, subscription Subscription)) *IntervalBoolStream {
+	return FromIntervalBoolObservable(IntervalBoolObservableFactory(f))
+}
+func PassthroughIntervalBool(next IntervalBool, err error, complete bool, observer IntervalBoolObserver) {
+	switch {
+	case err != nil:
+		observer.Error(err)
+	case complete:
+		observer.Complete()
+	default:
+		observer.Next(next)
+	}
+}
 
+var zeroIntervalBool = *new(IntervalBool)
+
+type IntervalBoolObserverFunc func(IntervalBool, error, bool)
+
+func (f IntervalBoolObserverFunc) Next(next IntervalBool) { f(next, nil, false) }
+func (f IntervalBoolObserverFunc) Error(err error)        { f(zeroIntervalBool, err, false) }
+func (f IntervalBoolObserverFunc) Complete()              { f(zeroIntervalBool, nil, true) }
+
+type IntervalBoolObservable interface {
+	Subscribe(IntervalBoolObserver) Subscription
+}
+
+// Convert a GenericObservableFilter to a IntervalBoolObservable
+func (f GenericObservableFilterFactory) IntervalBool(parent IntervalBoolObservable) IntervalBoolObservable {
+	return MapIntervalBool2IntervalBoolObservable(parent, func(observer IntervalBoolObserver) MappingIntervalBool2IntervalBoolFunc {
+		gobserver := IntervalBoolObserverAsGenericObserver(observer)
+		filter := f(gobserver)
+		return func(next IntervalBool, err error, complete bool, observer IntervalBoolObserver) {
+			filter(next, err, complete, gobserver)
+		}
+	},
+	)
+}
+
+func FromIntervalBoolArray(array []IntervalBool) *IntervalBoolStream {
+	return CreateIntervalBool(func(observer IntervalBoolObserver, subscription Subscription) {
+		for _, v := range array {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+		subscription.Dispose()
+	})
+}
+
+func FromIntervalBools(array ...IntervalBool) *IntervalBoolStream {
+	return FromIntervalBoolArray(array)
+}
+
+func FromIntervalBoolChannel(ch <-chan IntervalBool) *IntervalBoolStream {
+	return CreateIntervalBool(func(observer IntervalBoolObserver, subscription Subscription) {
+		for v := range ch {
+			if subscription.Disposed() {
+				return
+			}
+			observer.Next(v)
+		}
+		observer.Complete()
+	})
+}
+
+type IntervalBoolStream struct {
+	IntervalBoolObservable
+}
+
+func FromIntervalBoolObservable(observable IntervalBoolObservable) *IntervalBoolStream {
+	return &IntervalBoolStream{observable}
+}
+
+func (s *IntervalBoolStream) SubscribeFunc(f func(IntervalBool, error, bool)) Subscription {
+	return s.Subscribe(IntervalBoolObserverFunc(f))
+}
+
+func (s *IntervalBoolStream) SubscribeNext(f func(v IntervalBool)) Subscription {
+	return s.SubscribeFunc(func(next IntervalBool, err error, complete bool) {
+		if err == nil && !complete {
+			f(next)
+		}
+	})
+}
+
+// SubscribeGeneric subscribes a GenericObserver to the stream.
+func (s *IntervalBoolStream) SubscribeGeneric(observer GenericObserver) Subscription {
+	return s.Subscribe(GenericObserverAsIntervalBoolObserver(observer))
+}
+
+// Filter elements in the stream on a function.
+func (s *IntervalBoolStream) Filter(f func(IntervalBool) bool) *IntervalBoolStream {
+	return FromIntervalBoolObservable(filterFilter(func(v interface{}) bool { return f(v.(IntervalBool)) }).IntervalBool(s))
+}
+
+// Do applies a function for each value passing through the stream.
+func (s *IntervalBoolStream) Do(f func(next IntervalBool)) *IntervalBoolStream {
+	return FromIntervalBoolObservable(MapIntervalBool2IntervalBoolObserveNext(s, func(next IntervalBool) IntervalBool {
+		f(next)
+		return next
+	}))
+}
+
+// DoOnError applies a function for any error on the stream.
+func (s *IntervalBoolStream) DoOnError(f func(err error)) *IntervalBoolStream {
+	return FromIntervalBoolObservable(MapIntervalBool2IntervalBoolObserveDirect(s, func(next IntervalBool, err error, complete bool, observer IntervalBoolObserver) {
+		if err != nil {
+			f(err)
+		}
+		PassthroughIntervalBool(next, err, complete, observer)
+	}))
+}
+
+// DoOnComplete applies a function when the stream completes.
+func (s *IntervalBoolStream) DoOnComplete(f func()) *IntervalBoolStream {
+	return FromIntervalBoolObservable(MapIntervalBool2IntervalBoolObserveDirect(s, func(next IntervalBool, err error, complete bool, observer IntervalBoolObserver) {
+		if complete {
+			f()
+		}
+		PassthroughIntervalBool(next, err, complete, observer)
+	}))
 }
 
 // ToOneWithError blocks until the stream emits exactly one value. Otherwise, it errors.
-func (s *IntStream) ToOneWithError() (int, error) {
-	valuech := make(chan int, 1)
+func (s *IntervalBoolStream) ToOneWithError() (IntervalBool, error) {
+	valuech := make(chan IntervalBool, 1)
 	errch := make(chan error, 1)
-	FromIntObservable(oneFilter().Int(s)).SubscribeFunc(func(next int, err error, complete bool) {
+	FromIntervalBoolObservable(oneFilter().IntervalBool(s)).SubscribeFunc(func(next IntervalBool, err error, complete bool) {
 		if err != nil {
 			errch <- err
 		} else if !complete {
@@ -2864,24 +14202,24 @@ func (s *IntStream) ToOneWithError() (int, error) {
 	case value := <-valuech:
 		return value, nil
 	case err := <-errch:
-		return zeroInt, err
+		return zeroIntervalBool, err
 	}
 }
 
 // ToOne blocks and returns the only value emitted by the stream, or the zero
 // value if an error occurs.
-func (s *IntStream) ToOne() int {
+func (s *IntervalBoolStream) ToOne() IntervalBool {
 	value, _ := s.ToOneWithError()
 	return value
 }
 
 // ToArrayWithError collects all values from the stream into an array,
 // returning it and any error.
-func (s *IntStream) ToArrayWithError() ([]int, error) {
-	array := []int{}
+func (s *IntervalBoolStream) ToArrayWithError() ([]IntervalBool, error) {
+	array := []IntervalBool{}
 	completech := make(chan bool, 1)
 	errch := make(chan error, 1)
-	s.SubscribeFunc(func(next int, err error, complete bool) {
+	s.SubscribeFunc(func(next IntervalBool, err error, complete bool) {
 		switch {
 		case err != nil:
 			errch <- err
@@ -2900,16 +14238,16 @@ func (s *IntStream) ToArrayWithError() ([]int, error) {
 }
 
 // ToArray blocks and returns the values from the stream in an array.
-func (s *IntStream) ToArray() []int {
+func (s *IntervalBoolStream) ToArray() []IntervalBool {
 	out, _ := s.ToArrayWithError()
 	return out
 }
 
 // ToChannelWithError returns value and error channels corresponding to the stream elements and any error.
-func (s *IntStream) ToChannelWithError() (<-chan int, <-chan error) {
-	ch := make(chan int, 1)
+func (s *IntervalBoolStream) ToChannelWithError() (<-chan IntervalBool, <-chan error) {
+	ch := make(chan IntervalBool, 1)
 	errch := make(chan error, 1)
-	s.SubscribeFunc(func(next int, err error, complete bool) {
+	s.SubscribeFunc(func(next IntervalBool, err error, complete bool) {
 		switch {
 		case err != nil:
 			errch <- err
@@ -2924,326 +14262,225 @@ func (s *IntStream) ToChannelWithError() (<-chan int, <-chan error) {
 	return ch, errch
 }
 
-func (s *IntStream) ToChannel() <-chan int {
+func (s *IntervalBoolStream) ToChannel() <-chan IntervalBool {
 	ch, _ := s.ToChannelWithError()
 	return ch
 }
 
-// Count returns an IntStream with the count of elements in this stream.
-func (s *IntStream) Count() *IntStream {
-	count := 0
-	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
-		switch {
-		case err != nil:
-			observer.Next(count)
-			observer.Error(err)
-		case complete:
-			observer.Next(count)
-			observer.Complete()
-		default:
-			count++
+// Seq returns an iterator over the values in the stream. Each iteration
+// subscribes to the stream, and breaking out of the loop disposes the
+// subscription. An error ends the iteration; use Seq2 to observe it.
+func (s *IntervalBoolStream) Seq() iter.Seq[IntervalBool] {
+	return func(yield func(IntervalBool) bool) {
+		for v, err := range s.Seq2() {
+			if err != nil || !yield(v) {
+				return
+			}
 		}
-	}))
+	}
 }
 
-func (s *IntStream) Average() *IntStream {
-	var sum int
-	var count int
-	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
-		switch {
-		case err != nil:
-			observer.Next(sum / count)
-			observer.Error(err)
-		case complete:
-			observer.Next(sum / count)
-			observer.Complete()
-		default:
-			sum += next
-			count++
+// Seq2 returns an iterator over the values in the stream, paired with a nil
+// error. If the stream errors, the final pair holds the zero value and the
+// error. Each iteration subscribes to the stream, and breaking out of the
+// loop disposes the subscription.
+func (s *IntervalBoolStream) Seq2() iter.Seq2[IntervalBool, error] {
+	return func(yield func(IntervalBool, error) bool) {
+		type notification struct {
+			next     IntervalBool
+			err      error
+			complete bool
 		}
-	}))
+		ch := make(chan notification)
+		done := make(chan struct{})
+		defer close(done)
+		subscription := s.SubscribeFunc(func(next IntervalBool, err error, complete bool) {
+			select {
+			case ch <- notification{next, err, complete}:
+			case <-done:
+			}
+		})
+		defer subscription.Dispose()
+		for {
+			n := <-ch
+			switch {
+			case n.err != nil:
+				yield(zeroIntervalBool, n.err)
+				return
+			case n.complete:
+				return
+			case !yield(n.next, nil):
+				return
+			}
+		}
+	}
 }
 
-func (s *IntStream) Sum() *IntStream {
-	var sum int
-	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
-		switch {
-		case err != nil:
-			observer.Next(sum)
-			observer.Error(err)
-		case complete:
-			observer.Next(sum)
-			observer.Complete()
-		default:
-			sum += next
-		}
-	}))
+type mapIntervalBool2Response struct {
+	parent IntervalBoolObservable
+	f      func(IntervalBool) *http.Response
 }
 
-func (s *IntStream) Min() *IntStream {
-	started := false
-	var min int
-	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+func (m *mapIntervalBool2Response) Subscribe(observer ResponseObserver) Subscription {
+	return m.parent.Subscribe(IntervalBoolObserverFunc(func(next IntervalBool, err error, complete bool) {
 		switch {
 		case err != nil:
-			if started {
-				observer.Next(min)
-			}
 			observer.Error(err)
 		case complete:
-			if started {
-				observer.Next(min)
-			}
 			observer.Complete()
 		default:
-			if started {
-				if min > next {
-					min = next
-				}
-			} else {
-				min = next
-				started = true
-			}
+			observer.Next(m.f(next))
 		}
 	}))
 }
 
-func (s *IntStream) Max() *IntStream {
-	started := false
-	var max int
-	return FromIntObservable(MapInt2IntObserveDirect(s, func(next int, err error, complete bool, observer IntObserver) {
+// MapResponse maps this stream to an ResponseStream via f.
+func (s *IntervalBoolStream) MapResponse(f func(IntervalBool) *http.Response) *ResponseStream {
+	return &ResponseStream{&mapIntervalBool2Response{s, f}}
+}
+
+type mapIntervalBool2String struct {
+	parent IntervalBoolObservable
+	f      func(IntervalBool) string
+}
+
+func (m *mapIntervalBool2String) Subscribe(observer StringObserver) Subscription {
+	return m.parent.Subscribe(IntervalBoolObserverFunc(func(next IntervalBool, err error, complete bool) {
 		switch {
 		case err != nil:
-			if started {
-				observer.Next(max)
-			}
 			observer.Error(err)
 		case complete:
-			if started {
-				observer.Next(max)
-			}
 			observer.Complete()
 		default:
-			if started {
-				if max <= next {
-					max = next
-				}
-			} else {
-				max = next
-				started = true
-			}
+			observer.Next(m.f(next))
 		}
 	}))
 }
 
-type MappingInt2ResponseFunc func(next int, err error, complete bool, observer ResponseObserver)
-type MappingInt2ResponseFuncFactory func(observer ResponseObserver) MappingInt2ResponseFunc
-
-type MappingInt2ResponseObservable struct {
-	parent IntObservable
-	mapper MappingInt2ResponseFuncFactory
-}
-
-func (f *MappingInt2ResponseObservable) Subscribe(observer ResponseObserver) Subscription {
-	mapper := f.mapper(observer)
-	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
-		mapper(next, err, complete, observer)
-	}))
-}
-
-func MapInt2ResponseObservable(parent IntObservable, mapper MappingInt2ResponseFuncFactory) ResponseObservable {
-	return &MappingInt2ResponseObservable{
-		parent: parent,
-		mapper: mapper,
-	}
-}
-
-func MapInt2ResponseObserveDirect(parent IntObservable, mapper MappingInt2ResponseFunc) ResponseObservable {
-	return MapInt2ResponseObservable(parent, func(ResponseObserver) MappingInt2ResponseFunc {
-		return mapper
-	})
-}
-
-func MapInt2ResponseObserveNext(parent IntObservable, mapper func(int) *http.Response) ResponseObservable {
-	return MapInt2ResponseObservable(parent, func(ResponseObserver) MappingInt2ResponseFunc {
-		return func(next int, err error, complete bool, observer ResponseObserver) {
-			var mapped *http.Response
-			if err == nil && !complete {
-				mapped = mapper(next)
-			}
-			PassthroughResponse(mapped, err, complete, observer)
-		}
-	},
-	)
+// MapString maps this stream to an StringStream via f.
+func (s *IntervalBoolStream) MapString(f func(IntervalBool) string) *StringStream {
+	return &StringStream{&mapIntervalBool2String{s, f}}
 }
 
-type flatMapInt2Response struct {
-	parent IntObservable
-	mapper func(int) ResponseObservable
+type mapIntervalBool2Int struct {
+	parent IntervalBoolObservable
+	f      func(IntervalBool) int
 }
 
-func (f *flatMapInt2Response) Subscribe(observer ResponseObserver) Subscription {
-	subscription := NewGenericSubscription()
-	wg := sync.WaitGroup{}
-	f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+func (m *mapIntervalBool2Int) Subscribe(observer IntObserver) Subscription {
+	return m.parent.Subscribe(IntervalBoolObserverFunc(func(next IntervalBool, err error, complete bool) {
 		switch {
 		case err != nil:
-			wg.Wait()
 			observer.Error(err)
 		case complete:
-			wg.Wait()
 			observer.Complete()
 		default:
-			wg.Add(1)
-			observable := f.mapper(next)
-			stream := (&ResponseStream{observable}).
-				DoOnComplete(func() { wg.Done() }).
-				DoOnError(func(error) { wg.Done() })
-			stream = &ResponseStream{ignoreCompletionFilter().Response(stream)}
-			stream.Subscribe(observer)
+			observer.Next(m.f(next))
 		}
 	}))
-	return subscription
-}
-
-// MapResponse maps this stream to an ResponseStream via f.
-func (s *IntStream) MapResponse(f func(int) *http.Response) *ResponseStream {
-	return FromResponseObservable(MapInt2ResponseObserveNext(s, f))
 }
 
-func (s *IntStream) FlatMapResponse(f func(int) ResponseObservable) *ResponseStream {
-	return &ResponseStream{&flatMapInt2Response{s, f}}
+// MapInt maps this stream to an IntStream via f.
+func (s *IntervalBoolStream) MapInt(f func(IntervalBool) int) *IntStream {
+	return &IntStream{&mapIntervalBool2Int{s, f}}
 }
 
-type MappingInt2StringFunc func(next int, err error, complete bool, observer StringObserver)
-type MappingInt2StringFuncFactory func(observer StringObserver) MappingInt2StringFunc
+type MappingIntervalBool2IntervalBoolFunc func(next IntervalBool, err error, complete bool, observer IntervalBoolObserver)
+type MappingIntervalBool2IntervalBoolFuncFactory func(observer IntervalBoolObserver) MappingIntervalBool2IntervalBoolFunc
 
-type MappingInt2StringObservable struct {
-	parent IntObservable
-	mapper MappingInt2StringFuncFactory
+type MappingIntervalBool2IntervalBoolObservable struct {
+	parent IntervalBoolObservable
+	mapper MappingIntervalBool2IntervalBoolFuncFactory
 }
 
-func (f *MappingInt2StringObservable) Subscribe(observer StringObserver) Subscription {
+func (f *MappingIntervalBool2IntervalBoolObservable) Subscribe(observer IntervalBoolObserver) Subscription {
 	mapper := f.mapper(observer)
-	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+	return f.parent.Subscribe(IntervalBoolObserverFunc(func(next IntervalBool, err error, complete bool) {
 		mapper(next, err, complete, observer)
 	}))
 }
 
-func MapInt2StringObservable(parent IntObservable, mapper MappingInt2StringFuncFactory) StringObservable {
-	return &MappingInt2StringObservable{
+func MapIntervalBool2IntervalBoolObservable(parent IntervalBoolObservable, mapper MappingIntervalBool2IntervalBoolFuncFactory) IntervalBoolObservable {
+	return &MappingIntervalBool2IntervalBoolObservable{
 		parent: parent,
 		mapper: mapper,
 	}
 }
 
-func MapInt2StringObserveDirect(parent IntObservable, mapper MappingInt2StringFunc) StringObservable {
-	return MapInt2StringObservable(parent, func(StringObserver) MappingInt2StringFunc {
+func MapIntervalBool2IntervalBoolObserveDirect(parent IntervalBoolObservable, mapper MappingIntervalBool2IntervalBoolFunc) IntervalBoolObservable {
+	return MapIntervalBool2IntervalBoolObservable(parent, func(IntervalBoolObserver) MappingIntervalBool2IntervalBoolFunc {
 		return mapper
 	})
 }
 
-func MapInt2StringObserveNext(parent IntObservable, mapper func(int) string) StringObservable {
-	return MapInt2StringObservable(parent, func(StringObserver) MappingInt2StringFunc {
-		return func(next int, err error, complete bool, observer StringObserver) {
-			var mapped string
+func MapIntervalBool2IntervalBoolObserveNext(parent IntervalBoolObservable, mapper func(IntervalBool) IntervalBool) IntervalBoolObservable {
+	return MapIntervalBool2IntervalBoolObservable(parent, func(IntervalBoolObserver) MappingIntervalBool2IntervalBoolFunc {
+		return func(next IntervalBool, err error, complete bool, observer IntervalBoolObserver) {
+			var mapped IntervalBool
 			if err == nil && !complete {
 				mapped = mapper(next)
 			}
-			PassthroughString(mapped, err, complete, observer)
+			PassthroughIntervalBool(mapped, err, complete, observer)
 		}
 	},
 	)
 }
 
-type flatMapInt2String struct {
-	parent IntObservable
-	mapper func(int) StringObservable
-}
-
-func (f *flatMapInt2String) Subscribe(observer StringObserver) Subscription {
-	subscription := NewGenericSubscription()
-	wg := sync.WaitGroup{}
-	f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
-		switch {
-		case err != nil:
-			wg.Wait()
-			observer.Error(err)
-		case complete:
-			wg.Wait()
-			observer.Complete()
-		default:
-			wg.Add(1)
-			observable := f.mapper(next)
-			stream := (&StringStream{observable}).
-				DoOnComplete(func() { wg.Done() }).
-				DoOnError(func(error) { wg.Done() })
-			stream = &StringStream{ignoreCompletionFilter().String(stream)}
-			stream.Subscribe(observer)
-		}
-	}))
-	return subscription
-}
-
-// MapString maps this stream to an StringStream via f.
-func (s *IntStream) MapString(f func(int) string) *StringStream {
-	return FromStringObservable(MapInt2StringObserveNext(s, f))
-}
-
-func (s *IntStream) FlatMapString(f func(int) StringObservable) *StringStream {
-	return &StringStream{&flatMapInt2String{s, f}}
+// Map maps values in this stream to another value.
+func (s *IntervalBoolStream) Map(f func(IntervalBool) IntervalBool) *IntervalBoolStream {
+	return FromIntervalBoolObservable(MapIntervalBool2IntervalBoolObserveNext(s, f))
 }
 
-type MappingInt2IntFunc func(next int, err error, complete bool, observer IntObserver)
-type MappingInt2IntFuncFactory func(observer IntObserver) MappingInt2IntFunc
+type MappingIntervalBool2BoolFunc func(next IntervalBool, err error, complete bool, observer BoolObserver)
+type MappingIntervalBool2BoolFuncFactory func(observer BoolObserver) MappingIntervalBool2BoolFunc
 
-type MappingInt2IntObservable struct {
-	parent IntObservable
-	mapper MappingInt2IntFuncFactory
+type MappingIntervalBool2BoolObservable struct {
+	parent IntervalBoolObservable
+	mapper MappingIntervalBool2BoolFuncFactory
 }
 
-func (f *MappingInt2IntObservable) Subscribe(observer IntObserver) Subscription {
+func (f *MappingIntervalBool2BoolObservable) Subscribe(observer BoolObserver) Subscription {
 	mapper := f.mapper(observer)
-	return f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+	return f.parent.Subscribe(IntervalBoolObserverFunc(func(next IntervalBool, err error, complete bool) {
 		mapper(next, err, complete, observer)
 	}))
 }
 
-func MapInt2IntObservable(parent IntObservable, mapper MappingInt2IntFuncFactory) IntObservable {
-	return &MappingInt2IntObservable{
+func MapIntervalBool2BoolObservable(parent IntervalBoolObservable, mapper MappingIntervalBool2BoolFuncFactory) BoolObservable {
+	return &MappingIntervalBool2BoolObservable{
 		parent: parent,
 		mapper: mapper,
 	}
 }
 
-func MapInt2IntObserveDirect(parent IntObservable, mapper MappingInt2IntFunc) IntObservable {
-	return MapInt2IntObservable(parent, func(IntObserver) MappingInt2IntFunc {
+func MapIntervalBool2BoolObserveDirect(parent IntervalBoolObservable, mapper MappingIntervalBool2BoolFunc) BoolObservable {
+	return MapIntervalBool2BoolObservable(parent, func(BoolObserver) MappingIntervalBool2BoolFunc {
 		return mapper
 	})
 }
 
-func MapInt2IntObserveNext(parent IntObservable, mapper func(int) int) IntObservable {
-	return MapInt2IntObservable(parent, func(IntObserver) MappingInt2IntFunc {
-		return func(next int, err error, complete bool, observer IntObserver) {
-			var mapped int
+func MapIntervalBool2BoolObserveNext(parent IntervalBoolObservable, mapper func(IntervalBool) bool) BoolObservable {
+	return MapIntervalBool2BoolObservable(parent, func(BoolObserver) MappingIntervalBool2BoolFunc {
+		return func(next IntervalBool, err error, complete bool, observer BoolObserver) {
+			var mapped bool
 			if err == nil && !complete {
 				mapped = mapper(next)
 			}
-			PassthroughInt(mapped, err, complete, observer)
+			PassthroughBool(mapped, err, complete, observer)
 		}
 	},
 	)
 }
 
-type flatMapInt2Int struct {
-	parent IntObservable
-	mapper func(int) IntObservable
+type flatMapIntervalBool2Bool struct {
+	parent IntervalBoolObservable
+	mapper func(IntervalBool) BoolObservable
 }
 
-func (f *flatMapInt2Int) Subscribe(observer IntObserver) Subscription {
+func (f *flatMapIntervalBool2Bool) Subscribe(observer BoolObserver) Subscription {
 	subscription := NewGenericSubscription()
 	wg := sync.WaitGroup{}
-	f.parent.Subscribe(IntObserverFunc(func(next int, err error, complete bool) {
+	f.parent.Subscribe(IntervalBoolObserverFunc(func(next IntervalBool, err error, complete bool) {
 		switch {
 		case err != nil:
 			wg.Wait()
@@ -3254,21 +14491,21 @@ func (f *flatMapInt2Int) Subscribe(observer IntObserver) Subscription {
 		default:
 			wg.Add(1)
 			observable := f.mapper(next)
-			stream := (&IntStream{observable}).
+			stream := (&BoolStream{observable}).
 				DoOnComplete(func() { wg.Done() }).
 				DoOnError(func(error) { wg.Done() })
-			stream = &IntStream{ignoreCompletionFilter().Int(stream)}
+			stream = &BoolStream{ignoreCompletionFilter().Bool(stream)}
 			stream.Subscribe(observer)
 		}
 	}))
 	return subscription
 }
 
-// Map maps values in this stream to another value.
-func (s *IntStream) Map(f func(int) int) *IntStream {
-	return FromIntObservable(MapInt2IntObserveNext(s, f))
+// MapBool maps this stream to an BoolStream via f.
+func (s *IntervalBoolStream) MapBool(f func(IntervalBool) bool) *BoolStream {
+	return FromBoolObservable(MapIntervalBool2BoolObserveNext(s, f))
 }
 
-func (s *IntStream) FlatMap(f func(int) IntObservable) *IntStream {
-	return &IntStream{&flatMapInt2Int{s, f}}
+func (s *IntervalBoolStream) FlatMapBool(f func(IntervalBool) BoolObservable) *BoolStream {
+	return &BoolStream{&flatMapIntervalBool2Bool{s, f}}
 }
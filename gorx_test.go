@@ -1,11 +1,24 @@
 package gorx
 
 import (
+	"bufio"
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"runtime"
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -32,6 +45,11 @@ func TestDistinct(t *testing.T) {
 	assert.Equal(t, []int{1, 2, 3, 4, 5}, a)
 }
 
+func TestDistinctNonComparable(t *testing.T) {
+	a := FromByteSlices([]byte("a"), []byte("b"), []byte("a")).Distinct().ToArray()
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b")}, a)
+}
+
 func TestResubscribe(t *testing.T) {
 	expected := []int{1, 2, 3, 4}
 	actual := FromIntArray(expected)
@@ -145,6 +163,94 @@ func TestMax(t *testing.T) {
 	assert.Equal(t, 5, value)
 }
 
+func TestMinString(t *testing.T) {
+	value, err := FromStrings("b", "a", "c").Min().ToOneWithError()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", value)
+}
+
+func TestMaxTime(t *testing.T) {
+	now := time.Now()
+	value, err := FromTimes(now, now.Add(time.Hour), now.Add(-time.Hour)).Max().ToOneWithError()
+	assert.NoError(t, err)
+	assert.Equal(t, now.Add(time.Hour), value)
+}
+
+func TestSumDuration(t *testing.T) {
+	value, err := FromDurations(time.Second, time.Minute).Sum().ToOneWithError()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute+time.Second, value)
+}
+
+func TestSorted(t *testing.T) {
+	a := FromInts(3, 1, 4, 1, 5, 9, 2, 6).Sorted().ToArray()
+	assert.Equal(t, []int{1, 1, 2, 3, 4, 5, 6, 9}, a)
+	b := FromStrings("pear", "apple", "fig").Sorted().ToArray()
+	assert.Equal(t, []string{"apple", "fig", "pear"}, b)
+}
+
+func TestAll(t *testing.T) {
+	positive := func(n int) bool { return n > 0 }
+	assert.Equal(t, []bool{true}, FromInts(1, 2, 3).All(positive).ToArray())
+	assert.Equal(t, []bool{false}, FromInts(1, -2, 3).All(positive).ToArray())
+	assert.Equal(t, []bool{true}, EmptyInt().All(positive).ToArray())
+}
+
+func TestAny(t *testing.T) {
+	negative := func(n int) bool { return n < 0 }
+	assert.Equal(t, []bool{false}, FromInts(1, 2, 3).Any(negative).ToArray())
+	assert.Equal(t, []bool{true}, FromInts(1, -2, 3).Any(negative).ToArray())
+}
+
+func TestAnyShortCircuits(t *testing.T) {
+	a := Interval(time.Millisecond).Any(func(n int) bool { return n == 3 }).ToArray()
+	assert.Equal(t, []bool{true}, a)
+}
+
+func TestContains(t *testing.T) {
+	assert.Equal(t, []bool{true}, FromStrings("a", "b").Contains("b").ToArray())
+	assert.Equal(t, []bool{false}, FromStrings("a", "b").Contains("c").ToArray())
+	assert.Equal(t, []bool{true}, FromByteSlices([]byte("a"), []byte("b")).Contains([]byte("b")).ToArray())
+}
+
+func TestIsEmpty(t *testing.T) {
+	assert.Equal(t, []bool{true}, EmptyInt().IsEmpty().ToArray())
+	assert.Equal(t, []bool{false}, Interval(time.Millisecond).IsEmpty().ToArray())
+}
+
+func TestSequenceEqual(t *testing.T) {
+	assert.Equal(t, []bool{true}, FromInts(1, 2, 3).SequenceEqual(FromInts(1, 2, 3)).ToArray())
+	assert.Equal(t, []bool{false}, FromInts(1, 2, 3).SequenceEqual(FromInts(1, 2)).ToArray())
+	assert.Equal(t, []bool{false}, FromInts(1, 2).SequenceEqual(FromInts(1, 2, 3)).ToArray())
+	assert.Equal(t, []bool{false}, FromInts(1, 2, 3).SequenceEqual(FromInts(1, 3, 2)).ToArray())
+	assert.Equal(t, []bool{false}, Interval(time.Millisecond).SequenceEqual(FromInts(0, 2)).ToArray())
+}
+
+func TestToOneEmpty(t *testing.T) {
+	_, err := EmptyInt().ToOneWithError()
+	assert.Error(t, err)
+	_, err = EmptyTime().ToOneWithError()
+	assert.Error(t, err)
+}
+
+func TestDefaultIfEmpty(t *testing.T) {
+	assert.Equal(t, 3, EmptyInt().DefaultIfEmpty(3).ToOne())
+	assert.Equal(t, []int{1, 2}, FromInts(1, 2).DefaultIfEmpty(3).ToArray())
+}
+
+func TestSwitchIfEmpty(t *testing.T) {
+	assert.Equal(t, []int{3, 4}, EmptyInt().SwitchIfEmpty(FromInts(3, 4)).ToArray())
+	assert.Equal(t, []int{1, 2}, FromInts(1, 2).SwitchIfEmpty(FromInts(3, 4)).ToArray())
+}
+
+func TestStartWith(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, FromInts(3).StartWith(1, 2).ToArray())
+}
+
+func TestEndWith(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, FromInts(1).EndWith(2, 3).ToArray())
+}
+
 func TestToChannel(t *testing.T) {
 	expected := []int{1, 2, 3, 4, 5, 4, 3, 2, 1}
 	a := FromIntArray(expected).ToChannel()
@@ -258,6 +364,30 @@ func TestRange(t *testing.T) {
 	assert.Equal(t, []int{1, 2, 3, 4, 5}, b)
 }
 
+func TestDefer(t *testing.T) {
+	calls := 0
+	s := DeferInt(func() IntObservable {
+		calls++
+		return FromInts(calls)
+	})
+	assert.Equal(t, []int{1}, s.ToArray())
+	assert.Equal(t, []int{2}, s.ToArray())
+}
+
+func TestTimer(t *testing.T) {
+	start := time.Now()
+	a := Timer(50 * time.Millisecond).ToArray()
+	assert.Equal(t, []int{0}, a)
+	assert.True(t, time.Since(start) >= 50*time.Millisecond)
+}
+
+func TestTimerPeriodic(t *testing.T) {
+	start := time.Now()
+	a := TimerPeriodic(50*time.Millisecond, 10*time.Millisecond).Take(3).ToArray()
+	assert.Equal(t, []int{0, 1, 2}, a)
+	assert.True(t, time.Since(start) >= 70*time.Millisecond)
+}
+
 func TestRepeat(t *testing.T) {
 	s := RepeatInt(5, 3)
 	a := s.ToArray()
@@ -311,6 +441,69 @@ func TestTakeLast(t *testing.T) {
 	assert.Equal(t, []int{3, 4, 5}, b)
 }
 
+func TestTakeWhile(t *testing.T) {
+	a := FromInts(1, 2, 3, 1).TakeWhile(func(n int) bool { return n < 3 }).ToArray()
+	assert.Equal(t, []int{1, 2}, a)
+}
+
+func TestTakeWhileDisposes(t *testing.T) {
+	stopped := make(chan bool)
+	s := CreateInt(func(observer IntObserver, subscription Subscription) {
+		for i := 0; !subscription.Disposed(); i++ {
+			observer.Next(i)
+		}
+		close(stopped)
+	})
+	a := s.TakeWhile(func(n int) bool { return n < 3 }).ToArray()
+	assert.Equal(t, []int{0, 1, 2}, a)
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("stream was not disposed")
+	}
+}
+
+func TestSkipWhile(t *testing.T) {
+	a := FromInts(1, 2, 3, 1).SkipWhile(func(n int) bool { return n < 3 }).ToArray()
+	assert.Equal(t, []int{3, 1}, a)
+}
+
+func TestTakeUntil(t *testing.T) {
+	signal := Timer(55 * time.Millisecond).MapString(strconv.Itoa)
+	a := Interval(10 * time.Millisecond).TakeUntil(signal).ToArray()
+	assert.NotEmpty(t, a)
+	assert.True(t, len(a) < 10)
+	for i, n := range a {
+		assert.Equal(t, i, n)
+	}
+}
+
+func TestTakeUntilSignalError(t *testing.T) {
+	_, err := NeverInt().TakeUntil(ThrowString(errors.New("error"))).ToArrayWithError()
+	assert.Error(t, err)
+}
+
+func TestSkipUntil(t *testing.T) {
+	skipped := make(chan bool)
+	opened := make(chan bool)
+	signal := CreateString(func(observer StringObserver, subscription Subscription) {
+		<-skipped
+		observer.Next("open")
+		close(opened)
+	})
+	s := CreateInt(func(observer IntObserver, subscription Subscription) {
+		observer.Next(1)
+		observer.Next(2)
+		close(skipped)
+		<-opened
+		observer.Next(3)
+		observer.Next(4)
+		observer.Complete()
+	})
+	a := s.SkipUntil(signal).ToArray()
+	assert.Equal(t, []int{3, 4}, a)
+}
+
 func TestIgnoreElements(t *testing.T) {
 	s := FromInts(1, 2, 3, 4, 5)
 	a := s.IgnoreElements().ToArray()
@@ -361,6 +554,52 @@ func TestDebounce(t *testing.T) {
 	assert.Equal(t, []int{1, 3, 4}, a)
 }
 
+func TestDelay(t *testing.T) {
+	start := time.Now()
+	a := FromInts(1, 2, 3).Delay(50 * time.Millisecond).ToArray()
+	assert.Equal(t, []int{1, 2, 3}, a)
+	assert.True(t, time.Since(start) >= 50*time.Millisecond)
+}
+
+func TestDelayWhen(t *testing.T) {
+	a := FromInts(30, 10, 20).DelayWhen(func(n int) time.Duration {
+		return time.Duration(n) * time.Millisecond
+	}).ToArray()
+	assert.Equal(t, []int{30, 10, 20}, a)
+}
+
+func TestDelayDoesNotDelayErrors(t *testing.T) {
+	start := time.Now()
+	a, err := FromInts(1, 2).Concat(ThrowInt(errors.New("error"))).Delay(time.Second).ToArrayWithError()
+	assert.Error(t, err)
+	assert.Empty(t, a)
+	assert.True(t, time.Since(start) < time.Second)
+}
+
+func TestDelayDispose(t *testing.T) {
+	values := make(chan int, 3)
+	subscription := FromInts(1, 2, 3).Delay(50 * time.Millisecond).SubscribeNext(func(n int) {
+		values <- n
+	})
+	time.Sleep(10 * time.Millisecond)
+	subscription.Dispose()
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 0, len(values))
+}
+
+func TestDelaySubscription(t *testing.T) {
+	subscribed := time.Time{}
+	s := CreateInt(func(observer IntObserver, subscription Subscription) {
+		subscribed = time.Now()
+		observer.Next(1)
+		observer.Complete()
+	})
+	start := time.Now()
+	a := s.DelaySubscription(50 * time.Millisecond).ToArray()
+	assert.Equal(t, []int{1}, a)
+	assert.True(t, subscribed.Sub(start) >= 50*time.Millisecond)
+}
+
 func TestMerge(t *testing.T) {
 	sa := CreateInt(func(observer IntObserver, subscription Subscription) {
 		time.Sleep(10 * time.Millisecond)
@@ -397,6 +636,24 @@ func TestMergeDelayError(t *testing.T) {
 	assert.Equal(t, []int{0, 1, 2}, a)
 }
 
+func TestAmb(t *testing.T) {
+	disposed := make(chan bool, 1)
+	slow := CreateInt(func(observer IntObserver, subscription Subscription) {
+		time.Sleep(50 * time.Millisecond)
+		disposed <- subscription.Disposed()
+		observer.Next(1)
+		observer.Complete()
+	})
+	fast := CreateInt(func(observer IntObserver, subscription Subscription) {
+		time.Sleep(5 * time.Millisecond)
+		observer.Next(2)
+		observer.Next(3)
+		observer.Complete()
+	})
+	assert.Equal(t, []int{2, 3}, slow.Amb(fast).ToArray())
+	assert.True(t, <-disposed)
+}
+
 func TestConcat(t *testing.T) {
 	a := []int{1, 2, 3}
 	b := []int{4, 5}
@@ -414,6 +671,40 @@ func TestRecover(t *testing.T) {
 	assert.Equal(t, []int{1, 2, 3, 4, 5}, merged.ToArray())
 }
 
+func TestCatchFunc(t *testing.T) {
+	notFound := errors.New("not found")
+	handler := func(err error) IntObservable {
+		if err == ErrTimeout {
+			return ThrowInt(err)
+		}
+		return FromInts(-1)
+	}
+	a, err := FromInts(1).Concat(ThrowInt(notFound)).CatchFunc(handler).ToArrayWithError()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, -1}, a)
+	a, err = FromInts(1).Concat(ThrowInt(ErrTimeout)).CatchFunc(handler).ToArrayWithError()
+	assert.Equal(t, ErrTimeout, err)
+	assert.Equal(t, []int{1}, a)
+}
+
+func TestOnErrorReturn(t *testing.T) {
+	a, err := FromStrings("a").
+		Concat(ThrowString(errors.New("b"))).
+		OnErrorReturn(func(err error) string { return err.Error() }).
+		ToArrayWithError()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, a)
+}
+
+func TestOnErrorResumeNext(t *testing.T) {
+	a, err := FromInts(1, 2).OnErrorResumeNext(FromInts(3)).ToArrayWithError()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, a)
+	a, err = FromInts(1).Concat(ThrowInt(errors.New("error"))).OnErrorResumeNext(FromInts(3)).ToArrayWithError()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 3}, a)
+}
+
 func TestRetry(t *testing.T) {
 	errored := false
 	a := CreateInt(func(observer IntObserver, subscription Subscription) {
@@ -432,6 +723,198 @@ func TestRetry(t *testing.T) {
 	assert.True(t, errored)
 }
 
+// failingInts errors on its first failures subscriptions, then emits 1 and
+// completes.
+func failingInts(failures int) (*IntStream, *int32) {
+	subscriptions := new(int32)
+	return CreateInt(func(observer IntObserver, subscription Subscription) {
+		if int(atomic.AddInt32(subscriptions, 1)) <= failures {
+			observer.Error(errors.New("error"))
+		} else {
+			observer.Next(1)
+			observer.Complete()
+		}
+	}), subscriptions
+}
+
+func TestRetryN(t *testing.T) {
+	s, _ := failingInts(2)
+	a, err := s.RetryN(2).ToArrayWithError()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, a)
+
+	s, subscriptions := failingInts(3)
+	a, err = s.RetryN(2).ToArrayWithError()
+	assert.Error(t, err)
+	assert.Empty(t, a)
+	assert.Equal(t, int32(3), atomic.LoadInt32(subscriptions))
+}
+
+func TestRetryWhen(t *testing.T) {
+	attempts := []int{}
+	s, _ := failingInts(5)
+	_, err := s.RetryWhen(func(err error, attempt int) (time.Duration, bool) {
+		attempts = append(attempts, attempt)
+		return time.Millisecond, attempt < 3
+	}).ToArrayWithError()
+	assert.Error(t, err)
+	assert.Equal(t, []int{1, 2, 3}, attempts)
+}
+
+func TestRetryWhenResetsAttempt(t *testing.T) {
+	subscriptions := int32(0)
+	s := CreateInt(func(observer IntObserver, subscription Subscription) {
+		n := int(atomic.AddInt32(&subscriptions, 1))
+		observer.Next(n)
+		if n < 4 {
+			observer.Error(errors.New("error"))
+		} else {
+			observer.Complete()
+		}
+	})
+	attempts := []int{}
+	a, err := s.RetryWhen(func(err error, attempt int) (time.Duration, bool) {
+		attempts = append(attempts, attempt)
+		return 0, attempt < 2
+	}).ToArrayWithError()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, a)
+	assert.Equal(t, []int{1, 1, 1}, attempts)
+}
+
+func TestRetryWithBackoff(t *testing.T) {
+	s, _ := failingInts(3)
+	start := time.Now()
+	a, err := s.RetryWithBackoff(10*time.Millisecond, 30*time.Millisecond, 2, 0).ToArrayWithError()
+	elapsed := time.Since(start)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, a)
+	// 10ms + 20ms + 30ms (capped)
+	assert.True(t, elapsed >= 60*time.Millisecond, "%s", elapsed)
+}
+
+func TestRetryDispose(t *testing.T) {
+	s, subscriptions := failingInts(1000)
+	sub := s.RetryWithBackoff(10*time.Millisecond, 10*time.Millisecond, 1, 0).SubscribeFunc(func(int, error, bool) {})
+	time.Sleep(35 * time.Millisecond)
+	sub.Dispose()
+	assert.True(t, sub.Disposed())
+	count := atomic.LoadInt32(subscriptions)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, count, atomic.LoadInt32(subscriptions))
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	policy := ExponentialBackoff(time.Second, 5*time.Second, 2, 0)
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+		delay, ok := policy(nil, attempt+1)
+		assert.True(t, ok)
+		assert.Equal(t, expected, delay)
+	}
+	policy = ExponentialBackoff(time.Second, time.Second, 1, 0.5)
+	for i := 0; i < 100; i++ {
+		delay, _ := policy(nil, 1)
+		assert.True(t, delay >= 500*time.Millisecond && delay <= 1500*time.Millisecond)
+	}
+}
+
+func TestSerialSubscription(t *testing.T) {
+	serial := NewSerialSubscription()
+	a := NewGenericSubscription()
+	b := NewGenericSubscription()
+	serial.Set(a)
+	serial.Set(b)
+	assert.True(t, a.Disposed())
+	assert.False(t, b.Disposed())
+	serial.Dispose()
+	assert.True(t, b.Disposed())
+	c := NewGenericSubscription()
+	serial.Set(c)
+	assert.True(t, c.Disposed())
+}
+
+func TestMaterialize(t *testing.T) {
+	err := errors.New("error")
+	a := FromInts(1, 2).Concat(ThrowInt(err)).Materialize().ToArray()
+	assert.Equal(t, []IntNotification{
+		{Kind: NotificationNext, Value: 1},
+		{Kind: NotificationNext, Value: 2},
+		{Kind: NotificationError, Err: err},
+	}, a)
+	b := EmptyInt().Materialize().ToArray()
+	assert.Equal(t, []IntNotification{{Kind: NotificationComplete}}, b)
+}
+
+func TestMaterializeDoFilter(t *testing.T) {
+	err := errors.New("error")
+	kinds := []NotificationKind{}
+	a := FromInts(1, 2).Concat(ThrowInt(err)).
+		Materialize().
+		Do(func(n IntNotification) { kinds = append(kinds, n.Kind) }).
+		Filter(func(n IntNotification) bool { return n.Kind == NotificationNext }).
+		MapString(func(n IntNotification) string { return strconv.Itoa(n.Value) }).
+		ToArray()
+	assert.Equal(t, []string{"1", "2"}, a)
+	assert.Equal(t, []NotificationKind{NotificationNext, NotificationNext, NotificationError}, kinds)
+}
+
+func TestDematerialize(t *testing.T) {
+	err := errors.New("error")
+	a, aerr := FromIntNotifications(
+		IntNotification{Kind: NotificationNext, Value: 1},
+		IntNotification{Kind: NotificationError, Err: err},
+		IntNotification{Kind: NotificationNext, Value: 2},
+	).Dematerialize().ToArrayWithError()
+	assert.Equal(t, err, aerr)
+	assert.Equal(t, []int{1}, a)
+	b, berr := FromStrings("a", "b").Materialize().Dematerialize().ToArrayWithError()
+	assert.NoError(t, berr)
+	assert.Equal(t, []string{"a", "b"}, b)
+}
+
+func TestDematerializeResubscribe(t *testing.T) {
+	s := FromInts(1, 2).Materialize().Dematerialize()
+	assert.Equal(t, []int{1, 2}, s.ToArray())
+	assert.Equal(t, []int{1, 2}, s.ToArray())
+}
+
+func fakeClock(times ...time.Time) func() {
+	lock := sync.Mutex{}
+	now := Now
+	Now = func() time.Time {
+		lock.Lock()
+		defer lock.Unlock()
+		t := times[0]
+		times = times[1:]
+		return t
+	}
+	return func() { Now = now }
+}
+
+func TestTimestamp(t *testing.T) {
+	start := time.Now()
+	defer fakeClock(start, start.Add(time.Second))()
+	a := FromStrings("a", "b").Timestamp().ToArray()
+	assert.Equal(t, []TimestampedString{{"a", start}, {"b", start.Add(time.Second)}}, a)
+}
+
+func TestTimeInterval(t *testing.T) {
+	start := time.Now()
+	defer fakeClock(start, start.Add(time.Second), start.Add(3*time.Second))()
+	a := FromStrings("a", "b").TimeInterval().ToArray()
+	assert.Equal(t, []IntervalString{{"a", time.Second}, {"b", 2 * time.Second}}, a)
+}
+
+func TestTimeIntervalMapDuration(t *testing.T) {
+	start := time.Now()
+	defer fakeClock(start, start.Add(time.Second), start.Add(3*time.Second))()
+	a := FromStrings("a", "b").
+		TimeInterval().
+		MapDuration(func(i IntervalString) time.Duration { return i.Interval }).
+		ToArray()
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, a)
+}
+
 func TestLinkedSubscription(t *testing.T) {
 	linked := NewLinkedSubscription()
 	sub := NewGenericSubscription()
@@ -514,3 +997,381 @@ func TestFork(t *testing.T) {
 	assert.Equal(t, []int{1, 2, 3, 4}, b)
 	assert.Equal(t, []int{1, 2, 3}, a)
 }
+
+type errReader struct{ err error }
+
+func (e errReader) Read([]byte) (int, error) { return 0, e.err }
+
+func TestFromReaderLines(t *testing.T) {
+	a := FromReaderLines(strings.NewReader("a\r\nb\n\nc")).ToArray()
+	assert.Equal(t, []string{"a", "b", "", "c"}, a)
+}
+
+func TestFromReaderLinesError(t *testing.T) {
+	r := io.MultiReader(strings.NewReader("a\nb"), errReader{errors.New("error")})
+	a, err := FromReaderLines(r).ToArrayWithError()
+	assert.Equal(t, []string{"a"}, a)
+	assert.EqualError(t, err, "error")
+}
+
+func TestFromReaderDelimited(t *testing.T) {
+	a := FromReaderDelimited(strings.NewReader("a\x00bc\x00"), 0).ToArray()
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("bc")}, a)
+}
+
+func TestFromReaderChunks(t *testing.T) {
+	a := FromReaderChunks(strings.NewReader("abcdefg"), 3).ToArray()
+	assert.Equal(t, [][]byte{[]byte("abc"), []byte("def"), []byte("g")}, a)
+}
+
+func TestFromReaderChunksInvalidSize(t *testing.T) {
+	for _, size := range []int{0, -1} {
+		_, err := FromReaderChunks(strings.NewReader("abc"), size).ToArrayWithError()
+		assert.Error(t, err)
+	}
+}
+
+type countingWriter struct {
+	bytes.Buffer
+	writes int
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	c.writes++
+	return c.Buffer.Write(b)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("write failed") }
+
+func TestWriteLinesTo(t *testing.T) {
+	w := &countingWriter{}
+	err := FromStrings("a", "b", "c").WriteLinesTo(w)
+	assert.NoError(t, err)
+	assert.Equal(t, "a\nb\nc\n", w.String())
+	assert.Equal(t, 3, w.writes)
+}
+
+func TestWriteLinesToFlushCount(t *testing.T) {
+	w := &countingWriter{}
+	err := FromStrings("a", "b", "c").WriteLinesTo(w, FlushCount(2))
+	assert.NoError(t, err)
+	assert.Equal(t, "a\nb\nc\n", w.String())
+	assert.Equal(t, 2, w.writes)
+}
+
+func TestWriteLinesToFlushInterval(t *testing.T) {
+	w := &countingWriter{}
+	err := Interval(10*time.Millisecond).Take(5).MapString(strconv.Itoa).WriteLinesTo(w, FlushInterval(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, "0\n1\n2\n3\n4\n", w.String())
+	assert.Equal(t, 1, w.writes)
+}
+
+func TestWriteLinesToError(t *testing.T) {
+	w := &bytes.Buffer{}
+	err := FromStrings("a").Concat(ThrowString(errors.New("error"))).WriteLinesTo(w, FlushCount(10))
+	assert.EqualError(t, err, "error")
+	assert.Equal(t, "a\n", w.String())
+}
+
+func TestWriteLinesToWriteError(t *testing.T) {
+	err := Interval(time.Millisecond).MapString(strconv.Itoa).WriteLinesTo(failingWriter{})
+	assert.EqualError(t, err, "write failed")
+}
+
+func TestWriteTo(t *testing.T) {
+	var w io.WriterTo = FromByteSlices([]byte("ab"), []byte("c"))
+	b := &bytes.Buffer{}
+	n, err := w.WriteTo(b)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+	assert.Equal(t, "abc", b.String())
+}
+
+func TestTailFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gorx")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "log")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("a\n"), 0600))
+	lines := TailFile(path, TailOptions{PollInterval: 5 * time.Millisecond, FromStart: true}).Take(4).ToChannel()
+	assert.Equal(t, "a", <-lines)
+
+	// Append.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	assert.NoError(t, err)
+	_, err = f.WriteString("b\n")
+	assert.NoError(t, err)
+	f.Close()
+	assert.Equal(t, "b", <-lines)
+
+	// Rotate.
+	assert.NoError(t, os.Rename(path, path+".1"))
+	assert.NoError(t, ioutil.WriteFile(path, []byte("c\n"), 0600))
+	assert.Equal(t, "c", <-lines)
+
+	// Truncate.
+	assert.NoError(t, os.Truncate(path, 0))
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, ioutil.WriteFile(path, []byte("d\n"), 0600))
+	assert.Equal(t, "d", <-lines)
+}
+
+func TestTailFileFromEnd(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gorx")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "log")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("a\n"), 0600))
+	lines := TailFile(path, TailOptions{PollInterval: 5 * time.Millisecond}).Take(1).ToChannel()
+	time.Sleep(50 * time.Millisecond)
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	assert.NoError(t, err)
+	_, err = f.WriteString("b\n")
+	assert.NoError(t, err)
+	f.Close()
+	assert.Equal(t, "b", <-lines)
+}
+
+func TestFromCSV(t *testing.T) {
+	r := strings.NewReader("name;age\n# comment\nbob;42\n\"a;b\";7\n")
+	a := FromCSV(r, CSVOptions{Comma: ';', Comment: '#', SkipHeader: true}).ToArray()
+	assert.Equal(t, [][]string{{"bob", "42"}, {"a;b", "7"}}, a)
+}
+
+func TestFromCSVError(t *testing.T) {
+	_, err := FromCSV(strings.NewReader("a,b\nc\n"), CSVOptions{}).ToArrayWithError()
+	assert.Error(t, err)
+}
+
+func TestDecodeCSV(t *testing.T) {
+	a, err := DecodeIntCSV(strings.NewReader("1\n2\nx\n"), CSVOptions{}, func(record []string) (int, error) {
+		return strconv.Atoi(record[0])
+	}).ToArrayWithError()
+	assert.Equal(t, []int{1, 2}, a)
+	assert.Error(t, err)
+}
+
+func TestToCSV(t *testing.T) {
+	w := &bytes.Buffer{}
+	err := FromStringSlices([]string{"a", "b,c"}, []string{"d", "e"}).ToCSV(w)
+	assert.NoError(t, err)
+	assert.Equal(t, "a,\"b,c\"\nd,e\n", w.String())
+}
+
+func TestFromSSE(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, ": comment\ndata: a\n\nevent: ping\ndata: p\n\nid: 1\ndata:b\ndata: c\n\n")
+	}))
+	defer server.Close()
+	response, err := http.Get(server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "p", "b\nc"}, FromSSE(response).ToArray())
+
+	response, err = http.Get(server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b\nc"}, FromSSE(response, "message").ToArray())
+}
+
+func TestFromSSELastEventID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "id: 1\ndata: a\n\ndata: b\n\nid: 3\nevent: ping\ndata: p\n\nid\ndata: c\n\n")
+	}))
+	defer server.Close()
+	response, err := http.Get(server.URL)
+	assert.NoError(t, err)
+	ids := []string{}
+	a := FromSSEWithOptions(response, SSEOptions{
+		Events:      []string{"message"},
+		LastEventID: func(id string) { ids = append(ids, id) },
+	}).ToArray()
+	assert.Equal(t, []string{"a", "b", "c"}, a)
+	assert.Equal(t, []string{"1", "3", ""}, ids)
+}
+
+func TestServeSSE(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		FromStrings("a", "b\nc").ServeSSE(w, r)
+	}))
+	defer server.Close()
+	response, err := http.Get(server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+	assert.Equal(t, []string{"a", "b\nc"}, FromSSE(response).ToArray())
+}
+
+func TestServeSSEDisposesOnDisconnect(t *testing.T) {
+	disposed := make(chan bool, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		CreateString(func(observer StringObserver, subscription Subscription) {
+			for !subscription.Disposed() {
+				observer.Next("tick")
+				time.Sleep(time.Millisecond)
+			}
+			disposed <- true
+		}).ServeSSE(w, r)
+	}))
+	defer server.Close()
+	response, err := http.Get(server.URL)
+	assert.NoError(t, err)
+	line, err := bufio.NewReader(response.Body).ReadString('\n')
+	assert.NoError(t, err)
+	assert.Equal(t, "data: tick\n", line)
+	response.Body.Close()
+	select {
+	case <-disposed:
+	case <-time.After(time.Second):
+		t.Fatal("subscription was not disposed")
+	}
+}
+
+func TestFromListener(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	chunks := make(chan string)
+	subscription := FromListener(listener).SubscribeNext(func(conn net.Conn) {
+		FromConn(conn).SubscribeNext(func(chunk []byte) { chunks <- string(chunk) })
+	})
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	assert.NoError(t, err)
+	_, err = conn.Write([]byte("hello"))
+	assert.NoError(t, err)
+	conn.Close()
+	assert.Equal(t, "hello", <-chunks)
+
+	// Disposing closes the listener, which causes Accept to fail.
+	subscription.Dispose()
+	_, err = listener.Accept()
+	assert.Error(t, err)
+}
+
+func TestFromConnDispose(t *testing.T) {
+	server, client := net.Pipe()
+	defer client.Close()
+	subscription := FromConn(server).SubscribeNext(func([]byte) {})
+	subscription.Dispose()
+	// Read blocks until the server end is closed.
+	_, err := client.Read(make([]byte, 1))
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestFromSignals(t *testing.T) {
+	process, err := os.FindProcess(os.Getpid())
+	assert.NoError(t, err)
+	signals := make(chan os.Signal, 1)
+	subscription := FromSignals(os.Interrupt).SubscribeNext(func(sig os.Signal) { signals <- sig })
+	defer subscription.Dispose()
+	if err := process.Signal(os.Interrupt); err != nil {
+		t.Skip("can't send signals on this platform")
+	}
+	select {
+	case sig := <-signals:
+		assert.Equal(t, os.Interrupt, sig)
+	case <-time.After(time.Second):
+		t.Fatal("signal not received")
+	}
+}
+
+func TestFromSignalValues(t *testing.T) {
+	a := FromSignalValues(os.Interrupt, os.Kill).ToArray()
+	assert.Equal(t, []os.Signal{os.Interrupt, os.Kill}, a)
+}
+
+func TestWatchDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gorx")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "a.conf")
+	events := make(chan FileEvent, 16)
+	subscription := WatchDir(dir, 5*time.Millisecond, WatchOptions{Patterns: []string{"*.conf"}}).
+		SubscribeNext(func(event FileEvent) { events <- event })
+	defer subscription.Dispose()
+	time.Sleep(20 * time.Millisecond)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "b.txt"), []byte("b"), 0600))
+	assert.NoError(t, ioutil.WriteFile(path, []byte("a"), 0600))
+	event := <-events
+	assert.Equal(t, FileCreated, event.Op)
+	assert.Equal(t, path, event.Path)
+
+	assert.NoError(t, ioutil.WriteFile(path, []byte("aa"), 0600))
+	event = <-events
+	assert.Equal(t, FileModified, event.Op)
+	assert.Equal(t, int64(2), event.Info.Size())
+
+	assert.NoError(t, os.Remove(path))
+	event = <-events
+	assert.Equal(t, FileDeleted, event.Op)
+	assert.Equal(t, path, event.Path)
+}
+
+func TestWatchDirDebounce(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gorx")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	events := make(chan FileEvent, 16)
+	subscription := WatchDir(dir, 5*time.Millisecond, WatchOptions{Debounce: 50 * time.Millisecond}).
+		SubscribeNext(func(event FileEvent) { events <- event })
+	defer subscription.Dispose()
+	time.Sleep(20 * time.Millisecond)
+
+	for _, name := range []string{"a", "b", "c"} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), nil, 0600))
+	}
+	event := <-events
+	assert.Equal(t, filepath.Join(dir, "c"), event.Path)
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event %v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	s := FromIntSeq(slices.Values([]int{1, 2, 3}))
+	assert.Equal(t, []int{1, 2, 3}, s.ToArray())
+	assert.Equal(t, []int{1, 2, 3}, s.ToArray())
+}
+
+func TestSeq(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, slices.Collect(FromStrings("a", "b").Seq()))
+}
+
+func TestSeqBreakDisposes(t *testing.T) {
+	disposed := make(chan bool, 1)
+	s := CreateInt(func(observer IntObserver, subscription Subscription) {
+		for i := 0; !subscription.Disposed(); i++ {
+			observer.Next(i)
+		}
+		disposed <- true
+	})
+	a := []int{}
+	for v := range s.Seq() {
+		a = append(a, v)
+		if len(a) == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []int{0, 1, 2}, a)
+	select {
+	case <-disposed:
+	case <-time.After(time.Second):
+		t.Fatal("subscription was not disposed")
+	}
+}
+
+func TestSeq2(t *testing.T) {
+	a := []int{}
+	var err error
+	for v, e := range FromInts(1, 2).Concat(ThrowInt(errors.New("error"))).Seq2() {
+		if e != nil {
+			err = e
+			break
+		}
+		a = append(a, v)
+	}
+	assert.Equal(t, []int{1, 2}, a)
+	assert.EqualError(t, err, "error")
+}
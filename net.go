@@ -0,0 +1,78 @@
+package gorx
+
+import (
+	"io"
+	"net"
+)
+
+type listenerObservable struct {
+	listener net.Listener
+}
+
+func (l *listenerObservable) Subscribe(observer ConnObserver) Subscription {
+	subscription := NewChannelSubscription()
+	subscription.OnUnsubscribe(func() { l.listener.Close() })
+	go func() {
+		defer subscription.Dispose()
+		for {
+			conn, err := l.listener.Accept()
+			if err != nil {
+				// Accept fails once the listener is closed by Dispose.
+				if !subscription.Disposed() {
+					observer.Error(err)
+				}
+				return
+			}
+			observer.Next(conn)
+		}
+	}()
+	return subscription
+}
+
+// FromListener emits each connection accepted by listener. Disposing the
+// subscription closes the listener.
+func FromListener(listener net.Listener) *ConnStream {
+	return FromConnObservable(&listenerObservable{listener})
+}
+
+// connChunkSize is the maximum size of each chunk emitted by FromConn.
+const connChunkSize = 32 * 1024
+
+type connObservable struct {
+	conn net.Conn
+}
+
+func (c *connObservable) Subscribe(observer ByteSliceObserver) Subscription {
+	subscription := NewChannelSubscription()
+	subscription.OnUnsubscribe(func() { c.conn.Close() })
+	go func() {
+		defer subscription.Dispose()
+		buffer := make([]byte, connChunkSize)
+		for {
+			n, err := c.conn.Read(buffer)
+			if n > 0 {
+				chunk := make([]byte, n)
+				copy(chunk, buffer)
+				observer.Next(chunk)
+			}
+			if err == io.EOF {
+				observer.Complete()
+				return
+			} else if err != nil {
+				// Read fails once the connection is closed by Dispose.
+				if !subscription.Disposed() {
+					observer.Error(err)
+				}
+				return
+			}
+		}
+	}()
+	return subscription
+}
+
+// FromConn emits chunks of data as they are read from conn, completing when
+// the remote end closes it. The connection is closed when the stream
+// terminates or the subscription is disposed.
+func FromConn(conn net.Conn) *ByteSliceStream {
+	return FromByteSliceObservable(&connObservable{conn})
+}
@@ -0,0 +1,84 @@
+package gorx
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// FromReaderLines emits each line read from r, without its line ending.
+//
+// Disposal is checked between reads, so a blocked read will not be interrupted.
+func FromReaderLines(r io.Reader) *StringStream {
+	return CreateString(func(observer StringObserver, subscription Subscription) {
+		readDelimited(r, '\n', subscription, func(record []byte) {
+			observer.Next(string(bytes.TrimSuffix(record, []byte{'\r'})))
+		}, observer)
+	})
+}
+
+// FromReaderDelimited emits each record read from r that is terminated by
+// delim, without the delimiter. A final unterminated record is also emitted.
+//
+// Disposal is checked between reads, so a blocked read will not be interrupted.
+func FromReaderDelimited(r io.Reader, delim byte) *ByteSliceStream {
+	return CreateByteSlice(func(observer ByteSliceObserver, subscription Subscription) {
+		readDelimited(r, delim, subscription, observer.Next, observer)
+	})
+}
+
+// FromReaderChunks emits the content of r in chunks of size bytes. The final
+// chunk may be shorter. The stream errors if size is not positive.
+//
+// Disposal is checked between reads, so a blocked read will not be interrupted.
+func FromReaderChunks(r io.Reader, size int) *ByteSliceStream {
+	return CreateByteSlice(func(observer ByteSliceObserver, subscription Subscription) {
+		if size <= 0 {
+			observer.Error(fmt.Errorf("invalid chunk size %d", size))
+			return
+		}
+		for {
+			if subscription.Disposed() {
+				return
+			}
+			chunk := make([]byte, size)
+			n, err := io.ReadFull(r, chunk)
+			if n > 0 {
+				observer.Next(chunk[:n])
+			}
+			switch err {
+			case nil:
+			case io.EOF, io.ErrUnexpectedEOF:
+				observer.Complete()
+				return
+			default:
+				observer.Error(err)
+				return
+			}
+		}
+	})
+}
+
+func readDelimited(r io.Reader, delim byte, subscription Subscription, next func([]byte), observer TerminationObserver) {
+	reader := bufio.NewReader(r)
+	for {
+		if subscription.Disposed() {
+			return
+		}
+		record, err := reader.ReadBytes(delim)
+		if err == nil {
+			next(record[:len(record)-1])
+			continue
+		}
+		if err != io.EOF {
+			observer.Error(err)
+			return
+		}
+		if len(record) > 0 {
+			next(record)
+		}
+		observer.Complete()
+		return
+	}
+}
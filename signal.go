@@ -0,0 +1,36 @@
+package gorx
+
+import (
+	"os"
+	"os/signal"
+)
+
+type signalObservable []os.Signal
+
+func (s signalObservable) Subscribe(observer SignalObserver) Subscription {
+	subscription := NewChannelSubscription()
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, s...)
+	go func() {
+		for {
+			select {
+			case sig := <-ch:
+				observer.Next(sig)
+			case <-subscription:
+				signal.Stop(ch)
+				return
+			}
+		}
+	}()
+	return subscription
+}
+
+// FromSignals emits each of sigs as it is received by the process, or every
+// signal if sigs is empty. The stream never completes. Disposing the
+// subscription stops relaying the signals, restoring their default behaviour
+// if nothing else is notified of them.
+//
+// The generated constructor emitting the given values is FromSignalValues.
+func FromSignals(sigs ...os.Signal) *SignalStream {
+	return FromSignalObservable(signalObservable(sigs))
+}
@@ -0,0 +1,146 @@
+package gorx
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// SSEOptions configures FromSSEWithOptions.
+type SSEOptions struct {
+	// Events, if provided, restricts the stream to events of these types.
+	// Events without an explicit type are of type "message".
+	Events []string
+	// LastEventID, if provided, is called whenever an event changes the last
+	// event ID set by the "id" field, before the event is emitted. Send the
+	// most recent ID in the Last-Event-ID header when reconnecting, so that
+	// the server can resume from that event.
+	LastEventID func(id string)
+}
+
+// FromSSE emits the data of each Server-Sent Event in the body of response.
+// Multi-line data is joined with "\n". If events are provided, only events of
+// those types are emitted. Events without an explicit type are of type
+// "message".
+//
+// The response body is closed when the stream terminates.
+func FromSSE(response *http.Response, events ...string) *StringStream {
+	return FromSSEWithOptions(response, SSEOptions{Events: events})
+}
+
+// FromSSEWithOptions is like FromSSE, but configured by options.
+func FromSSEWithOptions(response *http.Response, options SSEOptions) *StringStream {
+	return CreateString(func(observer StringObserver, subscription Subscription) {
+		defer response.Body.Close()
+		scanner := bufio.NewScanner(response.Body)
+		event := ""
+		data := []string{}
+		id, lastID := "", ""
+		for scanner.Scan() {
+			if subscription.Disposed() {
+				return
+			}
+			line := scanner.Text()
+			if line == "" {
+				if id != lastID {
+					lastID = id
+					if options.LastEventID != nil {
+						options.LastEventID(id)
+					}
+				}
+				if len(data) > 0 && sseEventMatches(event, options.Events) {
+					observer.Next(strings.Join(data, "\n"))
+				}
+				event = ""
+				data = data[:0]
+				continue
+			}
+			field, value := line, ""
+			if colon := strings.IndexByte(line, ':'); colon >= 0 {
+				field, value = line[:colon], strings.TrimPrefix(line[colon+1:], " ")
+			}
+			switch field {
+			case "event":
+				event = value
+			case "data":
+				data = append(data, value)
+			case "id":
+				// IDs containing NUL are ignored, as in the SSE specification.
+				if strings.IndexByte(value, 0) < 0 {
+					id = value
+				}
+			}
+			// Comments (empty field) and "retry" are ignored.
+		}
+		if err := scanner.Err(); err != nil {
+			observer.Error(err)
+			return
+		}
+		observer.Complete()
+	})
+}
+
+func sseEventMatches(event string, events []string) bool {
+	if len(events) == 0 {
+		return true
+	}
+	if event == "" {
+		event = "message"
+	}
+	for _, e := range events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+type sseNotification struct {
+	next     string
+	err      error
+	complete bool
+}
+
+// ServeSSE streams each value to the client as a Server-Sent Event, blocking
+// until the stream terminates or the client disconnects, at which point the
+// subscription is disposed. The response ends when the stream terminates,
+// whether it completes or errors.
+func (s *StringStream) ServeSSE(w http.ResponseWriter, r *http.Request) {
+	flush := func() {
+		if flusher, ok := w.(http.Flusher); ok {
+			flusher.Flush()
+		}
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flush()
+
+	// The ResponseWriter can only be used from the handler goroutine.
+	notifications := make(chan sseNotification)
+	done := make(chan struct{})
+	defer close(done)
+	subscription := s.SubscribeFunc(func(next string, err error, complete bool) {
+		select {
+		case notifications <- sseNotification{next, err, complete}:
+		case <-done:
+		}
+	})
+	defer subscription.Dispose()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case n := <-notifications:
+			switch {
+			case n.err != nil, n.complete:
+				return
+			default:
+				fmt.Fprintf(w, "data: %s\n\n", strings.Replace(n.next, "\n", "\ndata: ", -1))
+				flush()
+			}
+		}
+	}
+}
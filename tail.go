@@ -0,0 +1,138 @@
+package gorx
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+	"time"
+)
+
+// TailOptions configures TailFile.
+type TailOptions struct {
+	// PollInterval is how often the file is checked for new lines, truncation
+	// and rotation. Defaults to 250ms.
+	PollInterval time.Duration
+	// FromStart emits the existing lines of the file, rather than only lines
+	// appended after subscription.
+	FromStart bool
+}
+
+// TailFile follows the file at path, emitting each line appended to it without
+// its line ending.
+//
+// The file is polled for changes. If it is truncated it is read again from the
+// start. If it is replaced, eg. by log rotation, the remainder of the old file
+// is read and the new file is then followed from its start.
+func TailFile(path string, options TailOptions) *StringStream {
+	if options.PollInterval == 0 {
+		options.PollInterval = 250 * time.Millisecond
+	}
+	return CreateString(func(observer StringObserver, subscription Subscription) {
+		t := &tailer{observer: observer}
+		if err := t.open(path, !options.FromStart); err != nil {
+			observer.Error(err)
+			return
+		}
+		defer func() { t.file.Close() }()
+		for {
+			if err := t.read(); err != nil {
+				observer.Error(err)
+				return
+			}
+			time.Sleep(options.PollInterval)
+			if subscription.Disposed() {
+				return
+			}
+			info, err := os.Stat(path)
+			if os.IsNotExist(err) {
+				// Probably mid-rotation.
+				continue
+			} else if err != nil {
+				observer.Error(err)
+				return
+			}
+			switch {
+			case !os.SameFile(info, t.info):
+				if err := t.read(); err != nil {
+					observer.Error(err)
+					return
+				}
+				t.flush()
+				t.file.Close()
+				if err := t.open(path, false); err != nil {
+					observer.Error(err)
+					return
+				}
+			case info.Size() < t.offset:
+				if _, err := t.file.Seek(0, io.SeekStart); err != nil {
+					observer.Error(err)
+					return
+				}
+				t.reset(0)
+			}
+		}
+	})
+}
+
+type tailer struct {
+	observer StringObserver
+	file     *os.File
+	info     os.FileInfo
+	reader   *bufio.Reader
+	offset   int64
+	partial  []byte
+}
+
+func (t *tailer) open(path string, fromEnd bool) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	offset := int64(0)
+	if fromEnd {
+		if offset, err = file.Seek(0, io.SeekEnd); err != nil {
+			file.Close()
+			return err
+		}
+	}
+	t.file = file
+	t.info = info
+	t.reset(offset)
+	return nil
+}
+
+func (t *tailer) reset(offset int64) {
+	t.reader = bufio.NewReader(t.file)
+	t.offset = offset
+	t.partial = nil
+}
+
+// read emits all complete lines currently available.
+func (t *tailer) read() error {
+	for {
+		line, err := t.reader.ReadBytes('\n')
+		t.offset += int64(len(line))
+		t.partial = append(t.partial, line...)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		t.observer.Next(string(bytes.TrimSuffix(t.partial[:len(t.partial)-1], []byte{'\r'})))
+		t.partial = nil
+	}
+}
+
+// flush emits any unterminated final line.
+func (t *tailer) flush() {
+	if len(t.partial) > 0 {
+		t.observer.Next(string(t.partial))
+		t.partial = nil
+	}
+}
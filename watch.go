@@ -0,0 +1,142 @@
+package gorx
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// FileOp is the kind of change described by a FileEvent.
+type FileOp int
+
+const (
+	FileCreated FileOp = iota
+	FileModified
+	FileDeleted
+)
+
+func (o FileOp) String() string {
+	switch o {
+	case FileCreated:
+		return "Created"
+	case FileModified:
+		return "Modified"
+	case FileDeleted:
+		return "Deleted"
+	}
+	return "FileOp(" + strconv.Itoa(int(o)) + ")"
+}
+
+// FileEvent is a change to a file in a directory watched by WatchDir.
+type FileEvent struct {
+	Op   FileOp
+	Path string
+	// Info is the file's current information, or for FileDeleted its last
+	// known information.
+	Info os.FileInfo
+}
+
+// WatchOptions configures WatchDir.
+type WatchOptions struct {
+	// Patterns, if provided, restricts the watch to files whose base name
+	// matches at least one of these filepath.Match globs.
+	Patterns []string
+	// Debounce, if non-zero, passes the events through Debounce, so that only
+	// the last event of each burst of changes is emitted. This is useful
+	// when any change should trigger the same action, such as a reload.
+	Debounce time.Duration
+}
+
+// WatchDir polls the directory at path every interval, emitting an event for
+// each file that is created, modified or deleted. Subdirectories are not
+// watched. A file is considered modified if its size or modification time
+// changes.
+//
+// The stream terminates with an error if the directory can not be read.
+func WatchDir(path string, interval time.Duration, options WatchOptions) *FileEventStream {
+	stream := CreateFileEvent(func(observer FileEventObserver, subscription Subscription) {
+		previous, err := snapshotDir(path, options.Patterns)
+		if err != nil {
+			observer.Error(err)
+			return
+		}
+		for {
+			time.Sleep(interval)
+			if subscription.Disposed() {
+				return
+			}
+			current, err := snapshotDir(path, options.Patterns)
+			if err != nil {
+				observer.Error(err)
+				return
+			}
+			for _, event := range diffSnapshots(path, previous, current) {
+				observer.Next(event)
+			}
+			previous = current
+		}
+	})
+	if options.Debounce > 0 {
+		stream = stream.Debounce(options.Debounce)
+	}
+	return stream
+}
+
+func snapshotDir(path string, patterns []string) (map[string]os.FileInfo, error) {
+	infos, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := map[string]os.FileInfo{}
+	for _, info := range infos {
+		if info.IsDir() || !matchesAny(info.Name(), patterns) {
+			continue
+		}
+		snapshot[info.Name()] = info
+	}
+	return snapshot, nil
+}
+
+func matchesAny(name string, patterns []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// diffSnapshots returns the events that transform previous into current,
+// ordered by file name.
+func diffSnapshots(path string, previous, current map[string]os.FileInfo) []FileEvent {
+	names := []string{}
+	for name := range previous {
+		names = append(names, name)
+	}
+	for name := range current {
+		if _, ok := previous[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	events := []FileEvent{}
+	for _, name := range names {
+		before, existed := previous[name]
+		after, exists := current[name]
+		switch {
+		case !existed:
+			events = append(events, FileEvent{FileCreated, filepath.Join(path, name), after})
+		case !exists:
+			events = append(events, FileEvent{FileDeleted, filepath.Join(path, name), before})
+		case before.Size() != after.Size() || !before.ModTime().Equal(after.ModTime()):
+			events = append(events, FileEvent{FileModified, filepath.Join(path, name), after})
+		}
+	}
+	return events
+}
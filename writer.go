@@ -0,0 +1,140 @@
+package gorx
+
+import (
+	"bufio"
+	"io"
+	"sync"
+	"time"
+)
+
+type writeOptions struct {
+	interval time.Duration
+	count    int
+}
+
+// A WriteOption configures how WriteLinesTo and WriteChunksTo write to their
+// io.Writer. By default every value is written through immediately.
+type WriteOption func(*writeOptions)
+
+// FlushInterval buffers output, flushing it at least every interval.
+func FlushInterval(interval time.Duration) WriteOption {
+	return func(o *writeOptions) { o.interval = interval }
+}
+
+// FlushCount buffers output, flushing it after every count values.
+func FlushCount(count int) WriteOption {
+	return func(o *writeOptions) { o.count = count }
+}
+
+// WriteLinesTo writes each string in the stream to w as a line, blocking until
+// the stream completes. Any buffered output is flushed before returning.
+//
+// The returned error is either the error from the stream or from writing to w.
+// A write error disposes the stream.
+func (s *StringStream) WriteLinesTo(w io.Writer, options ...WriteOption) error {
+	_, err := writeStream(w, options, func(write func([]byte, error, bool)) Subscription {
+		return s.SubscribeFunc(func(next string, err error, complete bool) {
+			write([]byte(next+"\n"), err, complete)
+		})
+	})
+	return err
+}
+
+// WriteChunksTo writes each []byte in the stream to w, blocking until the
+// stream completes. Any buffered output is flushed before returning.
+//
+// The number of bytes written is returned along with either the error from the
+// stream or from writing to w. A write error disposes the stream.
+func (s *ByteSliceStream) WriteChunksTo(w io.Writer, options ...WriteOption) (int64, error) {
+	return writeStream(w, options, func(write func([]byte, error, bool)) Subscription {
+		return s.SubscribeFunc(write)
+	})
+}
+
+// WriteTo implements io.WriterTo. It is equivalent to WriteChunksTo(w).
+func (s *ByteSliceStream) WriteTo(w io.Writer) (int64, error) {
+	return s.WriteChunksTo(w)
+}
+
+func writeStream(w io.Writer, options []WriteOption, subscribe func(write func([]byte, error, bool)) Subscription) (int64, error) {
+	opts := &writeOptions{}
+	for _, option := range options {
+		option(opts)
+	}
+	lock := sync.Mutex{}
+	out := w
+	var buffer *bufio.Writer
+	if opts.interval > 0 || opts.count > 0 {
+		buffer = bufio.NewWriter(w)
+		out = buffer
+	}
+	written := int64(0)
+	pending := 0
+	finished := false
+	done := make(chan error, 1)
+	// finish must be called with lock held.
+	finish := func(err error) {
+		finished = true
+		if buffer != nil {
+			if ferr := buffer.Flush(); err == nil {
+				err = ferr
+			}
+		}
+		done <- err
+	}
+	if opts.interval > 0 {
+		ticker := time.NewTicker(opts.interval)
+		stop := make(chan struct{})
+		defer ticker.Stop()
+		defer close(stop)
+		go func() {
+			for {
+				select {
+				case <-ticker.C:
+					lock.Lock()
+					if !finished && pending > 0 {
+						pending = 0
+						if err := buffer.Flush(); err != nil {
+							finish(err)
+						}
+					}
+					lock.Unlock()
+				case <-stop:
+					return
+				}
+			}
+		}()
+	}
+	subscription := subscribe(func(next []byte, err error, complete bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		if finished {
+			return
+		}
+		switch {
+		case err != nil:
+			finish(err)
+		case complete:
+			finish(nil)
+		default:
+			n, err := out.Write(next)
+			written += int64(n)
+			if err != nil {
+				finish(err)
+				return
+			}
+			pending++
+			if opts.count > 0 && pending >= opts.count {
+				pending = 0
+				if err := buffer.Flush(); err != nil {
+					finish(err)
+				}
+			}
+		}
+	})
+	err := <-done
+	subscription.Dispose()
+	lock.Lock()
+	defer lock.Unlock()
+	return written, err
+}